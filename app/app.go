@@ -600,6 +600,9 @@ func New(
 	if err != nil {
 		panic(fmt.Sprintf("error reading EVM config due to %s", err))
 	}
+	app.EvmKeeper.BloomBitsIndexEnabled = app.evmRPCConfig.EnableBloomBitsIndex
+	app.EvmKeeper.AddressTxIndexEnabled = app.evmRPCConfig.EnableAddressTxIndex
+	app.EvmKeeper.AddressTxIndexRetention = app.evmRPCConfig.AddressTxIndexRetention
 	evmQueryConfig, err := querier.ReadConfig(appOpts)
 	if err != nil {
 		panic(fmt.Sprintf("error reading evm query config due to %s", err))
@@ -651,7 +654,7 @@ func New(
 	// register the proposal types
 	govRouter := govtypes.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
-		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
+		AddRoute(paramproposal.RouterKey, evm.NewParamChangeProposalHandler(&app.EvmKeeper, params.NewParamChangeProposalHandler(app.ParamsKeeper))).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
 		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
@@ -947,6 +950,7 @@ func New(
 	app.HardForkManager.RegisterHandler(v0upgrade.NewHardForkUpgradeHandler(100_000, upgrades.ChainIDKiiHardForkTest, app.WasmKeeper))
 
 	app.RegisterDeliverTxHook(app.AddCosmosEventsToEVMReceiptIfApplicable)
+	app.EvmKeeper.CosmosEventsTranslator = app.TranslateCosmosEventsToEVMLogs
 
 	return app
 }
@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"strings"
 
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/kiichain/kiichain3/utils"
@@ -24,9 +26,20 @@ var ERC20TransferTopic = common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952
 var ERC721TransferTopic = common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
 var ERC721ApprovalTopic = common.HexToHash("0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925")
 var ERC721ApproveAllTopic = common.HexToHash("0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31")
+var ERC1155TransferSingleTopic = common.HexToHash("0xc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62")
+var ERC1155TransferBatchTopic = common.HexToHash("0x4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb")
+var ERC1155ApproveAllTopic = common.HexToHash("0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31")
 var EmptyHash = common.HexToHash("0x0")
 var TrueHash = common.HexToHash("0x1")
 
+var erc1155TransferBatchData = func() abi.Arguments {
+	uint256Arr, err := abi.NewType("uint256[]", "", nil)
+	if err != nil {
+		panic(err)
+	}
+	return abi.Arguments{{Type: uint256Arr}, {Type: uint256Arr}}
+}()
+
 type AllowanceResponse struct {
 	Allowance sdk.Int         `json:"allowance"`
 	Expires   json.RawMessage `json:"expires"`
@@ -38,35 +51,7 @@ func (app *App) AddCosmosEventsToEVMReceiptIfApplicable(ctx sdk.Context, tx sdk.
 	if len(wasmEvents) == 0 {
 		return
 	}
-	logs := []*ethtypes.Log{}
-	// wasmGasLimit := app.EvmKeeper.GetDeliverTxHookWasmGasLimit(ctx)
-	queryCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter(1, 1))
-	for _, wasmEvent := range wasmEvents {
-		contractAddr, found := GetAttributeValue(wasmEvent, wasmtypes.AttributeKeyContractAddr)
-		if !found {
-			continue
-		}
-		// check if there is a ERC20 pointer to contractAddr
-		pointerAddr, _, exists := app.EvmKeeper.GetERC20CW20Pointer(queryCtx, contractAddr)
-		if exists {
-			log, eligible := app.translateCW20Event(queryCtx, wasmEvent, pointerAddr, contractAddr)
-			if eligible {
-				log.Index = uint(len(logs))
-				logs = append(logs, log)
-			}
-			continue
-		}
-		// check if there is a ERC721 pointer to contract Addr
-		pointerAddr, _, exists = app.EvmKeeper.GetERC721CW721Pointer(queryCtx, contractAddr)
-		if exists {
-			log, eligible := app.translateCW721Event(queryCtx, wasmEvent, pointerAddr, contractAddr)
-			if eligible {
-				log.Index = uint(len(logs))
-				logs = append(logs, log)
-			}
-			continue
-		}
-	}
+	logs := app.TranslateCosmosEventsToEVMLogs(ctx, wasmEvents)
 	if len(logs) == 0 {
 		return
 	}
@@ -103,10 +88,59 @@ func (app *App) AddCosmosEventsToEVMReceiptIfApplicable(ctx sdk.Context, tx sdk.
 		_ = app.EvmKeeper.SetTransientReceipt(ctx, txHash, receipt)
 	}
 	if d, found := app.EvmKeeper.GetEVMTxDeferredInfo(ctx); found {
-		app.EvmKeeper.AppendToEvmTxDeferredInfo(ctx, bloom, txHash, d.Surplus)
+		app.EvmKeeper.AppendToEvmTxDeferredInfo(ctx, bloom, txHash, d.Surplus, d.BaseFee)
 	} else {
-		app.EvmKeeper.AppendToEvmTxDeferredInfo(ctx, bloom, txHash, sdk.ZeroInt())
+		app.EvmKeeper.AppendToEvmTxDeferredInfo(ctx, bloom, txHash, sdk.ZeroInt(), sdk.ZeroInt())
+	}
+}
+
+// TranslateCosmosEventsToEVMLogs returns the EVM logs of the pointers of the
+// wasm contracts that emitted the given events. Events of other types and of
+// contracts without pointers are ignored.
+func (app *App) TranslateCosmosEventsToEVMLogs(ctx sdk.Context, events []abci.Event) []*ethtypes.Log {
+	logs := []*ethtypes.Log{}
+	// wasmGasLimit := app.EvmKeeper.GetDeliverTxHookWasmGasLimit(ctx)
+	queryCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter(1, 1))
+	for _, wasmEvent := range events {
+		if wasmEvent.Type != wasmtypes.WasmModuleEventType {
+			continue
+		}
+		contractAddr, found := GetAttributeValue(wasmEvent, wasmtypes.AttributeKeyContractAddr)
+		if !found {
+			continue
+		}
+		// check if there is a ERC20 pointer to contractAddr
+		pointerAddr, _, exists := app.EvmKeeper.GetERC20CW20Pointer(queryCtx, contractAddr)
+		if exists {
+			log, eligible := app.translateCW20Event(queryCtx, wasmEvent, pointerAddr, contractAddr)
+			if eligible {
+				log.Index = uint(len(logs))
+				logs = append(logs, log)
+			}
+			continue
+		}
+		// check if there is a ERC721 pointer to contract Addr
+		pointerAddr, _, exists = app.EvmKeeper.GetERC721CW721Pointer(queryCtx, contractAddr)
+		if exists {
+			log, eligible := app.translateCW721Event(queryCtx, wasmEvent, pointerAddr, contractAddr)
+			if eligible {
+				log.Index = uint(len(logs))
+				logs = append(logs, log)
+			}
+			continue
+		}
+		// check if there is a ERC1155 pointer to contract Addr
+		pointerAddr, _, exists = app.EvmKeeper.GetERC1155CW1155Pointer(queryCtx, contractAddr)
+		if exists {
+			log, eligible := app.translateCW1155Event(queryCtx, wasmEvent, pointerAddr)
+			if eligible {
+				log.Index = uint(len(logs))
+				logs = append(logs, log)
+			}
+			continue
+		}
 	}
+	return logs
 }
 
 func (app *App) translateCW20Event(ctx sdk.Context, wasmEvent abci.Event, pointerAddr common.Address, contractAddr string) (*ethtypes.Log, bool) {
@@ -269,6 +303,85 @@ func (app *App) translateCW721Event(ctx sdk.Context, wasmEvent abci.Event, point
 	return nil, false
 }
 
+func (app *App) translateCW1155Event(ctx sdk.Context, wasmEvent abci.Event, pointerAddr common.Address) (*ethtypes.Log, bool) {
+	action, found := GetAttributeValue(wasmEvent, "action")
+	if !found {
+		return nil, false
+	}
+	var topics []common.Hash
+	switch action {
+	case "transfer_single", "mint_single", "burn_single":
+		tokenID := GetTokenIDAttribute(wasmEvent)
+		if tokenID == nil {
+			return nil, false
+		}
+		amount, found := GetAmountAttribute(wasmEvent)
+		if !found {
+			return nil, false
+		}
+		// `owner` is absent on mints and `recipient` is absent on burns, which
+		// leaves the corresponding topic as the zero address
+		topics = []common.Hash{
+			ERC1155TransferSingleTopic,
+			app.GetEvmAddressAttribute(ctx, wasmEvent, "sender"),
+			app.GetEvmAddressAttribute(ctx, wasmEvent, "owner"),
+			app.GetEvmAddressAttribute(ctx, wasmEvent, "recipient"),
+		}
+		return &ethtypes.Log{
+			Address: pointerAddr,
+			Topics:  topics,
+			Data:    append(common.BigToHash(tokenID).Bytes(), common.BigToHash(amount).Bytes()...),
+		}, true
+	case "transfer_batch", "mint_batch", "burn_batch":
+		tokenIDs, found := GetBigIntListAttribute(wasmEvent, "token_ids")
+		if !found {
+			return nil, false
+		}
+		amounts, found := GetBigIntListAttribute(wasmEvent, "amounts")
+		if !found || len(amounts) != len(tokenIDs) {
+			return nil, false
+		}
+		data, err := erc1155TransferBatchData.Pack(tokenIDs, amounts)
+		if err != nil {
+			return nil, false
+		}
+		topics = []common.Hash{
+			ERC1155TransferBatchTopic,
+			app.GetEvmAddressAttribute(ctx, wasmEvent, "sender"),
+			app.GetEvmAddressAttribute(ctx, wasmEvent, "owner"),
+			app.GetEvmAddressAttribute(ctx, wasmEvent, "recipient"),
+		}
+		return &ethtypes.Log{
+			Address: pointerAddr,
+			Topics:  topics,
+			Data:    data,
+		}, true
+	case "approve_all":
+		topics = []common.Hash{
+			ERC1155ApproveAllTopic,
+			app.GetEvmAddressAttribute(ctx, wasmEvent, "sender"),
+			app.GetEvmAddressAttribute(ctx, wasmEvent, "operator"),
+		}
+		return &ethtypes.Log{
+			Address: pointerAddr,
+			Topics:  topics,
+			Data:    TrueHash.Bytes(),
+		}, true
+	case "revoke_all":
+		topics = []common.Hash{
+			ERC1155ApproveAllTopic,
+			app.GetEvmAddressAttribute(ctx, wasmEvent, "sender"),
+			app.GetEvmAddressAttribute(ctx, wasmEvent, "operator"),
+		}
+		return &ethtypes.Log{
+			Address: pointerAddr,
+			Topics:  topics,
+			Data:    EmptyHash.Bytes(),
+		}, true
+	}
+	return nil, false
+}
+
 func (app *App) GetEvmAddressAttribute(ctx sdk.Context, event abci.Event, attribute string) common.Hash {
 	addrStr, found := GetAttributeValue(event, attribute)
 	if found {
@@ -321,3 +434,21 @@ func GetTokenIDAttribute(event abci.Event) *big.Int {
 	}
 	return tokenIDInt.BigInt()
 }
+
+// GetBigIntListAttribute parses a comma-separated list of integers, as emitted
+// by cw1155 batch actions.
+func GetBigIntListAttribute(event abci.Event, attribute string) ([]*big.Int, bool) {
+	value, found := GetAttributeValue(event, attribute)
+	if !found || value == "" {
+		return nil, false
+	}
+	res := []*big.Int{}
+	for _, s := range strings.Split(value, ",") {
+		i, ok := sdk.NewIntFromString(strings.TrimSpace(s))
+		if !ok {
+			return nil, false
+		}
+		res = append(res, i.BigInt())
+	}
+	return res, true
+}
@@ -18,9 +18,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	xauthsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/kiichain/kiichain3/app"
 	pcommon "github.com/kiichain/kiichain3/precompiles/common"
 	"github.com/kiichain/kiichain3/precompiles/wasmd"
 	testkeeper "github.com/kiichain/kiichain3/testutil/keeper"
@@ -405,3 +407,58 @@ func signTx(txBuilder client.TxBuilder, privKey cryptotypes.PrivKey, acc authtyp
 	_ = txBuilder.SetSignatures(sigsV2...)
 	return txBuilder.GetTx()
 }
+
+func TestEvmEventsForCw1155(t *testing.T) {
+	k := testkeeper.EVMTestApp.EvmKeeper
+	ctx := testkeeper.EVMTestApp.GetContextForDeliverTx([]byte{}).WithBlockTime(time.Now())
+	contractAddr, _ := testkeeper.MockAddressPair()
+	_, mockPointerAddr := testkeeper.MockAddressPair()
+	require.Nil(t, k.SetERC1155CW1155Pointer(ctx, contractAddr.String(), mockPointerAddr))
+	sender, senderEvmAddr := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx, sender, senderEvmAddr)
+	recipient, recipientEvmAddr := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx, recipient, recipientEvmAddr)
+	wasmEvent := func(attrs ...string) abci.Event {
+		e := abci.Event{Type: wasmtypes.WasmModuleEventType, Attributes: []abci.EventAttribute{
+			{Key: wasmtypes.AttributeKeyContractAddr, Value: contractAddr.String()},
+		}}
+		for i := 0; i < len(attrs); i += 2 {
+			e.Attributes = append(e.Attributes, abci.EventAttribute{Key: attrs[i], Value: attrs[i+1]})
+		}
+		return e
+	}
+
+	logs := testkeeper.EVMTestApp.TranslateCosmosEventsToEVMLogs(ctx, []abci.Event{
+		wasmEvent("action", "transfer_single", "sender", sender.String(), "owner", sender.String(), "recipient", recipient.String(), "token_id", "3", "amount", "7"),
+		wasmEvent("action", "mint_batch", "sender", sender.String(), "recipient", recipient.String(), "token_ids", "1,2", "amounts", "10,20"),
+		wasmEvent("action", "approve_all", "sender", sender.String(), "operator", recipient.String()),
+		wasmEvent("action", "transfer_batch", "sender", sender.String(), "token_ids", "1,2", "amounts", "10"),
+	})
+	require.Equal(t, 3, len(logs))
+
+	require.Equal(t, mockPointerAddr, logs[0].Address)
+	require.Equal(t, []common.Hash{
+		app.ERC1155TransferSingleTopic,
+		common.BytesToHash(senderEvmAddr[:]),
+		common.BytesToHash(senderEvmAddr[:]),
+		common.BytesToHash(recipientEvmAddr[:]),
+	}, logs[0].Topics)
+	require.Equal(t, append(common.BigToHash(big.NewInt(3)).Bytes(), common.BigToHash(big.NewInt(7)).Bytes()...), logs[0].Data)
+
+	require.Equal(t, uint(1), logs[1].Index)
+	require.Equal(t, []common.Hash{
+		app.ERC1155TransferBatchTopic,
+		common.BytesToHash(senderEvmAddr[:]),
+		app.EmptyHash,
+		common.BytesToHash(recipientEvmAddr[:]),
+	}, logs[1].Topics)
+	uint256Arr, err := ethabi.NewType("uint256[]", "", nil)
+	require.Nil(t, err)
+	values, err := ethabi.Arguments{{Type: uint256Arr}, {Type: uint256Arr}}.Unpack(logs[1].Data)
+	require.Nil(t, err)
+	require.Equal(t, []*big.Int{big.NewInt(1), big.NewInt(2)}, values[0])
+	require.Equal(t, []*big.Int{big.NewInt(10), big.NewInt(20)}, values[1])
+
+	require.Equal(t, app.ERC1155ApproveAllTopic, logs[2].Topics[0])
+	require.Equal(t, app.TrueHash.Bytes(), logs[2].Data)
+}
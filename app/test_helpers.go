@@ -16,6 +16,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	tokenfactorykeeper "github.com/kiichain/kiichain3/x/tokenfactory/keeper"
+	seidbtypes "github.com/sei-protocol/sei-db/ss/types"
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/config"
@@ -176,13 +177,19 @@ func (s *TestWrapper) EndBlock() {
 }
 
 func Setup(isCheckTx bool, enableEVMCustomPrecompiles bool, baseAppOptions ...func(*baseapp.BaseApp)) (res *App) {
+	return SetupWithReceiptStore(isCheckTx, enableEVMCustomPrecompiles, NewInMemoryStateStore(), baseAppOptions...)
+}
+
+// SetupWithReceiptStore is Setup with the given receipt store in place of the
+// default in-memory one.
+func SetupWithReceiptStore(isCheckTx bool, enableEVMCustomPrecompiles bool, receiptStore seidbtypes.StateStore, baseAppOptions ...func(*baseapp.BaseApp)) (res *App) {
 	db := dbm.NewMemDB()
 	encodingConfig := MakeEncodingConfig()
 	cdc := encodingConfig.Marshaler
 
 	options := []AppOption{
 		func(app *App) {
-			app.receiptStore = NewInMemoryStateStore()
+			app.receiptStore = receiptStore
 		},
 	}
 
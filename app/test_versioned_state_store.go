@@ -0,0 +1,132 @@
+package app
+
+import (
+	"errors"
+	"sort"
+
+	seidbproto "github.com/sei-protocol/sei-db/proto"
+	"github.com/sei-protocol/sei-db/ss/types"
+)
+
+// VersionedInMemoryStateStore is an InMemoryStateStore that reads versions the
+// way the pebble store does: a key has its value at the latest version that is
+// not greater than the one requested, and deletes hide earlier versions. Tests
+// of indexes that are written over many blocks and read at the latest version
+// need it, while InMemoryStateStore only sees the keys written at exactly the
+// requested version.
+type VersionedInMemoryStateStore struct {
+	*InMemoryStateStore
+}
+
+func NewVersionedInMemoryStateStore() *VersionedInMemoryStateStore {
+	return &VersionedInMemoryStateStore{InMemoryStateStore: NewInMemoryStateStore()}
+}
+
+// Get returns the value of the key at the latest version that is not greater
+// than the given version, and nil if the key was never written or is deleted.
+func (s *VersionedInMemoryStateStore) Get(storeKey string, version int64, key []byte) ([]byte, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if version < s.earliestVersion {
+		return nil, errors.New("version pruned")
+	}
+	var (
+		value        []byte
+		valueVersion int64 = -1
+	)
+	for v, versionData := range s.data[storeKey] {
+		if v > version || v <= valueVersion {
+			continue
+		}
+		if bz, ok := versionData[string(key)]; ok {
+			value, valueVersion = bz, v
+		}
+	}
+	return value, nil
+}
+
+func (s *VersionedInMemoryStateStore) Has(storeKey string, version int64, key []byte) (bool, error) {
+	value, err := s.Get(storeKey, version, key)
+	return value != nil, err
+}
+
+func (s *VersionedInMemoryStateStore) Iterator(storeKey string, version int64, start, end []byte) (types.DBIterator, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return NewInMemoryIterator(s.dataAtVersion(storeKey, version), start, end), nil
+}
+
+func (s *VersionedInMemoryStateStore) ReverseIterator(storeKey string, version int64, start, end []byte) (types.DBIterator, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	iter := NewInMemoryIterator(s.dataAtVersion(storeKey, version), start, end)
+
+	// Reverse the keys for reverse iteration
+	for i, j := 0, len(iter.keys)-1; i < j; i, j = i+1, j-1 {
+		iter.keys[i], iter.keys[j] = iter.keys[j], iter.keys[i]
+	}
+
+	return iter, nil
+}
+
+// dataAtVersion returns the live keys of a store as of a version. Deletions are
+// recorded as nil values.
+func (s *VersionedInMemoryStateStore) dataAtVersion(storeKey string, version int64) map[string][]byte {
+	versions := []int64{}
+	for v := range s.data[storeKey] {
+		if v <= version {
+			versions = append(versions, v)
+		}
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i] < versions[j] })
+	data := map[string][]byte{}
+	for _, v := range versions {
+		for key, value := range s.data[storeKey][v] {
+			if value == nil {
+				delete(data, key)
+			} else {
+				data[key] = value
+			}
+		}
+	}
+	return data
+}
+
+func (s *VersionedInMemoryStateStore) ApplyChangeset(version int64, cs *seidbproto.NamedChangeSet) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, pair := range cs.Changeset.Pairs {
+		storeKey := cs.Name
+
+		if s.data[storeKey] == nil {
+			s.data[storeKey] = make(map[int64]map[string][]byte)
+		}
+
+		if s.data[storeKey][version] == nil {
+			s.data[storeKey][version] = make(map[string][]byte)
+		}
+
+		if pair.Delete {
+			// keep a tombstone so that earlier versions of the key are hidden
+			s.data[storeKey][version][string(pair.Key)] = nil
+		} else {
+			s.data[storeKey][version][string(pair.Key)] = pair.Value
+		}
+	}
+
+	s.latestVersion = version
+	return nil
+}
+
+func (s *VersionedInMemoryStateStore) ApplyChangesetAsync(version int64, changesets []*seidbproto.NamedChangeSet) error {
+	for _, cs := range changesets {
+		if err := s.ApplyChangeset(version, cs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
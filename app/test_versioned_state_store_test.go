@@ -0,0 +1,62 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/cosmos/iavl"
+	seidbproto "github.com/sei-protocol/sei-db/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionedGetAndIterate(t *testing.T) {
+	store := NewVersionedInMemoryStateStore()
+
+	assert.NoError(t, store.ApplyChangeset(1, &seidbproto.NamedChangeSet{
+		Changeset: iavl.ChangeSet{
+			Pairs: []*iavl.KVPair{
+				{Key: []byte("key1"), Value: []byte("value1")},
+				{Key: []byte("key2"), Value: []byte("value2")},
+			},
+		},
+		Name: "exampleStore",
+	}))
+	assert.NoError(t, store.ApplyChangesetAsync(3, []*seidbproto.NamedChangeSet{{
+		Changeset: iavl.ChangeSet{
+			Pairs: []*iavl.KVPair{
+				{Key: []byte("key1"), Value: []byte("value1b")},
+				{Key: []byte("key2"), Delete: true},
+			},
+		},
+		Name: "exampleStore",
+	}}))
+
+	// earlier writes are visible at later versions
+	value, err := store.Get("exampleStore", 2, []byte("key1"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("value1"), value)
+	value, err = store.Get("exampleStore", 3, []byte("key1"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("value1b"), value)
+
+	// deletes hide earlier versions
+	has, err := store.Has("exampleStore", 2, []byte("key2"))
+	assert.NoError(t, err)
+	assert.True(t, has)
+	has, err = store.Has("exampleStore", 3, []byte("key2"))
+	assert.NoError(t, err)
+	assert.False(t, has)
+
+	iter, err := store.Iterator("exampleStore", 2, nil, nil)
+	assert.NoError(t, err)
+	keys := []string{}
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, string(iter.Key()))
+	}
+	assert.Equal(t, []string{"key1", "key2"}, keys)
+	iter, err = store.ReverseIterator("exampleStore", 3, nil, nil)
+	assert.NoError(t, err)
+	assert.True(t, iter.Valid())
+	assert.Equal(t, []byte("value1b"), iter.Value())
+	iter.Next()
+	assert.False(t, iter.Valid())
+}
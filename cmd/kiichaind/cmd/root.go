@@ -534,6 +534,96 @@ max_blocks_for_log = {{ .EVM.MaxBlocksForLog }}
 # max number of concurrent NewHead subscriptions
 max_subscriptions_new_head = {{ .EVM.MaxSubscriptionsNewHead }}
 
+# max number of concurrent NewPendingTransactions subscriptions
+max_subscriptions_new_pending_txs = {{ .EVM.MaxSubscriptionsNewPendingTxs }}
+
+# max number of concurrent Syncing subscriptions
+max_subscriptions_syncing = {{ .EVM.MaxSubscriptionsSyncing }}
+
+# max number of goroutines used to scan block blooms and fetch logs for a log query
+bloom_scan_workers = {{ .EVM.BloomScanWorkers }}
+
+# builds a bloom-bits index in the receipt store and uses it to answer log queries
+enable_bloom_bits_index = {{ .EVM.EnableBloomBitsIndex }}
+
+# token bucket rate limits per client (JWT subject, or IP if unauthenticated), each
+# entry is "<method|namespace|*>=<requests per second>[:<burst>]" and the most
+# specific entry for a method applies, e.g. ["*=100:200", "debug=1", "eth_getLogs=5:10"]
+rate_limits = [{{ range $i, $v := .EVM.RateLimits }}{{ if $i }}, {{ end }}"{{ $v }}"{{ end }}]
+
+# max number of requests in a batch, 0 for unlimited
+max_batch_size = {{ .EVM.MaxBatchSize }}
+
+# max size in bytes of a response, 0 for unlimited
+max_response_bytes = {{ .EVM.MaxResponseBytes }}
+
+# max number of heavy calls served concurrently, 0 for unlimited
+max_concurrent_heavy_calls = {{ .EVM.MaxConcurrentHeavyCalls }}
+
+# methods counted as heavy calls, entries ending with "*" match by prefix
+heavy_methods = [{{ range $i, $v := .EVM.HeavyMethods }}{{ if $i }}, {{ end }}"{{ $v }}"{{ end }}]
+
+# serves queries at the "pending" block tag from a state on which the executable
+# EVM txs of the mempool are speculatively applied
+enable_pending_state = {{ .EVM.EnablePendingState }}
+
+# max number of mempool txs applied to the pending state
+pending_state_max_txs = {{ .EVM.PendingStateMaxTxs }}
+
+# how long the pending state is reused before it is rebuilt
+pending_state_refresh_interval = "{{ .EVM.PendingStateRefreshInterval }}"
+
+# number of recent blocks sampled by the gas price oracle
+gas_price_oracle_blocks = {{ .EVM.GasPriceOracleBlocks }}
+
+# percentile of the sampled tips suggested by the gas price oracle, from 0 to 100
+gas_price_oracle_percentile = {{ .EVM.GasPriceOraclePercentile }}
+
+# tips in wei below which txs are ignored by the gas price oracle
+gas_price_oracle_ignore_price = {{ .EVM.GasPriceOracleIgnorePrice }}
+
+# max tip in wei suggested by the gas price oracle, 0 for no cap
+gas_price_oracle_max_price = {{ .EVM.GasPriceOracleMaxPrice }}
+
+# max time eth_sendRawTransactionSync waits for the receipt of a tx
+send_raw_transaction_sync_max_timeout = "{{ .EVM.SendRawTransactionSyncMaxTimeout }}"
+
+# max number of entries of each kind (blocks, receipts, txs) cached for
+# committed blocks, 0 to disable the cache
+response_cache_size = {{ .EVM.ResponseCacheSize }}
+
+# whether to index the txs of each address for kii_getTransactionsByAddress
+enable_address_tx_index = {{ .EVM.EnableAddressTxIndex }}
+
+# number of recent blocks kept in the address tx index, 0 to keep all
+address_tx_index_retention = {{ .EVM.AddressTxIndexRetention }}
+
+# JSON-RPC endpoint of an archive node to which state and trace queries at
+# heights pruned from this node are forwarded, empty to disable forwarding
+archive_fallback_url = "{{ .EVM.ArchiveFallbackURL }}"
+
+# methods that may be forwarded to the archive node, entries ending with "*"
+# match by prefix
+archive_fallback_methods = [{{ range $i, $v := .EVM.ArchiveFallbackMethods }}{{ if $i }}, {{ end }}"{{ $v }}"{{ end }}]
+
+# max time to wait for the answer of the archive node
+archive_fallback_timeout = "{{ .EVM.ArchiveFallbackTimeout }}"
+
+# methods that can only be called with an API key or a JWT granted access to
+# them, entries are methods, namespaces or "*"
+restricted_methods = [{{ range $i, $v := .EVM.RestrictedMethods }}{{ if $i }}, {{ end }}"{{ $v }}"{{ end }}]
+
+# credentials granted access to restricted methods, each entry is
+# "<key:<name>:<api key>|jwt:<subject>>=<scope>[,<scope>...][@<requests per second>[:<burst>]]"
+# where scopes are methods, namespaces or "*" and the optional rate is the
+# quota of the credential; API keys are sent in the X-Api-Key header or the
+# api_key query parameter
+access_policies = [{{ range $i, $v := .EVM.AccessPolicies }}{{ if $i }}, {{ end }}"{{ $v }}"{{ end }}]
+
+# serve GraphQL queries (EIP-1767) at /graphql on the HTTP server; queries
+# are limited, restricted and denied as calls to graphql_query
+enable_graphql = {{ .EVM.EnableGraphQL }}
+
 [eth_replay]
 eth_replay_enabled = {{ .ETHReplay.Enabled }}
 eth_rpc = "{{ .ETHReplay.EthRPC }}"
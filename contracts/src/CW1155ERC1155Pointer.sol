@@ -0,0 +1,214 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.12;
+
+import "@openzeppelin/contracts/token/ERC1155/IERC1155.sol";
+import "@openzeppelin/contracts/token/ERC1155/IERC1155Receiver.sol";
+import "@openzeppelin/contracts/token/ERC1155/extensions/IERC1155MetadataURI.sol";
+import "@openzeppelin/contracts/utils/Strings.sol";
+import {IERC165} from "@openzeppelin/contracts/utils/introspection/IERC165.sol";
+import {IERC1155Errors} from "@openzeppelin/contracts/interfaces/draft-IERC6093.sol";
+import {IWasmd} from "./precompiles/IWasmd.sol";
+import {IJson} from "./precompiles/IJson.sol";
+import {IAddr} from "./precompiles/IAddr.sol";
+
+contract CW1155ERC1155Pointer is IERC1155MetadataURI, IERC1155Errors {
+
+    address constant WASMD_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000001002;
+    address constant JSON_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000001003;
+    address constant ADDR_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000001004;
+
+    string public Cw1155Address;
+    IWasmd public WasmdPrecompile;
+    IJson public JsonPrecompile;
+    IAddr public AddrPrecompile;
+    string public name;
+    string public symbol;
+
+    constructor(string memory Cw1155Address_, string memory name_, string memory symbol_) {
+        WasmdPrecompile = IWasmd(WASMD_PRECOMPILE_ADDRESS);
+        JsonPrecompile = IJson(JSON_PRECOMPILE_ADDRESS);
+        AddrPrecompile = IAddr(ADDR_PRECOMPILE_ADDRESS);
+        Cw1155Address = Cw1155Address_;
+        name = name_;
+        symbol = symbol_;
+    }
+
+    function supportsInterface(bytes4 interfaceId) public pure override returns (bool) {
+        return
+            interfaceId == type(IERC165).interfaceId ||
+            interfaceId == type(IERC1155).interfaceId ||
+            interfaceId == type(IERC1155MetadataURI).interfaceId;
+    }
+
+    // Queries
+    function balanceOf(address account, uint256 id) public view override returns (uint256) {
+        string memory req = _curlyBrace(_formatPayload("balance_of", _ownerToken(account, id)));
+        bytes memory response = WasmdPrecompile.query(Cw1155Address, bytes(req));
+        return JsonPrecompile.extractAsUint256(response, "balance");
+    }
+
+    function balanceOfBatch(address[] memory accounts, uint256[] memory ids) public view override returns (uint256[] memory) {
+        if (accounts.length != ids.length) {
+            revert ERC1155InvalidArrayLength(ids.length, accounts.length);
+        }
+        uint256[] memory balances = new uint256[](accounts.length);
+        if (accounts.length == 0) {
+            return balances;
+        }
+        string memory ownerTokens = _ownerToken(accounts[0], ids[0]);
+        for (uint256 i = 1; i < accounts.length; i++) {
+            ownerTokens = _join(ownerTokens, _ownerToken(accounts[i], ids[i]), ",");
+        }
+        string memory req = _curlyBrace(_formatPayload("balance_of_batch", string.concat("[", string.concat(ownerTokens, "]"))));
+        bytes memory response = WasmdPrecompile.query(Cw1155Address, bytes(req));
+        bytes[] memory parsed = JsonPrecompile.extractAsBytesList(response, "balances");
+        for (uint256 i = 0; i < parsed.length && i < balances.length; i++) {
+            balances[i] = JsonPrecompile.extractAsUint256(parsed[i], "amount");
+        }
+        return balances;
+    }
+
+    function isApprovedForAll(address account, address operator) public view override returns (bool) {
+        string memory o = _formatPayload("owner", _doubleQuotes(AddrPrecompile.getKiiAddr(account)));
+        string memory op = _formatPayload("operator", _doubleQuotes(AddrPrecompile.getKiiAddr(operator)));
+        string memory req = _curlyBrace(_formatPayload("is_approved_for_all", _curlyBrace(_join(o, op, ","))));
+        bytes memory response = WasmdPrecompile.query(Cw1155Address, bytes(req));
+        bytes memory approved = JsonPrecompile.extractAsBytes(response, "approved");
+        return keccak256(approved) == keccak256("true");
+    }
+
+    function uri(uint256 id) public view override returns (string memory) {
+        string memory tId = _formatPayload("token_id", _doubleQuotes(Strings.toString(id)));
+        string memory req = _curlyBrace(_formatPayload("token_info", _curlyBrace(tId)));
+        bytes memory response = WasmdPrecompile.query(Cw1155Address, bytes(req));
+        bytes memory tokenUri = JsonPrecompile.extractAsBytes(response, "token_uri");
+        if (keccak256(tokenUri) == keccak256("null")) {
+            return "";
+        }
+        return string(tokenUri);
+    }
+
+    // 1155-Supply
+    function totalSupply(uint256 id) public view virtual returns (uint256) {
+        string memory tId = _formatPayload("token_id", _doubleQuotes(Strings.toString(id)));
+        string memory req = _curlyBrace(_formatPayload("num_tokens", _curlyBrace(tId)));
+        bytes memory response = WasmdPrecompile.query(Cw1155Address, bytes(req));
+        return JsonPrecompile.extractAsUint256(response, "count");
+    }
+
+    function exists(uint256 id) public view virtual returns (bool) {
+        return totalSupply(id) > 0;
+    }
+
+    // Transactions
+    function safeTransferFrom(address from, address to, uint256 id, uint256 amount, bytes memory data) public override {
+        if (to == address(0)) {
+            revert ERC1155InvalidReceiver(address(0));
+        }
+        string memory f = _formatPayload("from", _doubleQuotes(AddrPrecompile.getKiiAddr(from)));
+        string memory t = _formatPayload("to", _doubleQuotes(AddrPrecompile.getKiiAddr(to)));
+        string memory tId = _formatPayload("token_id", _doubleQuotes(Strings.toString(id)));
+        string memory amt = _formatPayload("amount", _doubleQuotes(Strings.toString(amount)));
+        string memory req = _curlyBrace(_formatPayload("send", _curlyBrace(_join(_join(f, t, ","), _join(tId, amt, ","), ","))));
+        _execute(bytes(req));
+        _checkOnERC1155Received(from, to, id, amount, data);
+    }
+
+    function safeBatchTransferFrom(address from, address to, uint256[] memory ids, uint256[] memory amounts, bytes memory data) public override {
+        if (to == address(0)) {
+            revert ERC1155InvalidReceiver(address(0));
+        }
+        if (ids.length != amounts.length) {
+            revert ERC1155InvalidArrayLength(ids.length, amounts.length);
+        }
+        if (ids.length == 0) {
+            return;
+        }
+        string memory batch = _tokenAmount(ids[0], amounts[0]);
+        for (uint256 i = 1; i < ids.length; i++) {
+            batch = _join(batch, _tokenAmount(ids[i], amounts[i]), ",");
+        }
+        string memory f = _formatPayload("from", _doubleQuotes(AddrPrecompile.getKiiAddr(from)));
+        string memory t = _formatPayload("to", _doubleQuotes(AddrPrecompile.getKiiAddr(to)));
+        string memory b = _formatPayload("batch", string.concat("[", string.concat(batch, "]")));
+        string memory req = _curlyBrace(_formatPayload("send_batch", _curlyBrace(_join(_join(f, t, ","), b, ","))));
+        _execute(bytes(req));
+        _checkOnERC1155BatchReceived(from, to, ids, amounts, data);
+    }
+
+    function setApprovalForAll(address operator, bool approved) public override {
+        string memory op = _curlyBrace(_formatPayload("operator", _doubleQuotes(AddrPrecompile.getKiiAddr(operator))));
+        if (approved) {
+            _execute(bytes(_curlyBrace(_formatPayload("approve_all", op))));
+        } else {
+            _execute(bytes(_curlyBrace(_formatPayload("revoke_all", op))));
+        }
+    }
+
+    function _execute(bytes memory req) internal returns (bytes memory) {
+        (bool success, bytes memory ret) = WASMD_PRECOMPILE_ADDRESS.delegatecall(
+            abi.encodeWithSignature(
+                "execute(string,bytes,bytes)",
+                Cw1155Address,
+                bytes(req),
+                bytes("[]")
+            )
+        );
+        require(success, "CosmWasm execute failed");
+        return ret;
+    }
+
+    function _checkOnERC1155Received(address from, address to, uint256 id, uint256 amount, bytes memory data) internal {
+        if (to.code.length == 0) {
+            return;
+        }
+        try IERC1155Receiver(to).onERC1155Received(msg.sender, from, id, amount, data) returns (bytes4 response) {
+            if (response != IERC1155Receiver.onERC1155Received.selector) {
+                revert ERC1155InvalidReceiver(to);
+            }
+        } catch {
+            revert ERC1155InvalidReceiver(to);
+        }
+    }
+
+    function _checkOnERC1155BatchReceived(address from, address to, uint256[] memory ids, uint256[] memory amounts, bytes memory data) internal {
+        if (to.code.length == 0) {
+            return;
+        }
+        try IERC1155Receiver(to).onERC1155BatchReceived(msg.sender, from, ids, amounts, data) returns (bytes4 response) {
+            if (response != IERC1155Receiver.onERC1155BatchReceived.selector) {
+                revert ERC1155InvalidReceiver(to);
+            }
+        } catch {
+            revert ERC1155InvalidReceiver(to);
+        }
+    }
+
+    function _ownerToken(address owner, uint256 id) internal view returns (string memory) {
+        string memory o = _formatPayload("owner", _doubleQuotes(AddrPrecompile.getKiiAddr(owner)));
+        string memory tId = _formatPayload("token_id", _doubleQuotes(Strings.toString(id)));
+        return _curlyBrace(_join(o, tId, ","));
+    }
+
+    function _tokenAmount(uint256 id, uint256 amount) internal pure returns (string memory) {
+        string memory tId = _formatPayload("token_id", _doubleQuotes(Strings.toString(id)));
+        string memory amt = _formatPayload("amount", _doubleQuotes(Strings.toString(amount)));
+        return _curlyBrace(_join(tId, amt, ","));
+    }
+
+    function _formatPayload(string memory key, string memory value) internal pure returns (string memory) {
+        return _join(_doubleQuotes(key), value, ":");
+    }
+
+    function _curlyBrace(string memory s) internal pure returns (string memory) {
+        return string.concat("{", string.concat(s, "}"));
+    }
+
+    function _doubleQuotes(string memory s) internal pure returns (string memory) {
+        return string.concat("\"", string.concat(s, "\""));
+    }
+
+    function _join(string memory a, string memory b, string memory separator) internal pure returns (string memory) {
+        return string.concat(a, string.concat(separator, b));
+    }
+}
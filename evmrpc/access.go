@@ -0,0 +1,207 @@
+package evmrpc
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/kiichain/kiichain3/utils/metrics"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// UnauthorizedErrorCode is the JSON-RPC error code for calls to restricted
+	// methods made without an API key or a JWT.
+	UnauthorizedErrorCode = -32001
+
+	// API keys are read from this header, or from the query parameter for
+	// websocket clients that can't set headers
+	apiKeyHeader     = "X-Api-Key"
+	apiKeyQueryParam = "api_key"
+)
+
+type unauthorizedError struct{ method string }
+
+func (e *unauthorizedError) ErrorCode() int { return UnauthorizedErrorCode }
+
+func (e *unauthorizedError) Error() string {
+	return fmt.Sprintf("the method %s requires an API key", e.method)
+}
+
+// accessGrant is what a credential gives access to.
+type accessGrant struct {
+	client  string        // "key:<name>" or "jwt:<subject>", never the API key itself
+	scopes  []string      // methods, namespaces or "*"
+	limiter *rate.Limiter // nil if the credential has no quota
+}
+
+func (g *accessGrant) allows(method string) bool {
+	return matchesScope(g.scopes, method)
+}
+
+// AccessPolicy restricts methods of one EVM RPC server to the clients holding
+// an API key or a JWT granted access to them, and enforces the quota of each
+// credential. Methods that aren't restricted are open to everyone.
+type AccessPolicy struct {
+	connectionType ConnectionType
+	restricted     []string                // methods, namespaces or "*"
+	keys           map[string]*accessGrant // by API key
+	subjects       map[string]*accessGrant // by JWT subject
+}
+
+// NewAccessPolicy returns the access policy of the config, or nil if it
+// restricts nothing.
+func NewAccessPolicy(config Config, connectionType ConnectionType) (*AccessPolicy, error) {
+	if len(config.RestrictedMethods) == 0 && len(config.AccessPolicies) == 0 {
+		return nil, nil
+	}
+	p := &AccessPolicy{
+		connectionType: connectionType,
+		restricted:     config.RestrictedMethods,
+		keys:           map[string]*accessGrant{},
+		subjects:       map[string]*accessGrant{},
+	}
+	for _, entry := range config.AccessPolicies {
+		if err := p.addGrant(entry); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// addGrant parses "<key:<name>:<api key>|jwt:<subject>>=<scope>[,<scope>...][@<rate>[:<burst>]]"
+// where scopes are methods, namespaces or "*".
+func (p *AccessPolicy) addGrant(entry string) error {
+	credential, spec, found := strings.Cut(strings.TrimSpace(entry), "=")
+	if !found {
+		return fmt.Errorf("invalid access policy %q, expected <credential>=<scopes>[@<rate>[:<burst>]]", entry)
+	}
+	scopes, quota, hasQuota := strings.Cut(spec, "@")
+	grant := &accessGrant{}
+	for _, scope := range strings.Split(scopes, ",") {
+		if scope = strings.TrimSpace(scope); scope != "" {
+			grant.scopes = append(grant.scopes, scope)
+		}
+	}
+	if len(grant.scopes) == 0 {
+		return fmt.Errorf("no scopes in access policy %q", entry)
+	}
+	if hasQuota {
+		rule, err := parseRateSpec(quota)
+		if err != nil {
+			return fmt.Errorf("%s in access policy %q", err, entry)
+		}
+		grant.limiter = rate.NewLimiter(rule.limit, rule.burst)
+	}
+	kind, id, _ := strings.Cut(credential, ":")
+	switch kind {
+	case "key":
+		name, key, found := strings.Cut(id, ":")
+		if !found || name == "" || key == "" {
+			return fmt.Errorf("invalid API key in access policy %q, expected key:<name>:<api key>", entry)
+		}
+		if _, exists := p.keys[key]; exists {
+			return fmt.Errorf("duplicate API key in access policy %q", entry)
+		}
+		grant.client = "key:" + name
+		p.keys[key] = grant
+	case "jwt":
+		if id == "" {
+			return fmt.Errorf("invalid JWT subject in access policy %q, expected jwt:<subject>", entry)
+		}
+		grant.client = "jwt:" + id
+		p.subjects[id] = grant
+	default:
+		return fmt.Errorf("invalid credential in access policy %q, expected key:<name>:<api key> or jwt:<subject>", entry)
+	}
+	return nil
+}
+
+// grantFor returns the grant of the API key of a request if it has a known
+// one, and otherwise of the subject of its JWT, if any.
+func (p *AccessPolicy) grantFor(r *http.Request) *accessGrant {
+	key := r.Header.Get(apiKeyHeader)
+	if key == "" {
+		key = r.URL.Query().Get(apiKeyQueryParam)
+	}
+	if grant, ok := p.keys[key]; ok {
+		return grant
+	}
+	if subject, ok := r.Context().Value(jwtSubjectKey{}).(string); ok && subject != "" {
+		return p.subjects[subject]
+	}
+	return nil
+}
+
+// check returns an error if the client of a request may not call a method.
+func (p *AccessPolicy) check(r *http.Request, method string) error {
+	restricted := matchesScope(p.restricted, method)
+	grant := p.grantFor(r)
+	if grant == nil {
+		if restricted {
+			p.record("anonymous", method, "unauthorized")
+			return &unauthorizedError{method: method}
+		}
+		return nil
+	}
+	if restricted && !grant.allows(method) {
+		p.record(grant.client, method, "denied")
+		return &methodNotFoundError{method: method}
+	}
+	if grant.limiter != nil && !grant.limiter.Allow() {
+		p.record(grant.client, method, "quota")
+		return &limitExceededError{message: fmt.Sprintf("quota exceeded for %s", grant.client)}
+	}
+	p.record(grant.client, method, "allowed")
+	return nil
+}
+
+func (p *AccessPolicy) record(client string, method string, result string) {
+	metrics.IncrementRpcAccessCounter(client, method, string(p.connectionType), result)
+}
+
+// matchesScope returns whether a method is one of the scopes, is in one of
+// the namespaces, or if "*" is a scope.
+func matchesScope(scopes []string, method string) bool {
+	namespace, _, _ := strings.Cut(method, "_")
+	for _, scope := range scopes {
+		if scope == "*" || scope == method || scope == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+type accessHandler struct {
+	policy *AccessPolicy
+	next   http.Handler
+}
+
+// newAccessHandler applies the access policy to JSON-RPC requests served over
+// HTTP. Like the deny list, a single rejected call rejects the whole batch.
+func newAccessHandler(policy *AccessPolicy, next http.Handler) http.Handler {
+	return &accessHandler{policy: policy, next: next}
+}
+
+func (h *accessHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.next.ServeHTTP(w, r)
+		return
+	}
+	reqs, isBatch, ok, err := peekRequestHeaders(r)
+	if err != nil {
+		rejectUnreadableRequest(w, err)
+		return
+	}
+	if !ok {
+		writeRPCErrorResponse(w, newRPCErrorResponse(nil, isBatch, &invalidMessageError{}))
+		return
+	}
+	for _, req := range reqs {
+		if err := h.policy.check(r, req.Method); err != nil {
+			writeRPCErrorResponse(w, newRPCErrorResponse(reqs, isBatch, err))
+			return
+		}
+	}
+	h.next.ServeHTTP(w, r)
+}
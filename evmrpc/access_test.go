@@ -0,0 +1,107 @@
+package evmrpc_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/gorilla/websocket"
+	"github.com/kiichain/kiichain3/evmrpc"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func startAccessServer(t *testing.T, config evmrpc.Config, jwtSecret []byte) string {
+	access, err := evmrpc.NewAccessPolicy(config, evmrpc.ConnectionTypeHTTP)
+	require.Nil(t, err)
+	srv := createAndStartServer(t, &evmrpc.HTTPConfig{
+		Modules:           []string{"test", "rpc"},
+		RPCEndpointConfig: evmrpc.RPCEndpointConfig{Access: access, JwtSecret: jwtSecret},
+	}, false, &evmrpc.WsConfig{}, nil)
+	t.Cleanup(srv.Stop)
+	return fmt.Sprintf("http://%v", srv.ListenAddr())
+}
+
+func TestAccessPolicy(t *testing.T) {
+	url := startAccessServer(t, evmrpc.Config{
+		RestrictedMethods: []string{"test"},
+		AccessPolicies:    []string{"key:indexer:s3cret=test@0.001:2", "key:other:0th3r=rpc"},
+	}, nil)
+	const hello = `{"jsonrpc":"2.0","id":1,"result":"Hello"}`
+	require.Equal(t, `{"jsonrpc":"2.0","id":1,"error":{"code":-32001,"message":"the method test_greet requires an API key"}}`, readBody(t, rpcRequest(t, url, "test_greet").Body))
+	// unknown keys are ignored
+	require.Contains(t, readBody(t, rpcRequest(t, url, "test_greet", "X-Api-Key", "wrong").Body), "-32001")
+	// unrestricted methods are open to everyone
+	require.NotContains(t, readBody(t, rpcRequest(t, url, "rpc_modules").Body), "error")
+
+	require.Equal(t, hello, readBody(t, rpcRequest(t, url, "test_greet", "X-Api-Key", "s3cret").Body))
+	require.Equal(t, hello, readBody(t, rpcRequest(t, url+"?api_key=s3cret", "test_greet").Body))
+	require.Equal(t, `{"jsonrpc":"2.0","id":1,"error":{"code":-32005,"message":"quota exceeded for key:indexer"}}`, readBody(t, rpcRequest(t, url, "test_greet", "X-Api-Key", "s3cret").Body))
+
+	// keys only give access to their scopes, and a denied call rejects the batch
+	require.Equal(t, `{"jsonrpc":"2.0","id":1,"error":{"code":-32601,"message":"the method test_greet does not exist/is not available"}}`, readBody(t, rpcRequest(t, url, "test_greet", "X-Api-Key", "0th3r").Body))
+	require.Contains(t, readBody(t, batchRpcRequest(t, url, []string{"rpc_modules", "test_greet"}, "X-Api-Key", "0th3r").Body), "-32601")
+
+	for _, entry := range []string{"key:indexer:s3cret", "key:s3cret=test", "user:s3cret=test", "jwt:=test", "key:indexer:s3cret=", "key:indexer:s3cret=test@fast"} {
+		_, err := evmrpc.NewAccessPolicy(evmrpc.Config{AccessPolicies: []string{entry}}, evmrpc.ConnectionTypeHTTP)
+		require.NotNil(t, err, entry)
+	}
+	access, err := evmrpc.NewAccessPolicy(evmrpc.Config{}, evmrpc.ConnectionTypeHTTP)
+	require.Nil(t, err)
+	require.Nil(t, access)
+}
+
+func TestAccessPolicyMalformedRequest(t *testing.T) {
+	url := startAccessServer(t, evmrpc.Config{
+		RestrictedMethods: []string{"test"},
+		AccessPolicies:    []string{"key:indexer:s3cret=test"},
+	}, nil)
+	// the server would serve the valid call of the batch, so the whole batch is rejected
+	body := `[{"jsonrpc":"2.0","id":1,"method":"test_greet","params":[]}, 1]`
+	require.Equal(t, `{"jsonrpc":"2.0","id":null,"error":{"code":-32600,"message":"invalid request"}}`, readBody(t, baseRpcRequest(t, url, body).Body))
+	// trailing bytes are ignored like the server does, so the call is still checked
+	body = `{"jsonrpc":"2.0","id":1,"method":"test_greet","params":[]}garbage`
+	require.Equal(t, `{"jsonrpc":"2.0","id":1,"error":{"code":-32001,"message":"the method test_greet requires an API key"}}`, readBody(t, baseRpcRequest(t, url, body).Body))
+	require.Equal(t, `{"jsonrpc":"2.0","id":1,"result":"Hello"}`, readBody(t, baseRpcRequest(t, url, body, "X-Api-Key", "s3cret").Body))
+}
+
+func TestAccessPolicyJWT(t *testing.T) {
+	secret := []byte("secret")
+	url := startAccessServer(t, evmrpc.Config{
+		RestrictedMethods: []string{"*"},
+		AccessPolicies:    []string{"jwt:indexer=test"},
+	}, secret)
+	token := func(subject string) string {
+		ss, err := jwt.NewWithClaims(jwt.SigningMethodHS256, testClaim{"iat": time.Now().Unix(), "sub": subject}).SignedString(secret)
+		require.Nil(t, err)
+		return "Bearer " + ss
+	}
+	require.Equal(t, `{"jsonrpc":"2.0","id":1,"result":"Hello"}`, readBody(t, rpcRequest(t, url, "test_greet", "Authorization", token("indexer")).Body))
+	require.Contains(t, readBody(t, rpcRequest(t, url, "rpc_modules", "Authorization", token("indexer")).Body), "-32601")
+	require.Contains(t, readBody(t, rpcRequest(t, url, "test_greet", "Authorization", token("someone")).Body), "-32001")
+}
+
+func TestWsAccessPolicy(t *testing.T) {
+	access, err := evmrpc.NewAccessPolicy(evmrpc.Config{RestrictedMethods: []string{"test_greet"}, AccessPolicies: []string{"key:indexer:s3cret=test_greet"}}, evmrpc.ConnectionTypeWS)
+	require.Nil(t, err)
+	srv := evmrpc.NewHTTPServer(log.NewNopLogger(), rpc.DefaultHTTPTimeouts)
+	require.Nil(t, srv.EnableWS(apis(), evmrpc.WsConfig{Origins: []string{"*"}, RPCEndpointConfig: evmrpc.RPCEndpointConfig{Access: access}}))
+	require.Nil(t, srv.SetListenAddr("localhost", 0))
+	require.Nil(t, srv.Start())
+	defer srv.Stop()
+
+	call := func(url string) string {
+		conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+		require.Nil(t, err)
+		defer conn.Close()
+		require.Nil(t, conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","id":1,"method":"test_greet","params":[]}`)))
+		_, buf, err := conn.ReadMessage()
+		require.Nil(t, err)
+		return strings.TrimSpace(string(buf))
+	}
+	require.Equal(t, `{"jsonrpc":"2.0","id":1,"error":{"code":-32001,"message":"the method test_greet requires an API key"}}`, call(fmt.Sprintf("ws://%v", srv.ListenAddr())))
+	require.Equal(t, `{"jsonrpc":"2.0","id":1,"result":"Hello"}`, call(fmt.Sprintf("ws://%v/?api_key=s3cret", srv.ListenAddr())))
+}
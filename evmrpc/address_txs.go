@@ -0,0 +1,125 @@
+package evmrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/kiichain/kiichain3/x/evm/keeper"
+	"github.com/kiichain/kiichain3/x/evm/types"
+)
+
+const (
+	// DefaultAddressTxsPageSize is the page size used when the caller doesn't
+	// set one.
+	DefaultAddressTxsPageSize = 100
+	// MaxAddressTxsPageSize is the max page size of kii_getTransactionsByAddress.
+	MaxAddressTxsPageSize = 1000
+)
+
+var addressTxRoleNames = map[byte]string{
+	types.AddressTxRoleFrom:    "from",
+	types.AddressTxRoleTo:      "to",
+	types.AddressTxRoleCreated: "created",
+	types.AddressTxRoleLog:     "log",
+}
+
+// roles of the address in the txs listed for each direction
+var addressTxDirections = map[string][]byte{
+	"all":  nil,
+	"from": {types.AddressTxRoleFrom},
+	"to":   {types.AddressTxRoleTo, types.AddressTxRoleCreated},
+	"logs": {types.AddressTxRoleLog},
+}
+
+// AddressTxsAPI lists the EVM txs of an address from the node-local address tx
+// index, most recent first.
+type AddressTxsAPI struct {
+	keeper         *keeper.Keeper
+	connectionType ConnectionType
+}
+
+func NewAddressTxsAPI(k *keeper.Keeper, connectionType ConnectionType) *AddressTxsAPI {
+	return &AddressTxsAPI{keeper: k, connectionType: connectionType}
+}
+
+// AddressTxsCursor points at the last tx that has been returned.
+type AddressTxsCursor struct {
+	BlockNumber hexutil.Uint64 `json:"blockNumber"`
+	TxIndex     hexutil.Uint   `json:"transactionIndex"`
+}
+
+type AddressTx struct {
+	BlockNumber hexutil.Uint64 `json:"blockNumber"`
+	TxIndex     hexutil.Uint   `json:"transactionIndex"`
+	Hash        common.Hash    `json:"hash"`
+	// Roles are the roles of the address in the tx: "from", "to", "created"
+	// (the contract created by the tx) and "log" (in a topic of a log).
+	Roles []string `json:"roles"`
+}
+
+type AddressTxsPage struct {
+	Transactions []AddressTx `json:"transactions"`
+	// Truncated is set if there are more txs; they can be fetched by passing
+	// Cursor back with the same direction.
+	Truncated bool              `json:"truncated"`
+	Cursor    *AddressTxsCursor `json:"cursor"`
+}
+
+// GetTransactionsByAddress returns at most limit txs in which an address
+// appears, in the given direction ("all" if empty, "from", "to" or "logs"),
+// starting after the cursor if one is given.
+func (a *AddressTxsAPI) GetTransactionsByAddress(_ context.Context, address common.Address, direction *string, cursor *AddressTxsCursor, limit *hexutil.Uint64) (res *AddressTxsPage, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("kii_getTransactionsByAddress", a.connectionType, startTime, returnErr == nil)
+	if !a.keeper.AddressTxIndexEnabled {
+		return nil, errors.New("address tx index is not enabled on this node")
+	}
+	dir := "all"
+	if direction != nil && *direction != "" {
+		dir = *direction
+	}
+	roles, ok := addressTxDirections[dir]
+	if !ok {
+		return nil, fmt.Errorf("unknown direction %q, must be one of all, from, to and logs", dir)
+	}
+	pageSize := DefaultAddressTxsPageSize
+	if limit != nil {
+		if *limit == 0 || *limit > MaxAddressTxsPageSize {
+			return nil, fmt.Errorf("limit must be between 1 and %d", MaxAddressTxsPageSize)
+		}
+		pageSize = int(*limit)
+	}
+	var after *keeper.AddressTxPosition
+	if cursor != nil {
+		after = &keeper.AddressTxPosition{Height: int64(cursor.BlockNumber), TxIndex: uint32(cursor.TxIndex)}
+	}
+	// one more tx tells whether the page is truncated
+	txs, err := a.keeper.GetAddressTxs(address, roles, after, pageSize+1, true)
+	if err != nil {
+		return nil, err
+	}
+	res = &AddressTxsPage{Transactions: []AddressTx{}}
+	for i, tx := range txs {
+		if i == pageSize {
+			res.Truncated = true
+			last := txs[i-1]
+			res.Cursor = &AddressTxsCursor{BlockNumber: hexutil.Uint64(last.Height), TxIndex: hexutil.Uint(last.TxIndex)}
+			break
+		}
+		roleNames := make([]string, 0, len(tx.Roles))
+		for _, role := range tx.Roles {
+			roleNames = append(roleNames, addressTxRoleNames[role])
+		}
+		res.Transactions = append(res.Transactions, AddressTx{
+			BlockNumber: hexutil.Uint64(tx.Height),
+			TxIndex:     hexutil.Uint(tx.TxIndex),
+			Hash:        tx.TxHash,
+			Roles:       roleNames,
+		})
+	}
+	return res, nil
+}
@@ -0,0 +1,58 @@
+package evmrpc_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/kiichain/kiichain3/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTransactionsByAddress(t *testing.T) {
+	from := common.HexToAddress("0x5151515151515151515151515151515151515151")
+	to := common.HexToAddress("0x5252525252525252525252525252525252525252")
+
+	resObj := sendKiiRequestGood(t, "getTransactionsByAddress", from.Hex())
+	require.Equal(t, "address tx index is not enabled on this node", resObj["error"].(map[string]interface{})["message"])
+
+	EVMKeeper.AddressTxIndexEnabled = true
+	defer func() { EVMKeeper.AddressTxIndexEnabled = false }()
+	ctx, _ := Ctx.CacheContext()
+	for i := uint32(0); i < 3; i++ {
+		receipt := &types.Receipt{TxHashHex: common.Hash{0x51, byte(i)}.Hex(), BlockNumber: 8, TransactionIndex: i, From: from.Hex(), To: to.Hex()}
+		if i == 2 {
+			receipt.From, receipt.To = to.Hex(), from.Hex()
+		}
+		EVMKeeper.IndexAddressTxs(ctx, receipt)
+	}
+	require.Nil(t, EVMKeeper.FlushTransientReceipts(ctx))
+	require.Eventually(t, func() bool {
+		txs, err := EVMKeeper.GetAddressTxs(from, nil, nil, 10, false)
+		return err == nil && len(txs) == 3
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// most recent first, in pages
+	page := sendKiiRequestGood(t, "getTransactionsByAddress", from.Hex(), "all", nil, "0x2")["result"].(map[string]interface{})
+	require.True(t, page["truncated"].(bool))
+	require.Equal(t, []interface{}{
+		map[string]interface{}{"blockNumber": "0x8", "transactionIndex": "0x2", "hash": common.Hash{0x51, 2}.Hex(), "roles": []interface{}{"to"}},
+		map[string]interface{}{"blockNumber": "0x8", "transactionIndex": "0x1", "hash": common.Hash{0x51, 1}.Hex(), "roles": []interface{}{"from"}},
+	}, page["transactions"])
+	page = sendKiiRequestGood(t, "getTransactionsByAddress", from.Hex(), "all", page["cursor"], "0x2")["result"].(map[string]interface{})
+	require.False(t, page["truncated"].(bool))
+	require.Nil(t, page["cursor"])
+	require.Len(t, page["transactions"], 1)
+	require.Equal(t, common.Hash{0x51, 0}.Hex(), page["transactions"].([]interface{})[0].(map[string]interface{})["hash"])
+
+	// directions
+	page = sendKiiRequestGood(t, "getTransactionsByAddress", from.Hex(), "from")["result"].(map[string]interface{})
+	require.Len(t, page["transactions"], 2)
+	page = sendKiiRequestGood(t, "getTransactionsByAddress", from.Hex(), "to")["result"].(map[string]interface{})
+	require.Len(t, page["transactions"], 1)
+
+	resObj = sendKiiRequestGood(t, "getTransactionsByAddress", from.Hex(), "sideways")
+	require.Contains(t, resObj["error"].(map[string]interface{})["message"], "unknown direction")
+	resObj = sendKiiRequestGood(t, "getTransactionsByAddress", from.Hex(), "all", nil, "0x0")
+	require.Equal(t, "limit must be between 1 and 1000", resObj["error"].(map[string]interface{})["message"])
+}
@@ -0,0 +1,82 @@
+package evmrpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/kiichain/kiichain3/utils/metrics"
+)
+
+// ArchiveFallback forwards queries at heights whose state has been pruned from
+// this node to an archive node and relays its answers. A nil ArchiveFallback
+// forwards nothing.
+type ArchiveFallback struct {
+	client         *rpc.Client
+	methods        []string
+	timeout        time.Duration
+	connectionType ConnectionType
+}
+
+// NewArchiveFallback returns a fallback to the archive node of the config, or
+// nil if none is configured.
+func NewArchiveFallback(config Config, connectionType ConnectionType) (*ArchiveFallback, error) {
+	if config.ArchiveFallbackURL == "" {
+		return nil, nil
+	}
+	client, err := rpc.DialContext(context.Background(), config.ArchiveFallbackURL)
+	if err != nil {
+		return nil, err
+	}
+	return &ArchiveFallback{
+		client:         client,
+		methods:        config.ArchiveFallbackMethods,
+		timeout:        config.ArchiveFallbackTimeout,
+		connectionType: connectionType,
+	}, nil
+}
+
+func (f *ArchiveFallback) allows(method string) bool {
+	for _, allowed := range f.methods {
+		if prefix, isPrefix := strings.CutSuffix(allowed, "*"); isPrefix && strings.HasPrefix(method, prefix) {
+			return true
+		}
+		if allowed == method {
+			return true
+		}
+	}
+	return false
+}
+
+// forward sends a request to the archive node and decodes its answer into
+// result if err reports that the queried height isn't available on this node
+// and the method is allowlisted. Otherwise err is returned unchanged.
+func (f *ArchiveFallback) forward(ctx context.Context, err error, result interface{}, method string, args ...interface{}) error {
+	var unavailable *heightUnavailableError
+	if f == nil || !errors.As(err, &unavailable) || !f.allows(method) {
+		return err
+	}
+	startTime := time.Now()
+	if f.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, f.timeout)
+		defer cancel()
+	}
+	err = f.client.CallContext(ctx, result, method, args...)
+	metrics.IncrementRpcArchiveFallbackCounter(method, string(f.connectionType), err == nil)
+	metrics.MeasureRpcArchiveFallbackLatency(method, string(f.connectionType), startTime)
+	return err
+}
+
+// forwardRaw is like forward for methods whose answer is relayed as is, such
+// as traces.
+func (f *ArchiveFallback) forwardRaw(ctx context.Context, err error, method string, args ...interface{}) (interface{}, error) {
+	var result json.RawMessage
+	if err := f.forward(ctx, err, &result, method, args...); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
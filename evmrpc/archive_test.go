@@ -0,0 +1,87 @@
+package evmrpc_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/kiichain/kiichain3/evmrpc"
+	"github.com/stretchr/testify/require"
+)
+
+type archiveEthAPI struct{}
+
+func (archiveEthAPI) GetBalance(_ context.Context, _ common.Address, blockNrOrHash rpc.BlockNumberOrHash) (*hexutil.Big, error) {
+	if number, ok := blockNrOrHash.Number(); !ok || number != 5 {
+		return nil, nil
+	}
+	return (*hexutil.Big)(hexutil.MustDecodeBig("0x2a")), nil
+}
+
+type archiveDebugAPI struct{}
+
+func (archiveDebugAPI) TraceBlockByNumber(_ context.Context, number rpc.BlockNumber, _ *tracers.TraceConfig) (interface{}, error) {
+	return []map[string]interface{}{{"txHash": common.Hash{}, "result": map[string]interface{}{"height": number}}}, nil
+}
+
+// startArchive serves a stand-in for an archive node, which answers queries at
+// height 5.
+func startArchive(t *testing.T) string {
+	server := rpc.NewServer()
+	require.Nil(t, server.RegisterName("eth", archiveEthAPI{}))
+	require.Nil(t, server.RegisterName("debug", archiveDebugAPI{}))
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(func() {
+		httpServer.Close()
+		server.Stop()
+	})
+	return httpServer.URL
+}
+
+func TestArchiveFallback(t *testing.T) {
+	config := evmrpc.DefaultConfig
+	config.ArchiveFallbackURL = startArchive(t)
+	config.ArchiveFallbackMethods = []string{"eth_getBalance", "debug_trace*"}
+	config.ArchiveFallbackTimeout = 5 * time.Second
+	archive, err := evmrpc.NewArchiveFallback(config, evmrpc.ConnectionTypeHTTP)
+	require.Nil(t, err)
+	// the state of the test context isn't versioned, so it is unavailable at any
+	// explicit height
+	ctxProvider := func(int64) sdk.Context { return Ctx }
+	addr := common.HexToAddress("0x1234567890123456789023456789012345678901")
+	atHeight := rpc.BlockNumberOrHashWithNumber(5)
+
+	stateAPI := evmrpc.NewStateAPI(&MockClient{}, EVMKeeper, ctxProvider, archive, evmrpc.ConnectionTypeHTTP)
+	balance, err := stateAPI.GetBalance(context.Background(), addr, atHeight)
+	require.Nil(t, err)
+	require.Equal(t, "0x2a", balance.String())
+	// the latest state is served locally
+	balance, err = stateAPI.GetBalance(context.Background(), addr, rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber))
+	require.Nil(t, err)
+	require.Equal(t, "0x38d7ea4c68000", balance.String())
+	// methods not in the allowlist aren't forwarded
+	_, err = stateAPI.GetCode(context.Background(), addr, atHeight)
+	require.ErrorContains(t, err, "module does not exist on height")
+
+	debugAPI := evmrpc.NewDebugAPI(&MockClient{}, EVMKeeper, ctxProvider, TxConfig.TxDecoder(), &evmrpc.SimulateConfig{GasCap: 10000000}, archive, evmrpc.ConnectionTypeHTTP)
+	trace, err := debugAPI.TraceBlockByNumber(context.Background(), 5, nil)
+	require.Nil(t, err)
+	bz, err := json.Marshal(trace)
+	require.Nil(t, err)
+	require.JSONEq(t, `[{"txHash":"0x0000000000000000000000000000000000000000000000000000000000000000","result":{"height":"0x5"}}]`, string(bz))
+
+	// without an archive node, nothing is forwarded
+	archive, err = evmrpc.NewArchiveFallback(evmrpc.DefaultConfig, evmrpc.ConnectionTypeHTTP)
+	require.Nil(t, err)
+	require.Nil(t, archive)
+	stateAPI = evmrpc.NewStateAPI(&MockClient{}, EVMKeeper, ctxProvider, archive, evmrpc.ConnectionTypeHTTP)
+	_, err = stateAPI.GetBalance(context.Background(), addr, atHeight)
+	require.ErrorContains(t, err, "module does not exist on height")
+}
@@ -37,10 +37,11 @@ type BlockAPI struct {
 	connectionType       ConnectionType
 	namespace            string
 	includeShellReceipts bool
+	cache                *ResponseCache
 }
 
-func NewBlockAPI(tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, txConfig client.TxConfig, connectionType ConnectionType, namespace string) *BlockAPI {
-	return &BlockAPI{tmClient: tmClient, keeper: k, ctxProvider: ctxProvider, txConfig: txConfig, connectionType: connectionType, includeShellReceipts: shouldIncludeSynthetic(namespace)}
+func NewBlockAPI(tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, txConfig client.TxConfig, cache *ResponseCache, connectionType ConnectionType, namespace string) *BlockAPI {
+	return &BlockAPI{tmClient: tmClient, keeper: k, ctxProvider: ctxProvider, txConfig: txConfig, cache: cache, connectionType: connectionType, includeShellReceipts: shouldIncludeSynthetic(namespace)}
 }
 
 func (a *BlockAPI) GetBlockTransactionCountByNumber(ctx context.Context, number rpc.BlockNumber) (result *hexutil.Uint, returnErr error) {
@@ -74,16 +75,34 @@ func (a *BlockAPI) GetBlockByHash(ctx context.Context, blockHash common.Hash, fu
 }
 
 func (a *BlockAPI) getBlockByHash(ctx context.Context, blockHash common.Hash, fullTx bool) (result map[string]interface{}, returnErr error) {
-	block, err := blockByHashWithRetry(ctx, a.tmClient, blockHash[:], 1)
+	if height, ok := a.cache.blockHeight(blockHash); ok {
+		if result, ok := a.cache.block(blockCacheKey{height: height, fullTx: fullTx, includeShellReceipts: a.includeShellReceipts}); ok {
+			return result, nil
+		}
+	}
+	committed := a.ctxProvider(LatestCtxHeight).BlockHeight()
+	block, err := a.cache.blockByHash(ctx, a.tmClient, blockHash, committed)
 	if err != nil {
 		return nil, err
 	}
+	return a.encodeBlock(ctx, block, fullTx, committed)
+}
+
+// encodeBlock renders a Tendermint block in EVM form and caches the rendering
+// if the block is committed.
+func (a *BlockAPI) encodeBlock(ctx context.Context, block *coretypes.ResultBlock, fullTx bool, committed int64) (map[string]interface{}, error) {
 	blockRes, err := blockResultsWithRetry(ctx, a.tmClient, &block.Block.Height)
 	if err != nil {
 		return nil, err
 	}
 	blockBloom := a.keeper.GetBlockBloom(a.ctxProvider(block.Block.Height))
-	return EncodeTmBlock(a.ctxProvider(block.Block.Height), block, blockRes, blockBloom, a.keeper, a.txConfig.TxDecoder(), fullTx, a.includeShellReceipts)
+	baseFeePerGas := getBlockBaseFee(a.keeper, a.ctxProvider, block.Block.Height)
+	result, err := EncodeTmBlock(a.ctxProvider(block.Block.Height), block, blockRes, blockBloom, baseFeePerGas, a.keeper, a.txConfig.TxDecoder(), fullTx, a.includeShellReceipts)
+	if err != nil {
+		return nil, err
+	}
+	a.cache.addBlock(blockCacheKey{height: block.Block.Height, fullTx: fullTx, includeShellReceipts: a.includeShellReceipts}, result, committed)
+	return result, nil
 }
 
 func (a *BlockAPI) GetBlockByNumber(ctx context.Context, number rpc.BlockNumber, fullTx bool) (result map[string]interface{}, returnErr error) {
@@ -122,16 +141,17 @@ func (a *BlockAPI) getBlockByNumber(ctx context.Context, number rpc.BlockNumber,
 	if err != nil {
 		return nil, err
 	}
-	block, err := blockByNumberWithRetry(ctx, a.tmClient, numberPtr, 1)
-	if err != nil {
-		return nil, err
+	if numberPtr != nil {
+		if result, ok := a.cache.block(blockCacheKey{height: *numberPtr, fullTx: fullTx, includeShellReceipts: a.includeShellReceipts}); ok {
+			return result, nil
+		}
 	}
-	blockRes, err := blockResultsWithRetry(ctx, a.tmClient, &block.Block.Height)
+	committed := a.ctxProvider(LatestCtxHeight).BlockHeight()
+	block, err := a.cache.blockByNumber(ctx, a.tmClient, numberPtr, committed)
 	if err != nil {
 		return nil, err
 	}
-	blockBloom := a.keeper.GetBlockBloom(a.ctxProvider(block.Block.Height))
-	return EncodeTmBlock(a.ctxProvider(block.Block.Height), block, blockRes, blockBloom, a.keeper, a.txConfig.TxDecoder(), fullTx, a.includeShellReceipts)
+	return a.encodeBlock(ctx, block, fullTx, committed)
 }
 
 func (a *BlockAPI) GetBlockReceipts(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (result []map[string]interface{}, returnErr error) {
@@ -207,6 +227,7 @@ func EncodeTmBlock(
 	block *coretypes.ResultBlock,
 	blockRes *coretypes.ResultBlockResults,
 	blockBloom ethtypes.Bloom,
+	baseFeePerGas *big.Int,
 	k *keeper.Keeper,
 	txDecoder sdk.TxDecoder,
 	fullTx bool,
@@ -220,7 +241,6 @@ func EncodeTmBlock(
 	txHash := common.HexToHash(block.Block.DataHash.String())
 	resultHash := common.HexToHash(block.Block.LastResultsHash.String())
 	miner := common.HexToAddress(block.Block.ProposerAddress.String())
-	baseFeePerGas := k.GetDynamicBaseFeePerGas(ctx).TruncateInt().BigInt()
 	var blockGasUsed int64
 	chainConfig := types.DefaultChainConfig().EthereumConfig(k.ChainID(ctx))
 	transactions := []interface{}{}
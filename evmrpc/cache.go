@@ -0,0 +1,142 @@
+package evmrpc
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/lru"
+	"github.com/ethereum/go-ethereum/lib/ethapi"
+	"github.com/kiichain/kiichain3/utils/metrics"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	"github.com/tendermint/tendermint/rpc/coretypes"
+)
+
+// ResponseCache caches data of committed blocks, which never changes:
+// Tendermint blocks, their EVM renderings, receipts and transactions. Entries
+// are only added for heights that the app has committed, so that nothing read
+// from a state that may still change is cached. A nil ResponseCache caches
+// nothing.
+type ResponseCache struct {
+	tmBlocks     *lru.Cache[int64, *coretypes.ResultBlock]
+	blockHeights *lru.Cache[common.Hash, int64]
+	blocks       *lru.Cache[blockCacheKey, map[string]interface{}]
+	receipts     *lru.Cache[common.Hash, map[string]interface{}]
+	txs          *lru.Cache[common.Hash, *ethapi.RPCTransaction]
+}
+
+type blockCacheKey struct {
+	height               int64
+	fullTx               bool
+	includeShellReceipts bool
+}
+
+// NewResponseCache returns a cache holding up to size entries of each kind,
+// or nil if size isn't positive.
+func NewResponseCache(size int) *ResponseCache {
+	if size <= 0 {
+		return nil
+	}
+	return &ResponseCache{
+		tmBlocks:     lru.NewCache[int64, *coretypes.ResultBlock](size),
+		blockHeights: lru.NewCache[common.Hash, int64](size),
+		blocks:       lru.NewCache[blockCacheKey, map[string]interface{}](size),
+		receipts:     lru.NewCache[common.Hash, map[string]interface{}](size),
+		txs:          lru.NewCache[common.Hash, *ethapi.RPCTransaction](size),
+	}
+}
+
+func getCached[K comparable, V any](cache *lru.Cache[K, V], name string, key K) (V, bool) {
+	value, ok := cache.Get(key)
+	metrics.IncrementRpcCacheCounter(name, ok)
+	return value, ok
+}
+
+// blockByNumber returns the Tendermint block at a height, or the latest block
+// if height is nil. committed is the latest height committed by the app.
+func (c *ResponseCache) blockByNumber(ctx context.Context, tmClient rpcclient.Client, height *int64, committed int64) (*coretypes.ResultBlock, error) {
+	if c != nil && height != nil {
+		if block, ok := getCached(c.tmBlocks, "tm_block", *height); ok {
+			return block, nil
+		}
+	}
+	block, err := blockByNumberWithRetry(ctx, tmClient, height, 1)
+	if err != nil {
+		return nil, err
+	}
+	c.addTmBlock(block, committed)
+	return block, nil
+}
+
+// blockByHash returns the Tendermint block with a hash. committed is the
+// latest height committed by the app.
+func (c *ResponseCache) blockByHash(ctx context.Context, tmClient rpcclient.Client, hash common.Hash, committed int64) (*coretypes.ResultBlock, error) {
+	if height, ok := c.blockHeight(hash); ok {
+		if block, ok := getCached(c.tmBlocks, "tm_block", height); ok {
+			return block, nil
+		}
+	}
+	block, err := blockByHashWithRetry(ctx, tmClient, hash[:], 1)
+	if err != nil {
+		return nil, err
+	}
+	c.addTmBlock(block, committed)
+	return block, nil
+}
+
+func (c *ResponseCache) addTmBlock(block *coretypes.ResultBlock, committed int64) {
+	if c == nil || block.Block.Height > committed {
+		return
+	}
+	c.tmBlocks.Add(block.Block.Height, block)
+	c.blockHeights.Add(common.BytesToHash(block.BlockID.Hash), block.Block.Height)
+}
+
+// blockHeight returns the height of a cached block by its hash.
+func (c *ResponseCache) blockHeight(hash common.Hash) (int64, bool) {
+	if c == nil {
+		return 0, false
+	}
+	return getCached(c.blockHeights, "block_height", hash)
+}
+
+func (c *ResponseCache) block(key blockCacheKey) (map[string]interface{}, bool) {
+	if c == nil {
+		return nil, false
+	}
+	return getCached(c.blocks, "block", key)
+}
+
+func (c *ResponseCache) addBlock(key blockCacheKey, block map[string]interface{}, committed int64) {
+	if c == nil || key.height > committed {
+		return
+	}
+	c.blocks.Add(key, block)
+}
+
+func (c *ResponseCache) receipt(hash common.Hash) (map[string]interface{}, bool) {
+	if c == nil {
+		return nil, false
+	}
+	return getCached(c.receipts, "receipt", hash)
+}
+
+func (c *ResponseCache) addReceipt(hash common.Hash, height int64, receipt map[string]interface{}, committed int64) {
+	if c == nil || receipt == nil || height > committed {
+		return
+	}
+	c.receipts.Add(hash, receipt)
+}
+
+func (c *ResponseCache) tx(hash common.Hash) (*ethapi.RPCTransaction, bool) {
+	if c == nil {
+		return nil, false
+	}
+	return getCached(c.txs, "tx", hash)
+}
+
+func (c *ResponseCache) addTx(hash common.Hash, height int64, tx *ethapi.RPCTransaction, committed int64) {
+	if c == nil || tx == nil || height > committed {
+		return
+	}
+	c.txs.Add(hash, tx)
+}
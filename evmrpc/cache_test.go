@@ -0,0 +1,66 @@
+package evmrpc_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/kiichain/kiichain3/evmrpc"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/rpc/coretypes"
+)
+
+// blockCountingClient counts the blocks fetched from Tendermint.
+type blockCountingClient struct {
+	*MockClient
+	blocks int
+}
+
+func (c *blockCountingClient) Block(ctx context.Context, h *int64) (*coretypes.ResultBlock, error) {
+	c.blocks++
+	return c.MockClient.Block(ctx, h)
+}
+
+func TestResponseCache(t *testing.T) {
+	require.Nil(t, evmrpc.NewResponseCache(0))
+
+	client := &blockCountingClient{MockClient: &MockClient{}}
+	ctxProvider := func(int64) sdk.Context { return Ctx }
+	api := evmrpc.NewBlockAPI(client, EVMKeeper, ctxProvider, TxConfig, evmrpc.NewResponseCache(10), evmrpc.ConnectionTypeHTTP, "eth")
+	block, err := api.GetBlockByNumber(context.Background(), 8, true)
+	require.Nil(t, err)
+	cached, err := api.GetBlockByNumber(context.Background(), 8, true)
+	require.Nil(t, err)
+	require.Equal(t, block, cached)
+	require.Equal(t, 1, client.blocks)
+
+	// the hash-only rendering is cached separately but reuses the block
+	_, err = api.GetBlockByNumber(context.Background(), 8, false)
+	require.Nil(t, err)
+	require.Equal(t, 1, client.blocks)
+	cached, err = api.GetBlockByHash(context.Background(), block["hash"].(common.Hash), true)
+	require.Nil(t, err)
+	require.Equal(t, block, cached)
+	require.Equal(t, 1, client.blocks)
+
+	// blocks that the app hasn't committed yet aren't cached
+	client.blocks = 0
+	ctxProvider = func(int64) sdk.Context { return Ctx.WithBlockHeight(7) }
+	api = evmrpc.NewBlockAPI(client, EVMKeeper, ctxProvider, TxConfig, evmrpc.NewResponseCache(10), evmrpc.ConnectionTypeHTTP, "eth")
+	for i := 0; i < 2; i++ {
+		_, err = api.GetBlockByNumber(context.Background(), 8, true)
+		require.Nil(t, err)
+	}
+	require.Equal(t, 2, client.blocks)
+
+	// receipts are cached by tx hash
+	txAPI := evmrpc.NewTransactionAPI(client, EVMKeeper, func(int64) sdk.Context { return Ctx }, TxConfig, "", evmrpc.NewResponseCache(10), nil, evmrpc.ConnectionTypeHTTP)
+	receipt, err := txAPI.GetTransactionReceipt(context.Background(), tx1.Hash())
+	require.Nil(t, err)
+	client.blocks = 0
+	cachedReceipt, err := txAPI.GetTransactionReceipt(context.Background(), tx1.Hash())
+	require.Nil(t, err)
+	require.Equal(t, receipt, cachedReceipt)
+	require.Equal(t, 0, client.blocks)
+}
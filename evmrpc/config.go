@@ -1,6 +1,7 @@
 package evmrpc
 
 import (
+	"fmt"
 	"time"
 
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
@@ -83,8 +84,97 @@ type Config struct {
 	// max number of concurrent NewHead subscriptions
 	MaxSubscriptionsNewHead uint64 `mapstructure:"max_subscriptions_new_head"`
 
+	// max number of concurrent NewPendingTransactions subscriptions
+	MaxSubscriptionsNewPendingTxs uint64 `mapstructure:"max_subscriptions_new_pending_txs"`
+
+	// max number of concurrent Syncing subscriptions
+	MaxSubscriptionsSyncing uint64 `mapstructure:"max_subscriptions_syncing"`
+
 	// test api enables certain override apis for integration test situations
 	EnableTestAPI bool `mapstructure:"enable_test_api"`
+
+	// max number of goroutines used to scan block blooms and fetch logs for a log query
+	BloomScanWorkers int `mapstructure:"bloom_scan_workers"`
+
+	// builds a bloom-bits index in the receipt store and uses it to answer log queries
+	EnableBloomBitsIndex bool `mapstructure:"enable_bloom_bits_index"`
+
+	// token bucket rate limits per client (JWT subject, or IP if unauthenticated), each
+	// entry is "<method|namespace|*>=<requests per second>[:<burst>]" and the most
+	// specific entry for a method applies
+	RateLimits []string `mapstructure:"rate_limits"`
+
+	// max number of requests in a batch, 0 for unlimited
+	MaxBatchSize int `mapstructure:"max_batch_size"`
+
+	// max size in bytes of a response, 0 for unlimited
+	MaxResponseBytes int `mapstructure:"max_response_bytes"`
+
+	// max number of heavy calls served concurrently, 0 for unlimited
+	MaxConcurrentHeavyCalls int `mapstructure:"max_concurrent_heavy_calls"`
+
+	// methods counted as heavy calls, entries ending with "*" match by prefix
+	HeavyMethods []string `mapstructure:"heavy_methods"`
+
+	// serves queries at the "pending" block tag from a state on which the executable
+	// EVM txs of the mempool are speculatively applied
+	EnablePendingState bool `mapstructure:"enable_pending_state"`
+
+	// max number of mempool txs applied to the pending state
+	PendingStateMaxTxs int `mapstructure:"pending_state_max_txs"`
+
+	// how long the pending state is reused before it is rebuilt
+	PendingStateRefreshInterval time.Duration `mapstructure:"pending_state_refresh_interval"`
+
+	// number of recent blocks sampled by the gas price oracle
+	GasPriceOracleBlocks int `mapstructure:"gas_price_oracle_blocks"`
+
+	// percentile of the sampled tips suggested by the gas price oracle
+	GasPriceOraclePercentile int `mapstructure:"gas_price_oracle_percentile"`
+
+	// tips in wei below which txs are ignored by the gas price oracle
+	GasPriceOracleIgnorePrice uint64 `mapstructure:"gas_price_oracle_ignore_price"`
+
+	// max tip in wei suggested by the gas price oracle, 0 for no cap
+	GasPriceOracleMaxPrice uint64 `mapstructure:"gas_price_oracle_max_price"`
+
+	// max time eth_sendRawTransactionSync waits for the receipt of a tx
+	SendRawTransactionSyncMaxTimeout time.Duration `mapstructure:"send_raw_transaction_sync_max_timeout"`
+
+	// max number of entries of each kind (blocks, receipts, txs) cached for
+	// committed blocks, 0 to disable the cache
+	ResponseCacheSize int `mapstructure:"response_cache_size"`
+
+	// whether to index the txs of each address for kii_getTransactionsByAddress
+	EnableAddressTxIndex bool `mapstructure:"enable_address_tx_index"`
+
+	// number of recent blocks kept in the address tx index, 0 to keep all
+	AddressTxIndexRetention int64 `mapstructure:"address_tx_index_retention"`
+
+	// JSON-RPC endpoint of an archive node to which state and trace queries at
+	// heights pruned from this node are forwarded, empty to disable forwarding
+	ArchiveFallbackURL string `mapstructure:"archive_fallback_url"`
+
+	// methods that may be forwarded to the archive node, entries ending with "*"
+	// match by prefix
+	ArchiveFallbackMethods []string `mapstructure:"archive_fallback_methods"`
+
+	// max time to wait for the answer of the archive node
+	ArchiveFallbackTimeout time.Duration `mapstructure:"archive_fallback_timeout"`
+
+	// methods that can only be called with an API key or a JWT granted access to
+	// them, entries are methods, namespaces or "*"
+	RestrictedMethods []string `mapstructure:"restricted_methods"`
+
+	// credentials granted access to restricted methods, each entry is
+	// "<key:<name>:<api key>|jwt:<subject>>=<scope>[,<scope>...][@<requests per second>[:<burst>]]"
+	// where scopes are methods, namespaces or "*" and the optional rate is the
+	// quota of the credential
+	AccessPolicies []string `mapstructure:"access_policies"`
+
+	// serve GraphQL queries (EIP-1767) at /graphql on the HTTP server; queries
+	// are limited, restricted and denied as calls to graphql_query
+	EnableGraphQL bool `mapstructure:"enable_graphql"`
 }
 
 var DefaultConfig = Config{
@@ -109,6 +199,33 @@ var DefaultConfig = Config{
 	MaxBlocksForLog:         2000,
 	MaxSubscriptionsNewHead: 10000,
 	EnableTestAPI:           false,
+
+	MaxSubscriptionsNewPendingTxs:    10000,
+	MaxSubscriptionsSyncing:          10000,
+	BloomScanWorkers:                 16,
+	EnableBloomBitsIndex:             false,
+	RateLimits:                       make([]string, 0),
+	MaxBatchSize:                     1000,
+	MaxResponseBytes:                 0,
+	MaxConcurrentHeavyCalls:          0,
+	HeavyMethods:                     []string{"debug_trace*", "debug_accountRange", "debug_storageRangeAt", "trace_*", "eth_getLogs", "kii_getLogs", "kii_getLogsPaged", "eth_getFilterLogs", "kii_getFilterLogs"},
+	EnablePendingState:               false,
+	PendingStateMaxTxs:               500,
+	PendingStateRefreshInterval:      time.Second,
+	GasPriceOracleBlocks:             20,
+	GasPriceOraclePercentile:         60,
+	GasPriceOracleIgnorePrice:        2,
+	GasPriceOracleMaxPrice:           500_000_000_000, // 500 gwei
+	SendRawTransactionSyncMaxTimeout: 30 * time.Second,
+	ResponseCacheSize:                1024,
+	EnableAddressTxIndex:             false,
+	AddressTxIndexRetention:          0,
+	ArchiveFallbackURL:               "",
+	ArchiveFallbackMethods:           []string{"eth_getBalance", "eth_getCode", "eth_getStorageAt", "eth_getTransactionCount", "eth_call", "eth_estimateGas", "debug_traceTransaction", "debug_traceBlockByNumber", "debug_traceBlockByHash", "debug_traceCall", "debug_accountRange"},
+	ArchiveFallbackTimeout:           10 * time.Second,
+	RestrictedMethods:                make([]string, 0),
+	AccessPolicies:                   make([]string, 0),
+	EnableGraphQL:                    false,
 }
 
 const (
@@ -133,6 +250,33 @@ const (
 	flagMaxBlocksForLog         = "evm.max_blocks_for_log"
 	flagMaxSubscriptionsNewHead = "evm.max_subscriptions_new_head"
 	flagEnableTestAPI           = "evm.enable_test_api"
+
+	flagMaxSubscriptionsNewPendingTxs    = "evm.max_subscriptions_new_pending_txs"
+	flagMaxSubscriptionsSyncing          = "evm.max_subscriptions_syncing"
+	flagBloomScanWorkers                 = "evm.bloom_scan_workers"
+	flagEnableBloomBitsIndex             = "evm.enable_bloom_bits_index"
+	flagRateLimits                       = "evm.rate_limits"
+	flagMaxBatchSize                     = "evm.max_batch_size"
+	flagMaxResponseBytes                 = "evm.max_response_bytes"
+	flagMaxConcurrentHeavyCalls          = "evm.max_concurrent_heavy_calls"
+	flagHeavyMethods                     = "evm.heavy_methods"
+	flagEnablePendingState               = "evm.enable_pending_state"
+	flagPendingStateMaxTxs               = "evm.pending_state_max_txs"
+	flagPendingStateRefreshInterval      = "evm.pending_state_refresh_interval"
+	flagGasPriceOracleBlocks             = "evm.gas_price_oracle_blocks"
+	flagGasPriceOraclePercentile         = "evm.gas_price_oracle_percentile"
+	flagGasPriceOracleIgnorePrice        = "evm.gas_price_oracle_ignore_price"
+	flagGasPriceOracleMaxPrice           = "evm.gas_price_oracle_max_price"
+	flagSendRawTransactionSyncMaxTimeout = "evm.send_raw_transaction_sync_max_timeout"
+	flagResponseCacheSize                = "evm.response_cache_size"
+	flagEnableAddressTxIndex             = "evm.enable_address_tx_index"
+	flagAddressTxIndexRetention          = "evm.address_tx_index_retention"
+	flagArchiveFallbackURL               = "evm.archive_fallback_url"
+	flagArchiveFallbackMethods           = "evm.archive_fallback_methods"
+	flagArchiveFallbackTimeout           = "evm.archive_fallback_timeout"
+	flagRestrictedMethods                = "evm.restricted_methods"
+	flagAccessPolicies                   = "evm.access_policies"
+	flagEnableGraphQL                    = "evm.enable_graphql"
 )
 
 func ReadConfig(opts servertypes.AppOptions) (Config, error) {
@@ -238,10 +382,143 @@ func ReadConfig(opts servertypes.AppOptions) (Config, error) {
 			return cfg, err
 		}
 	}
+	if v := opts.Get(flagMaxSubscriptionsNewPendingTxs); v != nil {
+		if cfg.MaxSubscriptionsNewPendingTxs, err = cast.ToUint64E(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagMaxSubscriptionsSyncing); v != nil {
+		if cfg.MaxSubscriptionsSyncing, err = cast.ToUint64E(v); err != nil {
+			return cfg, err
+		}
+	}
 	if v := opts.Get(flagEnableTestAPI); v != nil {
 		if cfg.EnableTestAPI, err = cast.ToBoolE(v); err != nil {
 			return cfg, err
 		}
 	}
+	if v := opts.Get(flagBloomScanWorkers); v != nil {
+		if cfg.BloomScanWorkers, err = cast.ToIntE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagEnableBloomBitsIndex); v != nil {
+		if cfg.EnableBloomBitsIndex, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagRateLimits); v != nil {
+		if cfg.RateLimits, err = cast.ToStringSliceE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagMaxBatchSize); v != nil {
+		if cfg.MaxBatchSize, err = cast.ToIntE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagMaxResponseBytes); v != nil {
+		if cfg.MaxResponseBytes, err = cast.ToIntE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagMaxConcurrentHeavyCalls); v != nil {
+		if cfg.MaxConcurrentHeavyCalls, err = cast.ToIntE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagHeavyMethods); v != nil {
+		if cfg.HeavyMethods, err = cast.ToStringSliceE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagEnablePendingState); v != nil {
+		if cfg.EnablePendingState, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagPendingStateMaxTxs); v != nil {
+		if cfg.PendingStateMaxTxs, err = cast.ToIntE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagPendingStateRefreshInterval); v != nil {
+		if cfg.PendingStateRefreshInterval, err = cast.ToDurationE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagGasPriceOracleBlocks); v != nil {
+		if cfg.GasPriceOracleBlocks, err = cast.ToIntE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagGasPriceOraclePercentile); v != nil {
+		if cfg.GasPriceOraclePercentile, err = cast.ToIntE(v); err != nil {
+			return cfg, err
+		}
+		if cfg.GasPriceOraclePercentile < 0 || cfg.GasPriceOraclePercentile > 100 {
+			return cfg, fmt.Errorf("%s must be between 0 and 100, got %d", flagGasPriceOraclePercentile, cfg.GasPriceOraclePercentile)
+		}
+	}
+	if v := opts.Get(flagGasPriceOracleIgnorePrice); v != nil {
+		if cfg.GasPriceOracleIgnorePrice, err = cast.ToUint64E(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagGasPriceOracleMaxPrice); v != nil {
+		if cfg.GasPriceOracleMaxPrice, err = cast.ToUint64E(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagSendRawTransactionSyncMaxTimeout); v != nil {
+		if cfg.SendRawTransactionSyncMaxTimeout, err = cast.ToDurationE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagResponseCacheSize); v != nil {
+		if cfg.ResponseCacheSize, err = cast.ToIntE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagEnableAddressTxIndex); v != nil {
+		if cfg.EnableAddressTxIndex, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagAddressTxIndexRetention); v != nil {
+		if cfg.AddressTxIndexRetention, err = cast.ToInt64E(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagArchiveFallbackURL); v != nil {
+		if cfg.ArchiveFallbackURL, err = cast.ToStringE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagArchiveFallbackMethods); v != nil {
+		if cfg.ArchiveFallbackMethods, err = cast.ToStringSliceE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagArchiveFallbackTimeout); v != nil {
+		if cfg.ArchiveFallbackTimeout, err = cast.ToDurationE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagRestrictedMethods); v != nil {
+		if cfg.RestrictedMethods, err = cast.ToStringSliceE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagAccessPolicies); v != nil {
+		if cfg.AccessPolicies, err = cast.ToStringSliceE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagEnableGraphQL); v != nil {
+		if cfg.EnableGraphQL, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
 	return cfg, nil
 }
@@ -30,6 +30,33 @@ type opts struct {
 	maxBlocksForLog         interface{}
 	maxSubscriptionsNewHead interface{}
 	enableTestAPI           interface{}
+
+	maxSubscriptionsNewPendingTxs    interface{}
+	maxSubscriptionsSyncing          interface{}
+	bloomScanWorkers                 interface{}
+	enableBloomBitsIndex             interface{}
+	rateLimits                       interface{}
+	maxBatchSize                     interface{}
+	maxResponseBytes                 interface{}
+	maxConcurrentHeavyCalls          interface{}
+	heavyMethods                     interface{}
+	enablePendingState               interface{}
+	pendingStateMaxTxs               interface{}
+	pendingStateRefreshInterval      interface{}
+	gasPriceOracleBlocks             interface{}
+	gasPriceOraclePercentile         interface{}
+	gasPriceOracleIgnorePrice        interface{}
+	gasPriceOracleMaxPrice           interface{}
+	sendRawTransactionSyncMaxTimeout interface{}
+	responseCacheSize                interface{}
+	enableAddressTxIndex             interface{}
+	addressTxIndexRetention          interface{}
+	archiveFallbackURL               interface{}
+	archiveFallbackMethods           interface{}
+	archiveFallbackTimeout           interface{}
+	restrictedMethods                interface{}
+	accessPolicies                   interface{}
+	enableGraphQL                    interface{}
 }
 
 func (o *opts) Get(k string) interface{} {
@@ -96,6 +123,84 @@ func (o *opts) Get(k string) interface{} {
 	if k == "evm.enable_test_api" {
 		return o.enableTestAPI
 	}
+	if k == "evm.max_subscriptions_new_pending_txs" {
+		return o.maxSubscriptionsNewPendingTxs
+	}
+	if k == "evm.max_subscriptions_syncing" {
+		return o.maxSubscriptionsSyncing
+	}
+	if k == "evm.bloom_scan_workers" {
+		return o.bloomScanWorkers
+	}
+	if k == "evm.enable_bloom_bits_index" {
+		return o.enableBloomBitsIndex
+	}
+	if k == "evm.rate_limits" {
+		return o.rateLimits
+	}
+	if k == "evm.max_batch_size" {
+		return o.maxBatchSize
+	}
+	if k == "evm.max_response_bytes" {
+		return o.maxResponseBytes
+	}
+	if k == "evm.max_concurrent_heavy_calls" {
+		return o.maxConcurrentHeavyCalls
+	}
+	if k == "evm.heavy_methods" {
+		return o.heavyMethods
+	}
+	if k == "evm.enable_pending_state" {
+		return o.enablePendingState
+	}
+	if k == "evm.pending_state_max_txs" {
+		return o.pendingStateMaxTxs
+	}
+	if k == "evm.pending_state_refresh_interval" {
+		return o.pendingStateRefreshInterval
+	}
+	if k == "evm.gas_price_oracle_blocks" {
+		return o.gasPriceOracleBlocks
+	}
+	if k == "evm.gas_price_oracle_percentile" {
+		return o.gasPriceOraclePercentile
+	}
+	if k == "evm.gas_price_oracle_ignore_price" {
+		return o.gasPriceOracleIgnorePrice
+	}
+	if k == "evm.gas_price_oracle_max_price" {
+		return o.gasPriceOracleMaxPrice
+	}
+	if k == "evm.send_raw_transaction_sync_max_timeout" {
+		return o.sendRawTransactionSyncMaxTimeout
+	}
+	if k == "evm.response_cache_size" {
+		return o.responseCacheSize
+	}
+	if k == "evm.enable_address_tx_index" {
+		return o.enableAddressTxIndex
+	}
+	if k == "evm.address_tx_index_retention" {
+		return o.addressTxIndexRetention
+	}
+	if k == "evm.archive_fallback_url" {
+		return o.archiveFallbackURL
+	}
+	if k == "evm.archive_fallback_methods" {
+		return o.archiveFallbackMethods
+	}
+	if k == "evm.archive_fallback_timeout" {
+		return o.archiveFallbackTimeout
+	}
+	if k == "evm.restricted_methods" {
+		return o.restrictedMethods
+	}
+	if k == "evm.access_policies" {
+		return o.accessPolicies
+	}
+	if k == "evm.enable_graphql" {
+		return o.enableGraphQL
+	}
 	panic("unknown key")
 }
 
@@ -122,6 +227,32 @@ func TestReadConfig(t *testing.T) {
 		1000,
 		10000,
 		false,
+		10000,
+		10000,
+		16,
+		false,
+		[]string{"*=100:200", "debug=1"},
+		1000,
+		0,
+		4,
+		[]string{"debug_trace*"},
+		true,
+		100,
+		time.Duration(5),
+		20,
+		60,
+		uint64(2),
+		uint64(500_000_000_000),
+		time.Duration(5),
+		1024,
+		true,
+		int64(100),
+		"http://localhost:8545",
+		[]string{"eth_getBalance", "debug_trace*"},
+		time.Duration(5),
+		[]string{"debug", "txpool", "trace"},
+		[]string{"key:indexer:secret=debug,trace@10"},
+		true,
 	}
 	_, err := evmrpc.ReadConfig(&goodOpts)
 	require.Nil(t, err)
@@ -193,4 +324,116 @@ func TestReadConfig(t *testing.T) {
 	badOpts.denyList = map[string]interface{}{}
 	_, err = evmrpc.ReadConfig(&badOpts)
 	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.maxSubscriptionsNewPendingTxs = "bad"
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.maxSubscriptionsSyncing = "bad"
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.bloomScanWorkers = "bad"
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.enableBloomBitsIndex = "bad"
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.rateLimits = map[string]interface{}{}
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.maxBatchSize = "bad"
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.maxResponseBytes = "bad"
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.maxConcurrentHeavyCalls = "bad"
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.heavyMethods = map[string]interface{}{}
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.enablePendingState = "bad"
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.pendingStateMaxTxs = "bad"
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.pendingStateRefreshInterval = "bad"
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.gasPriceOracleBlocks = "bad"
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.gasPriceOraclePercentile = "bad"
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.gasPriceOraclePercentile = 101
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.gasPriceOraclePercentile = -1
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.gasPriceOracleIgnorePrice = "bad"
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.gasPriceOracleMaxPrice = "bad"
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.sendRawTransactionSyncMaxTimeout = "bad"
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.responseCacheSize = "bad"
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.enableAddressTxIndex = "bad"
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.addressTxIndexRetention = "bad"
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.archiveFallbackURL = map[string]interface{}{}
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.archiveFallbackMethods = map[string]interface{}{}
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.archiveFallbackTimeout = "bad"
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.restrictedMethods = map[string]interface{}{}
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.accessPolicies = map[string]interface{}{}
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
+	badOpts = goodOpts
+	badOpts.enableGraphQL = "bad"
+	_, err = evmrpc.ReadConfig(&badOpts)
+	require.NotNil(t, err)
 }
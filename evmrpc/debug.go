@@ -0,0 +1,298 @@
+package evmrpc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethstate "github.com/ethereum/go-ethereum/core/state"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/kiichain/kiichain3/x/evm/keeper"
+	"github.com/kiichain/kiichain3/x/evm/state"
+	"github.com/kiichain/kiichain3/x/evm/types"
+)
+
+const (
+	// AccountRangeMaxResults caps the number of accounts returned by one
+	// debug_accountRange call, as in go-ethereum.
+	AccountRangeMaxResults = 256
+
+	// AccountRangeMaxStorageSlots caps the number of storage slots returned by
+	// one debug_accountRange call. Larger storages can be read page by page
+	// with debug_storageRangeAt.
+	AccountRangeMaxStorageSlots = 4096
+)
+
+// BadBlockArgs is an entry of debug_getBadBlocks, as in go-ethereum.
+type BadBlockArgs struct {
+	Hash  common.Hash            `json:"hash"`
+	Block map[string]interface{} `json:"block"`
+	RLP   string                 `json:"rlp"`
+}
+
+// StorageRangeResult is the result of debug_storageRangeAt. Unlike go-ethereum,
+// whose storage is a trie keyed by slot hash, slots are walked in the order of
+// their keys, so NextKey and keyStart are slot keys rather than slot hashes.
+type StorageRangeResult struct {
+	Storage map[common.Hash]StorageEntry `json:"storage"`
+	NextKey *common.Hash                 `json:"nextKey"` // nil if Storage includes the last slot
+}
+
+type StorageEntry struct {
+	Key   *common.Hash `json:"key"`
+	Value common.Hash  `json:"value"`
+}
+
+// GetRawTransaction returns the binary encoding of a transaction, or empty
+// bytes if it is not known.
+func (api *DebugAPI) GetRawTransaction(ctx context.Context, hash common.Hash) (result hexutil.Bytes, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("debug_getRawTransaction", api.connectionType, startTime, returnErr == nil)
+	tx, _, _, _, err := api.backend.GetTransaction(ctx, hash)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return tx.MarshalBinary()
+}
+
+// GetRawReceipts returns the consensus encoding of the receipts of a block.
+func (api *DebugAPI) GetRawReceipts(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (result []hexutil.Bytes, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("debug_getRawReceipts", api.connectionType, startTime, returnErr == nil)
+	height, err := api.backend.getBlockHeight(ctx, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	receipts, err := api.ethReceipts(height)
+	if err != nil {
+		return nil, err
+	}
+	result = make([]hexutil.Bytes, 0, len(receipts))
+	for _, receipt := range receipts {
+		bz, err := receipt.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, bz)
+	}
+	return result, nil
+}
+
+// GetRawBlock returns the RLP encoding of a block in the Ethereum format. The
+// parent hash is the hash of the parent Tendermint block, so the encoded block
+// can be passed to debug_traceBlock. Its own hash, being the hash of the
+// Ethereum header, differs from the Tendermint block hash.
+func (api *DebugAPI) GetRawBlock(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (result hexutil.Bytes, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("debug_getRawBlock", api.connectionType, startTime, returnErr == nil)
+	height, err := api.backend.getBlockHeight(ctx, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	block, err := api.backend.BlockByNumber(ctx, rpc.BlockNumber(height))
+	if err != nil {
+		return nil, err
+	}
+	tmBlock, err := blockByNumberWithRetry(ctx, api.tmClient, &height, 1)
+	if err != nil {
+		return nil, err
+	}
+	receipts, err := api.ethReceipts(height)
+	if err != nil {
+		return nil, err
+	}
+	header := ethtypes.CopyHeader(block.Header())
+	header.ParentHash = common.BytesToHash(tmBlock.Block.Header.LastBlockID.Hash)
+	// blob transactions are not supported, and the encoding of the blob gas
+	// fields requires the withdrawals hash that blocks don't have
+	header.ExcessBlobGas = nil
+	header.GasUsed = 0
+	if len(receipts) > 0 {
+		header.GasUsed = receipts[len(receipts)-1].CumulativeGasUsed
+	}
+	return rlp.EncodeToBytes(ethtypes.NewBlock(header, block.Transactions(), nil, receipts, trie.NewStackTrie(nil)))
+}
+
+// StorageRangeAt returns up to maxResult storage slots of a contract, starting
+// at keyStart, as they were before the transaction at txIndex in the block.
+func (api *DebugAPI) StorageRangeAt(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash, txIndex int, contractAddress common.Address, keyStart hexutil.Bytes, maxResult int) (result StorageRangeResult, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("debug_storageRangeAt", api.connectionType, startTime, returnErr == nil)
+	if maxResult <= 0 {
+		return StorageRangeResult{}, errors.New("maxResult must be greater than 0")
+	}
+	height, err := api.backend.getBlockHeight(ctx, blockNrOrHash)
+	if err != nil {
+		return StorageRangeResult{}, err
+	}
+	block, err := api.backend.BlockByNumber(ctx, rpc.BlockNumber(height))
+	if err != nil {
+		return StorageRangeResult{}, err
+	}
+	_, _, statedb, release, err := api.backend.StateAtTransaction(ctx, block, txIndex, 0)
+	if err != nil {
+		return StorageRangeResult{}, err
+	}
+	defer release()
+	db, ok := statedb.(*state.DBImpl)
+	if !ok {
+		return StorageRangeResult{}, fmt.Errorf("unexpected state type %T", statedb)
+	}
+
+	result = StorageRangeResult{Storage: map[common.Hash]StorageEntry{}}
+	start := common.BytesToHash(keyStart)
+	iter := api.keeper.PrefixStore(db.Ctx(), types.StateKey(contractAddress)).Iterator(start[:], nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		key := common.BytesToHash(iter.Key())
+		if len(result.Storage) == maxResult {
+			result.NextKey = &key
+			break
+		}
+		result.Storage[crypto.Keccak256Hash(key[:])] = StorageEntry{Key: &key, Value: common.BytesToHash(iter.Value())}
+	}
+	return result, nil
+}
+
+// AccountRange returns up to maxResults EVM accounts, in the order of their
+// addresses and starting at start, along with their code and storage unless
+// excluded. EVM accounts are the addresses that are associated, have code or
+// have sent a transaction. The incompletes flag is accepted for compatibility
+// but has no effect since all addresses are known.
+func (api *DebugAPI) AccountRange(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash, start hexutil.Bytes, maxResults int, nocode, nostorage, incompletes bool) (result ethstate.Dump, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("debug_accountRange", api.connectionType, startTime, returnErr == nil)
+	if maxResults <= 0 || maxResults > AccountRangeMaxResults {
+		maxResults = AccountRangeMaxResults
+	}
+	block, err := GetBlockNumberByNrOrHash(ctx, api.tmClient, blockNrOrHash)
+	if err != nil {
+		return ethstate.Dump{}, err
+	}
+	sdkCtx := api.ctxProvider(LatestCtxHeight)
+	if block != nil {
+		sdkCtx = api.ctxProvider(*block)
+		if err := CheckVersion(sdkCtx, api.keeper); err != nil {
+			returnErr = api.archive.forward(ctx, err, &result, "debug_accountRange", blockNrOrHash, start, maxResults, nocode, nostorage, incompletes)
+			return
+		}
+	}
+
+	addresses := collectAccountAddresses(api.keeper, sdkCtx, common.BytesToAddress(start), maxResults+1)
+	result = ethstate.Dump{Accounts: map[string]ethstate.DumpAccount{}}
+	if len(addresses) > maxResults {
+		result.Next = addresses[maxResults].Bytes()
+		addresses = addresses[:maxResults]
+	}
+	db := state.NewDBImpl(sdkCtx, api.keeper, true)
+	storageSlots := AccountRangeMaxStorageSlots
+	for i, addr := range addresses {
+		addr := addr
+		account := ethstate.DumpAccount{
+			Balance:  db.GetBalance(addr).String(),
+			Nonce:    db.GetNonce(addr),
+			CodeHash: db.GetCodeHash(addr).Bytes(),
+			Address:  &addr,
+		}
+		if !nocode {
+			account.Code = db.GetCode(addr)
+		}
+		if !nostorage {
+			account.Storage = map[common.Hash]string{}
+			truncated := false
+			api.keeper.IterateAll(sdkCtx, types.StateKey(addr), func(key, val []byte) bool {
+				if len(account.Storage) == storageSlots {
+					truncated = true
+					return true
+				}
+				account.Storage[common.BytesToHash(key)] = common.Bytes2Hex(common.TrimLeftZeroes(val))
+				return false
+			})
+			if truncated {
+				if i == 0 {
+					return ethstate.Dump{}, fmt.Errorf("storage of %s exceeds %d slots, use debug_storageRangeAt", addr.Hex(), AccountRangeMaxStorageSlots)
+				}
+				// the next page starts at the account whose storage doesn't fit
+				result.Next = addr.Bytes()
+				break
+			}
+			storageSlots -= len(account.Storage)
+		}
+		result.Accounts[addr.Hex()] = account
+	}
+	return result, nil
+}
+
+// GetBadBlocks returns the blocks that failed validation. Blocks are only
+// committed once validators agree on them, and a node that fails to execute a
+// committed block halts instead of skipping it, so there are never any bad
+// blocks to report. The method is served for the tooling that expects it.
+func (api *DebugAPI) GetBadBlocks(ctx context.Context) (result []*BadBlockArgs, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("debug_getBadBlocks", api.connectionType, startTime, returnErr == nil)
+	return []*BadBlockArgs{}, nil
+}
+
+// ethReceipts returns the receipts of the EVM transactions of a block in the
+// Ethereum format.
+func (api *DebugAPI) ethReceipts(height int64) ([]*ethtypes.Receipt, error) {
+	sdkCtx := api.ctxProvider(height)
+	receipts := []*ethtypes.Receipt{}
+	for _, hash := range api.keeper.GetTxHashesOnHeight(sdkCtx, height) {
+		receipt, err := api.keeper.GetReceipt(sdkCtx, hash)
+		if err != nil {
+			if strings.Contains(err.Error(), "not found") {
+				continue
+			}
+			return nil, err
+		}
+		if receipt.TxType == ShellEVMTxType {
+			continue
+		}
+		receipts = append(receipts, &ethtypes.Receipt{
+			Type:              uint8(receipt.TxType),
+			Status:            uint64(receipt.Status),
+			CumulativeGasUsed: receipt.CumulativeGasUsed,
+			Bloom:             ethtypes.BytesToBloom(receipt.LogsBloom),
+			Logs:              keeper.GetLogsForTx(receipt),
+		})
+	}
+	return receipts, nil
+}
+
+// collectAccountAddresses returns the first limit addresses from start on that
+// are associated, have code or have a nonce, in ascending order.
+func collectAccountAddresses(k *keeper.Keeper, ctx sdk.Context, start common.Address, limit int) []common.Address {
+	seen := map[common.Address]struct{}{}
+	for _, prefix := range [][]byte{types.EVMAddressToKiiAddressKeyPrefix, types.CodeKeyPrefix, types.NonceKeyPrefix} {
+		iter := k.PrefixStore(ctx, prefix).Iterator(start[:], nil)
+		for n := 0; iter.Valid() && n < limit; iter.Next() {
+			seen[common.BytesToAddress(iter.Key())] = struct{}{}
+			n++
+		}
+		iter.Close()
+	}
+	addresses := make([]common.Address, 0, len(seen))
+	for addr := range seen {
+		addresses = append(addresses, addr)
+	}
+	sort.Slice(addresses, func(i, j int) bool { return bytes.Compare(addresses[i][:], addresses[j][:]) < 0 })
+	if len(addresses) > limit {
+		addresses = addresses[:limit]
+	}
+	return addresses
+}
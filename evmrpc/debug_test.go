@@ -0,0 +1,121 @@
+package evmrpc_test
+
+import (
+	"encoding/base64"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/kiichain/kiichain3/evmrpc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRawTransaction(t *testing.T) {
+	resObj := sendRequestGoodWithNamespace(t, "debug", "getRawTransaction", DebugTraceHashHex)
+	tx := new(ethtypes.Transaction)
+	require.Nil(t, tx.UnmarshalBinary(hexutil.MustDecode(resObj["result"].(string))))
+	require.Equal(t, common.HexToAddress("0x0000000000000000000000000000000000010203"), *tx.To())
+	require.Equal(t, []byte("abc"), tx.Data())
+
+	resObj = sendRequestGoodWithNamespace(t, "debug", "getRawTransaction", "0x0000000000000000000000000000000000000000000000000000000000000123")
+	require.Equal(t, "0x", resObj["result"])
+}
+
+func TestGetRawReceipts(t *testing.T) {
+	resObj := sendRequestGoodWithNamespace(t, "debug", "getRawReceipts", "0x65")
+	result := resObj["result"].([]interface{})
+	require.Len(t, result, 1)
+	receipt := new(ethtypes.Receipt)
+	require.Nil(t, receipt.UnmarshalBinary(hexutil.MustDecode(result[0].(string))))
+}
+
+func TestGetRawBlockAndTraceBlock(t *testing.T) {
+	resObj := sendRequestGoodWithNamespace(t, "debug", "getRawBlock", "0x65")
+	raw := resObj["result"].(string)
+	block := new(ethtypes.Block)
+	require.Nil(t, rlp.DecodeBytes(hexutil.MustDecode(raw), block))
+	require.Equal(t, uint64(DebugTraceMockHeight), block.NumberU64())
+	require.Len(t, block.Transactions(), 1)
+	require.Equal(t, common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000006"), block.ParentHash())
+
+	resObj = sendRequestGoodWithNamespace(t, "debug", "traceBlock", raw, map[string]interface{}{"tracer": "callTracer"})
+	result := resObj["result"].([]interface{})[0].(map[string]interface{})["result"].(map[string]interface{})
+	require.Equal(t, "0x5b4eba929f3811980f5ae0c5d04fa200f837df4e", result["from"])
+	require.Equal(t, "0x0000000000000000000000000000000000010203", result["to"])
+	require.Equal(t, "CALL", result["type"])
+}
+
+func TestStorageRangeAt(t *testing.T) {
+	contract := "0x1234567890123456789023456789012345678901"
+	key := common.BytesToHash([]byte("key"))
+	resObj := sendRequestGoodWithNamespace(t, "debug", "storageRangeAt", "0x65", 0, contract, "0x", 10)
+	result := resObj["result"].(map[string]interface{})
+	require.Nil(t, result["nextKey"])
+	storage := result["storage"].(map[string]interface{})
+	require.Len(t, storage, 1)
+	entry := storage[common.BytesToHash(crypto.Keccak256(key[:])).Hex()].(map[string]interface{})
+	require.Equal(t, key.Hex(), entry["key"])
+	require.Equal(t, common.BytesToHash([]byte("value")).Hex(), entry["value"])
+
+	// starting after the only slot
+	resObj = sendRequestGoodWithNamespace(t, "debug", "storageRangeAt", "0x65", 0, contract, common.BigToHash(key.Big().Add(key.Big(), common.Big1)).Hex(), 10)
+	require.Empty(t, resObj["result"].(map[string]interface{})["storage"])
+
+	resObj = sendRequestGoodWithNamespace(t, "debug", "storageRangeAt", "0x65", 0, contract, "0x", 0)
+	require.Contains(t, resObj["error"].(map[string]interface{})["message"], "maxResult")
+}
+
+func TestAccountRange(t *testing.T) {
+	contract := common.HexToAddress("0x1234567890123456789023456789012345678901")
+	resObj := sendRequestGoodWithNamespace(t, "debug", "accountRange", "latest", contract.Hex(), 1, false, false, false)
+	result := resObj["result"].(map[string]interface{})
+	accounts := result["accounts"].(map[string]interface{})
+	require.Len(t, accounts, 1)
+	account := accounts[contract.Hex()].(map[string]interface{})
+	require.Equal(t, "0x616263", account["code"])
+	require.Len(t, account["storage"], 1)
+	require.NotNil(t, result["next"])
+
+	// the next page starts at the returned key
+	resObj = sendRequestGoodWithNamespace(t, "debug", "accountRange", "latest", contract.Hex(), 2, true, true, false)
+	accounts = resObj["result"].(map[string]interface{})["accounts"].(map[string]interface{})
+	require.Len(t, accounts, 2)
+	require.Nil(t, accounts[contract.Hex()].(map[string]interface{})["code"])
+}
+
+func TestAccountRangeStorageCap(t *testing.T) {
+	small := common.HexToAddress("0xfffffffffffffffffffffffffffffffffffffffd")
+	large := common.HexToAddress("0xfffffffffffffffffffffffffffffffffffffffe")
+	tooLarge := common.HexToAddress("0xffffffffffffffffffffffffffffffffffffffff")
+	setStorage := func(addr common.Address, slots int) {
+		EVMKeeper.SetCode(Ctx, addr, []byte("abc"))
+		for i := 0; i < slots; i++ {
+			EVMKeeper.SetState(Ctx, addr, common.BigToHash(big.NewInt(int64(i))), common.Hash{1})
+		}
+	}
+	setStorage(small, 1)
+	setStorage(large, evmrpc.AccountRangeMaxStorageSlots)
+	setStorage(tooLarge, evmrpc.AccountRangeMaxStorageSlots+1)
+
+	// a page ends before the first account whose storage doesn't fit
+	resObj := sendRequestGoodWithNamespace(t, "debug", "accountRange", "latest", small.Hex(), 3, true, false, false)
+	result := resObj["result"].(map[string]interface{})
+	require.Len(t, result["accounts"], 1)
+	require.Equal(t, base64.StdEncoding.EncodeToString(large.Bytes()), result["next"])
+
+	resObj = sendRequestGoodWithNamespace(t, "debug", "accountRange", "latest", large.Hex(), 1, true, false, false)
+	accounts := resObj["result"].(map[string]interface{})["accounts"].(map[string]interface{})
+	require.Len(t, accounts[large.Hex()].(map[string]interface{})["storage"], evmrpc.AccountRangeMaxStorageSlots)
+
+	resObj = sendRequestGoodWithNamespace(t, "debug", "accountRange", "latest", tooLarge.Hex(), 1, true, false, false)
+	require.Contains(t, resObj["error"].(map[string]interface{})["message"], "use debug_storageRangeAt")
+}
+
+func TestGetBadBlocks(t *testing.T) {
+	resObj := sendRequestGoodWithNamespace(t, "debug", "getBadBlocks")
+	require.Equal(t, []interface{}{}, resObj["result"])
+}
@@ -1,20 +1,24 @@
 package evmrpc
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"sync"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/bitutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/filters"
 	ethrpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/kiichain/kiichain3/utils"
 	"github.com/kiichain/kiichain3/x/evm/keeper"
+	"github.com/kiichain/kiichain3/x/evm/types"
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
 	"github.com/tendermint/tendermint/rpc/coretypes"
 	tmtypes "github.com/tendermint/tendermint/types"
@@ -53,9 +57,11 @@ type FilterAPI struct {
 }
 
 type FilterConfig struct {
-	timeout  time.Duration
-	maxLog   int64
-	maxBlock int64
+	timeout          time.Duration
+	maxLog           int64
+	maxBlock         int64
+	bloomScanWorkers int
+	useBloomBits     bool
 }
 
 type EventItemDataWrapper struct {
@@ -63,8 +69,8 @@ type EventItemDataWrapper struct {
 	Value json.RawMessage `json:"value"`
 }
 
-func NewFilterAPI(tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, filterConfig *FilterConfig, connectionType ConnectionType, namespace string) *FilterAPI {
-	logFetcher := &LogFetcher{tmClient: tmClient, k: k, ctxProvider: ctxProvider, filterConfig: filterConfig, includeSyntheticReceipts: shouldIncludeSynthetic(namespace)}
+func NewFilterAPI(tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, filterConfig *FilterConfig, cache *ResponseCache, connectionType ConnectionType, namespace string) *FilterAPI {
+	logFetcher := &LogFetcher{tmClient: tmClient, k: k, ctxProvider: ctxProvider, filterConfig: filterConfig, includeSyntheticReceipts: shouldIncludeSynthetic(namespace), cache: cache}
 	filters := make(map[ethrpc.ID]filter)
 	api := &FilterAPI{
 		namespace:      namespace,
@@ -279,30 +285,20 @@ type LogFetcher struct {
 	ctxProvider              func(int64) sdk.Context
 	filterConfig             *FilterConfig
 	includeSyntheticReceipts bool
+	cache                    *ResponseCache
 }
 
 func (f *LogFetcher) GetLogsByFilters(ctx context.Context, crit filters.FilterCriteria, lastToHeight int64) ([]*ethtypes.Log, int64, error) {
 	bloomIndexes := EncodeFilters(crit.Addresses, crit.Topics)
 	if crit.BlockHash != nil {
-		block, err := blockByHashWithRetry(ctx, f.tmClient, crit.BlockHash[:], 1)
+		block, err := f.cache.blockByHash(ctx, f.tmClient, *crit.BlockHash, f.ctxProvider(LatestCtxHeight).BlockHeight())
 		if err != nil {
 			return nil, 0, err
 		}
 		return f.GetLogsForBlock(ctx, block, crit, bloomIndexes), block.Block.Height, nil
 	}
 	applyOpenEndedLogLimit := f.filterConfig.maxLog > 0 && (crit.FromBlock == nil || crit.ToBlock == nil)
-	latest := f.ctxProvider(LatestCtxHeight).BlockHeight()
-	begin, end := latest, latest
-	if crit.FromBlock != nil {
-		begin = getHeightFromBigIntBlockNumber(latest, crit.FromBlock)
-	}
-	if crit.ToBlock != nil {
-		end = getHeightFromBigIntBlockNumber(latest, crit.ToBlock)
-		// only if fromBlock is not specified, default it to end block
-		if crit.FromBlock == nil && begin > end {
-			begin = end
-		}
-	}
+	begin, end := f.blockRange(crit)
 	if lastToHeight > begin {
 		begin = lastToHeight
 	}
@@ -315,22 +311,67 @@ func (f *LogFetcher) GetLogsByFilters(ctx context.Context, crit filters.FilterCr
 	}
 	blockHeights := f.FindBlockesByBloom(begin, end, bloomIndexes)
 	res := []*ethtypes.Log{}
-	for _, height := range blockHeights {
-		h := height
-		block, err := blockByNumberWithRetry(ctx, f.tmClient, &h, 1)
-		if err != nil {
-			return nil, 0, err
-		}
-		res = append(res, f.GetLogsForBlock(ctx, block, crit, bloomIndexes)...)
+	err := f.forEachBlockLogs(ctx, blockHeights, crit, bloomIndexes, func(_ int64, logs []*ethtypes.Log) bool {
+		res = append(res, logs...)
 		if applyOpenEndedLogLimit && int64(len(res)) >= f.filterConfig.maxLog {
 			res = res[:int(f.filterConfig.maxLog)]
-			break
+			return false
 		}
+		return true
+	})
+	if err != nil {
+		return nil, 0, err
 	}
 
 	return res, end, nil
 }
 
+// forEachBlockLogs fetches the matching logs of the given heights in parallel
+// batches and passes them to fn in height order until fn returns false.
+func (f *LogFetcher) forEachBlockLogs(ctx context.Context, heights []int64, crit filters.FilterCriteria, bloomIndexes [][]bloomIndexes, fn func(height int64, logs []*ethtypes.Log) bool) error {
+	// fetch logs in batches so that callers can stop early once they have enough
+	batchSize := f.workers() * 4
+	committed := f.ctxProvider(LatestCtxHeight).BlockHeight()
+	for start := 0; start < len(heights); start += batchSize {
+		batch := heights[start:min(start+batchSize, len(heights))]
+		batchLogs, err := parallelMap(len(batch), f.workers(), func(i int) ([]*ethtypes.Log, error) {
+			h := batch[i]
+			block, err := f.cache.blockByNumber(ctx, f.tmClient, &h, committed)
+			if err != nil {
+				return nil, err
+			}
+			return f.GetLogsForBlock(ctx, block, crit, bloomIndexes), nil
+		})
+		if err != nil {
+			return err
+		}
+		for i, logs := range batchLogs {
+			if !fn(batch[i], logs) {
+				return nil
+			}
+		}
+	}
+	return nil
+}
+
+// blockRange resolves the fromBlock and toBlock of the criteria against the
+// latest height. Both default to the latest block.
+func (f *LogFetcher) blockRange(crit filters.FilterCriteria) (begin int64, end int64) {
+	latest := f.ctxProvider(LatestCtxHeight).BlockHeight()
+	begin, end = latest, latest
+	if crit.FromBlock != nil {
+		begin = getHeightFromBigIntBlockNumber(latest, crit.FromBlock)
+	}
+	if crit.ToBlock != nil {
+		end = getHeightFromBigIntBlockNumber(latest, crit.ToBlock)
+		// only if fromBlock is not specified, default it to end block
+		if crit.FromBlock == nil && begin > end {
+			begin = end
+		}
+	}
+	return
+}
+
 func (f *LogFetcher) GetLogsForBlock(ctx context.Context, block *coretypes.ResultBlock, crit filters.FilterCriteria, filters [][]bloomIndexes) []*ethtypes.Log {
 	possibleLogs := f.FindLogsByBloom(block.Block.Height, filters)
 	matchedLogs := utils.Filter(possibleLogs, func(l *ethtypes.Log) bool { return f.IsLogExactMatch(l, crit) })
@@ -340,22 +381,136 @@ func (f *LogFetcher) GetLogsForBlock(ctx context.Context, block *coretypes.Resul
 	return matchedLogs
 }
 
+// FindBlockesByBloom returns the heights in [begin, end] whose block bloom matches
+// the filters. Sections covered by the bloom-bits index are answered from the
+// index; all other heights are scanned by a bounded pool of workers.
 func (f *LogFetcher) FindBlockesByBloom(begin, end int64, filters [][]bloomIndexes) (res []int64) {
-	//TODO: parallelize
-	for height := begin; height <= end; height++ {
-		if height == 0 {
-			// no block bloom on genesis height
-			continue
-		}
-		ctx := f.ctxProvider(height)
-		blockBloom := f.k.GetBlockBloom(ctx)
-		if MatchFilters(blockBloom, filters) {
+	if len(filters) == 0 {
+		// every block matches an empty filter, no need to load any bloom
+		for height := max(begin, 1); height <= end; height++ {
 			res = append(res, height)
 		}
+		return
 	}
+	toScan := []int64{}
+	for section := begin / types.BloomBitsSectionSize; section <= end/types.BloomBitsSectionSize; section++ {
+		lo := max(begin, section*types.BloomBitsSectionSize)
+		hi := min(end, (section+1)*types.BloomBitsSectionSize-1)
+		if f.filterConfig != nil && f.filterConfig.useBloomBits {
+			if heights, indexed := f.findBlocksByBloomBits(uint64(section), lo, hi, filters); indexed {
+				res = append(res, heights...)
+				continue
+			}
+		}
+		for height := lo; height <= hi; height++ {
+			if height == 0 {
+				// no block bloom on genesis height
+				continue
+			}
+			toScan = append(toScan, height)
+		}
+	}
+	matched, _ := parallelMap(len(toScan), f.workers(), func(i int) (bool, error) {
+		ctx := f.ctxProvider(toScan[i])
+		return MatchFilters(f.k.GetBlockBloom(ctx), filters), nil
+	})
+	for i, ok := range matched {
+		if ok {
+			res = append(res, toScan[i])
+		}
+	}
+	slices.Sort(res)
 	return
 }
 
+// findBlocksByBloomBits matches the filters against the bloom-bits index of a
+// section and returns the candidate heights in [lo, hi]. It returns false if the
+// section has not been indexed.
+func (f *LogFetcher) findBlocksByBloomBits(section uint64, lo, hi int64, filters [][]bloomIndexes) ([]int64, bool) {
+	vectors := map[uint][]byte{}
+	getVector := func(bit uint) ([]byte, bool) {
+		if v, ok := vectors[bit]; ok {
+			return v, true
+		}
+		v, indexed, err := f.k.GetBloomBits(bit, section)
+		if err != nil || !indexed {
+			return nil, false
+		}
+		vectors[bit] = v
+		return v, true
+	}
+	// AND on outer level, OR on mid level, AND on inner level, as in MatchFilters
+	var result []byte
+	for _, filter := range filters {
+		group := make([]byte, types.BloomBitsSectionSize/8)
+		for _, possibility := range filter {
+			var all []byte
+			for _, bit := range possibility {
+				v, ok := getVector(bit)
+				if !ok {
+					return nil, false
+				}
+				if all == nil {
+					all = bytes.Clone(v)
+				} else {
+					bitutil.ANDBytes(all, all, v)
+				}
+			}
+			bitutil.ORBytes(group, group, all)
+		}
+		if result == nil {
+			result = group
+		} else {
+			bitutil.ANDBytes(result, result, group)
+		}
+	}
+	first := int64(section) * types.BloomBitsSectionSize
+	res := []int64{}
+	for height := lo; height <= hi; height++ {
+		i := height - first
+		if height != 0 && result[i/8]&(1<<(7-i%8)) != 0 {
+			res = append(res, height)
+		}
+	}
+	return res, true
+}
+
+func (f *LogFetcher) workers() int {
+	if f.filterConfig == nil || f.filterConfig.bloomScanWorkers <= 0 {
+		return 1
+	}
+	return f.filterConfig.bloomScanWorkers
+}
+
+// parallelMap calls fn for every index in [0, n) using at most workers goroutines
+// and returns the results in index order, along with the first error encountered.
+func parallelMap[T any](n int, workers int, fn func(i int) (T, error)) ([]T, error) {
+	res := make([]T, n)
+	errs := make([]error, n)
+	indexes := make(chan int)
+	wg := sync.WaitGroup{}
+	for w := 0; w < min(workers, n); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				res[i], errs[i] = fn(i)
+			}
+		}()
+	}
+	for i := 0; i < n; i++ {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
 func (f *LogFetcher) FindLogsByBloom(height int64, filters [][]bloomIndexes) (res []*ethtypes.Log) {
 	ctx := f.ctxProvider(LatestCtxHeight)
 	txHashes := f.k.GetTxHashesOnHeight(ctx, height)
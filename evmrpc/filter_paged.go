@@ -0,0 +1,163 @@
+package evmrpc
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/kiichain/kiichain3/x/evm/keeper"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+)
+
+// DefaultLogsPageSize is the page size used when neither the caller nor the
+// node config (max_log_no_block) limits the number of logs per page.
+const DefaultLogsPageSize = 10000
+
+// LogsPagedAPI serves log queries page by page. Unlike eth_getLogs, which
+// silently caps open-ended queries at max_log_no_block logs and bounded
+// queries at max_blocks_for_log blocks, every page tells the caller whether
+// the range has been exhausted and, if not, where to resume.
+type LogsPagedAPI struct {
+	logFetcher     *LogFetcher
+	filterConfig   *FilterConfig
+	connectionType ConnectionType
+}
+
+func NewLogsPagedAPI(tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, filterConfig *FilterConfig, cache *ResponseCache, connectionType ConnectionType) *LogsPagedAPI {
+	logFetcher := &LogFetcher{tmClient: tmClient, k: k, ctxProvider: ctxProvider, filterConfig: filterConfig, includeSyntheticReceipts: shouldIncludeSynthetic("kii"), cache: cache}
+	return &LogsPagedAPI{logFetcher: logFetcher, filterConfig: filterConfig, connectionType: connectionType}
+}
+
+// LogsCursor points at the first log that has not been returned yet. FromBlock
+// and ToBlock pin the range resolved by the first call, so that a range given
+// with block tags such as "latest" does not move while it is being walked.
+type LogsCursor struct {
+	BlockNumber hexutil.Uint64 `json:"blockNumber"`
+	TxIndex     hexutil.Uint   `json:"transactionIndex"`
+	LogIndex    hexutil.Uint   `json:"logIndex"`
+	FromBlock   hexutil.Uint64 `json:"fromBlock"`
+	ToBlock     hexutil.Uint64 `json:"toBlock"`
+}
+
+type LogsPage struct {
+	Logs []*ethtypes.Log `json:"logs"`
+	// Truncated is set if there are more logs in the range; they can be fetched
+	// by passing Cursor back with the same criteria.
+	Truncated bool        `json:"truncated"`
+	Cursor    *LogsCursor `json:"cursor"`
+}
+
+// GetLogsPaged returns at most limit logs matching the criteria, starting at the
+// cursor if one is given. Pages never span more than max_blocks_for_log blocks.
+func (a *LogsPagedAPI) GetLogsPaged(ctx context.Context, crit filters.FilterCriteria, cursor *LogsCursor, limit *hexutil.Uint64) (res *LogsPage, err error) {
+	defer recordMetrics("kii_getLogsPaged", a.connectionType, time.Now(), err == nil)
+	pageSize := a.filterConfig.logsPageSize()
+	if limit != nil {
+		if *limit == 0 {
+			return nil, errors.New("limit must be greater than 0")
+		}
+		pageSize = min(pageSize, int64(*limit))
+	}
+	return a.logFetcher.GetLogsPage(ctx, crit, cursor, pageSize)
+}
+
+// logsPageSize returns the max number of logs per page, max_log_no_block if it
+// is set.
+func (c *FilterConfig) logsPageSize() int64 {
+	if c.maxLog > 0 {
+		return c.maxLog
+	}
+	return DefaultLogsPageSize
+}
+
+// GetLogsPage walks the blocks matching the criteria in order and returns the
+// logs from the cursor on, up to limit logs or max_blocks_for_log blocks,
+// whichever comes first.
+func (f *LogFetcher) GetLogsPage(ctx context.Context, crit filters.FilterCriteria, cursor *LogsCursor, limit int64) (*LogsPage, error) {
+	var begin, end int64
+	if crit.BlockHash != nil {
+		block, err := blockByHashWithRetry(ctx, f.tmClient, crit.BlockHash[:], 1)
+		if err != nil {
+			return nil, err
+		}
+		begin, end = block.Block.Height, block.Block.Height
+	} else {
+		begin, end = f.blockRange(crit)
+	}
+	rangeBegin := begin
+	if cursor != nil {
+		if !cursor.inRange(crit, end) {
+			return nil, fmt.Errorf("cursor at block %d is outside of the queried range", cursor.BlockNumber)
+		}
+		rangeBegin, begin, end = int64(cursor.FromBlock), int64(cursor.BlockNumber), int64(cursor.ToBlock)
+	}
+	if begin > end {
+		return nil, fmt.Errorf("fromBlock %d is after toBlock %d", begin, end)
+	}
+	pageEnd := end
+	if f.filterConfig.maxBlock > 0 && end >= begin+f.filterConfig.maxBlock {
+		pageEnd = begin + f.filterConfig.maxBlock - 1
+	}
+
+	bloomIndexes := EncodeFilters(crit.Addresses, crit.Topics)
+	blockHeights := f.FindBlockesByBloom(begin, pageEnd, bloomIndexes)
+	page := &LogsPage{Logs: []*ethtypes.Log{}}
+	err := f.forEachBlockLogs(ctx, blockHeights, crit, bloomIndexes, func(height int64, logs []*ethtypes.Log) bool {
+		slices.SortStableFunc(logs, compareLogPosition)
+		for _, l := range logs {
+			if cursor != nil && height == int64(cursor.BlockNumber) && (l.TxIndex < uint(cursor.TxIndex) || (l.TxIndex == uint(cursor.TxIndex) && l.Index < uint(cursor.LogIndex))) {
+				// returned by a previous page
+				continue
+			}
+			if int64(len(page.Logs)) >= limit {
+				page.Truncated = true
+				page.Cursor = &LogsCursor{BlockNumber: hexutil.Uint64(height), TxIndex: hexutil.Uint(l.TxIndex), LogIndex: hexutil.Uint(l.Index), FromBlock: hexutil.Uint64(rangeBegin), ToBlock: hexutil.Uint64(end)}
+				return false
+			}
+			page.Logs = append(page.Logs, l)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !page.Truncated && pageEnd < end {
+		page.Truncated = true
+		page.Cursor = &LogsCursor{BlockNumber: hexutil.Uint64(pageEnd + 1), FromBlock: hexutil.Uint64(rangeBegin), ToBlock: hexutil.Uint64(end)}
+	}
+	return page, nil
+}
+
+// inRange returns whether the cursor lies within its own range and within the
+// bounds of the criteria given as block numbers. Bounds given as block tags
+// were resolved by the first call and are only checked through the range of
+// the cursor. end is the height of the block of a block hash query.
+func (c *LogsCursor) inRange(crit filters.FilterCriteria, end int64) bool {
+	if c.BlockNumber < c.FromBlock || c.BlockNumber > c.ToBlock {
+		return false
+	}
+	if crit.BlockHash != nil {
+		return int64(c.ToBlock) == end
+	}
+	if crit.FromBlock != nil && crit.FromBlock.Sign() >= 0 && int64(c.BlockNumber) < crit.FromBlock.Int64() {
+		return false
+	}
+	if crit.ToBlock != nil && crit.ToBlock.Sign() >= 0 && int64(c.ToBlock) > crit.ToBlock.Int64() {
+		return false
+	}
+	return true
+}
+
+func compareLogPosition(a, b *ethtypes.Log) int {
+	if c := cmp.Compare(a.TxIndex, b.TxIndex); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.Index, b.Index)
+}
@@ -389,3 +389,56 @@ func TestFilterGetFilterChangesKeepsFilterAlive(t *testing.T) {
 		time.Sleep(filterTimeoutDuration / 2)
 	}
 }
+
+func TestKiiGetLogsPaged(t *testing.T) {
+	filterCriteria := map[string]interface{}{
+		"fromBlock": "0x2",
+		"toBlock":   "0x8",
+	}
+	expected := sendKiiRequestGood(t, "getLogs", filterCriteria)["result"].([]interface{})
+	require.NotEmpty(t, expected)
+
+	// walk the range two logs at a time
+	got := []interface{}{}
+	var cursor interface{}
+	for i := 0; i <= len(expected); i++ {
+		page := sendKiiRequestGood(t, "getLogsPaged", filterCriteria, cursor, "0x2")["result"].(map[string]interface{})
+		logs := page["logs"].([]interface{})
+		require.LessOrEqual(t, len(logs), 2)
+		got = append(got, logs...)
+		if !page["truncated"].(bool) {
+			require.Nil(t, page["cursor"])
+			break
+		}
+		cursor = page["cursor"]
+		require.Equal(t, "0x2", cursor.(map[string]interface{})["fromBlock"])
+		require.Equal(t, "0x8", cursor.(map[string]interface{})["toBlock"])
+	}
+	require.Equal(t, expected, got)
+
+	// the range of the cursor is used when the criteria use block tags, which
+	// may resolve to other heights than on the first call
+	resObj := sendKiiRequestGood(t, "getLogsPaged", map[string]interface{}{}, map[string]interface{}{
+		"blockNumber": "0x2", "transactionIndex": "0x0", "logIndex": "0x0", "fromBlock": "0x2", "toBlock": "0x8",
+	})
+	require.Nil(t, resObj["error"])
+	require.Equal(t, expected[:min(4, len(expected))], resObj["result"].(map[string]interface{})["logs"])
+
+	// pages are capped at max_log_no_block logs by default
+	page := sendKiiRequestGood(t, "getLogsPaged", filterCriteria)["result"].(map[string]interface{})
+	require.Equal(t, len(expected) > 4, page["truncated"].(bool))
+	require.Equal(t, expected[:min(4, len(expected))], page["logs"])
+
+	// a cursor outside of the queried range or of its own range is rejected
+	resObj = sendKiiRequestGood(t, "getLogsPaged", filterCriteria, map[string]interface{}{
+		"blockNumber": "0x1", "transactionIndex": "0x0", "logIndex": "0x0", "toBlock": "0x8",
+	})
+	require.Contains(t, resObj["error"].(map[string]interface{})["message"], "outside of the queried range")
+	resObj = sendKiiRequestGood(t, "getLogsPaged", map[string]interface{}{}, map[string]interface{}{
+		"blockNumber": "0x2", "transactionIndex": "0x0", "logIndex": "0x0", "fromBlock": "0x3", "toBlock": "0x8",
+	})
+	require.Contains(t, resObj["error"].(map[string]interface{})["message"], "outside of the queried range")
+
+	resObj = sendKiiRequestGood(t, "getLogsPaged", filterCriteria, nil, "0x0")
+	require.Equal(t, "limit must be greater than 0", resObj["error"].(map[string]interface{})["message"])
+}
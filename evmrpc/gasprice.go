@@ -0,0 +1,116 @@
+package evmrpc
+
+import (
+	"context"
+	"math/big"
+	"slices"
+	"sync"
+
+	"github.com/tendermint/tendermint/rpc/coretypes"
+)
+
+// number of the lowest tips of a block sampled by the gas price oracle
+const gasPriceOracleSampleNumber = 3
+
+type GasPriceOracleConfig struct {
+	Blocks      int
+	Percentile  int
+	IgnorePrice *big.Int
+	MaxPrice    *big.Int
+}
+
+// gasPriceOracle suggests priority fees from the tips paid by the EVM txs of
+// recent blocks, like the gas price oracle of go-ethereum: it samples the
+// lowest tips of each block of the window and picks the configured percentile
+// of the samples. Suggestions are cached per height.
+type gasPriceOracle struct {
+	config *GasPriceOracleConfig
+
+	mtx      sync.Mutex
+	lastHead int64
+	lastTip  *big.Int
+}
+
+func newGasPriceOracle(config *GasPriceOracleConfig) *gasPriceOracle {
+	return &gasPriceOracle{config: config}
+}
+
+// suggestTip returns the suggested priority fee for the block after the
+// latest one. It is zero if no block of the window has EVM txs with a tip of
+// at least the ignore price.
+func (i *InfoAPI) suggestTip(ctx context.Context) (*big.Int, error) {
+	o := i.oracle
+	head := i.ctxProvider(LatestCtxHeight).BlockHeight()
+	o.mtx.Lock()
+	if o.lastTip != nil && o.lastHead == head {
+		defer o.mtx.Unlock()
+		return new(big.Int).Set(o.lastTip), nil
+	}
+	o.mtx.Unlock()
+
+	samples := []*big.Int{}
+	for height := head; height > head-int64(o.config.Blocks) && height > 0; height-- {
+		if CheckVersion(i.ctxProvider(height), i.keeper) != nil {
+			// either height is pruned or before EVM is introduced
+			break
+		}
+		baseFee := i.safeGetBaseFee(height)
+		if baseFee == nil {
+			// the block has been pruned
+			break
+		}
+		h := height
+		block, err := blockByNumber(ctx, i.tmClient, &h)
+		if err != nil {
+			// block pruned from tendermint store
+			break
+		}
+		tips, err := i.getLowestTips(block, baseFee)
+		if err != nil {
+			return nil, err
+		}
+		samples = append(samples, tips...)
+	}
+	tip := big.NewInt(0)
+	if len(samples) > 0 {
+		slices.SortFunc(samples, func(a, b *big.Int) int { return a.Cmp(b) })
+		tip = samples[(len(samples)-1)*o.config.Percentile/100]
+	}
+	// a max price of 0 means no cap
+	if o.config.MaxPrice != nil && o.config.MaxPrice.Sign() > 0 && tip.Cmp(o.config.MaxPrice) > 0 {
+		tip = new(big.Int).Set(o.config.MaxPrice)
+	}
+
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+	o.lastHead, o.lastTip = head, tip
+	return new(big.Int).Set(tip), nil
+}
+
+// getLowestTips returns the lowest tips paid by the EVM txs of a block, in
+// ascending order, ignoring the tips below the ignore price.
+func (i *InfoAPI) getLowestTips(block *coretypes.ResultBlock, baseFee *big.Int) ([]*big.Int, error) {
+	tips := []*big.Int{}
+	for _, txbz := range block.Block.Txs {
+		ethtx := getEthTxForTxBz(txbz, i.txDecoder)
+		if ethtx == nil {
+			// not evm tx
+			continue
+		}
+		// okay to get from latest since receipt is immutable
+		receipt, err := i.keeper.GetReceipt(i.ctxProvider(LatestCtxHeight), ethtx.Hash())
+		if err != nil {
+			return nil, err
+		}
+		tip := new(big.Int).Sub(new(big.Int).SetUint64(receipt.EffectiveGasPrice), baseFee)
+		if i.oracle.config.IgnorePrice != nil && tip.Cmp(i.oracle.config.IgnorePrice) < 0 {
+			continue
+		}
+		tips = append(tips, tip)
+	}
+	slices.SortFunc(tips, func(a, b *big.Int) int { return a.Cmp(b) })
+	if len(tips) > gasPriceOracleSampleNumber {
+		tips = tips[:gasPriceOracleSampleNumber]
+	}
+	return tips, nil
+}
@@ -0,0 +1,880 @@
+package evmrpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/lib/ethapi"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/graph-gophers/graphql-go"
+	"github.com/kiichain/kiichain3/precompiles/wasmd"
+	"github.com/kiichain/kiichain3/x/evm/keeper"
+	"github.com/tendermint/tendermint/libs/log"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+)
+
+// graphqlSchema is the subset of the EIP-1767 schema served by the node. Like
+// in geth, Long values are returned as hex strings but accepted as numbers,
+// decimal strings or hex strings.
+const graphqlSchema = `
+scalar Bytes32
+scalar Address
+scalar Bytes
+scalar BigInt
+scalar Long
+
+schema {
+	query: Query
+}
+
+type Account {
+	address: Address!
+	balance: BigInt!
+	transactionCount: Long!
+	code: Bytes!
+	storage(slot: Bytes32!): Bytes32!
+}
+
+type Log {
+	index: Long!
+	account(block: Long): Account!
+	topics: [Bytes32!]!
+	data: Bytes!
+	transaction: Transaction
+}
+
+type Transaction {
+	hash: Bytes32!
+	nonce: Long!
+	index: Long
+	from(block: Long): Account!
+	to(block: Long): Account
+	value: BigInt!
+	gasPrice: BigInt!
+	maxFeePerGas: BigInt
+	maxPriorityFeePerGas: BigInt
+	effectiveGasPrice: BigInt
+	gas: Long!
+	inputData: Bytes!
+	block: Block
+	status: Long
+	gasUsed: Long
+	cumulativeGasUsed: Long
+	createdContract(block: Long): Account
+	logs: [Log!]
+	r: BigInt!
+	s: BigInt!
+	v: BigInt!
+	type: Long
+}
+
+input BlockFilterCriteria {
+	addresses: [Address!]
+	topics: [[Bytes32!]!]
+}
+
+type Block {
+	number: Long!
+	hash: Bytes32!
+	parent: Block
+	nonce: Bytes!
+	transactionsRoot: Bytes32!
+	transactionCount: Long
+	stateRoot: Bytes32!
+	receiptsRoot: Bytes32!
+	miner(block: Long): Account!
+	extraData: Bytes!
+	gasLimit: Long!
+	gasUsed: Long!
+	baseFeePerGas: BigInt
+	timestamp: Long!
+	logsBloom: Bytes!
+	mixHash: Bytes32!
+	difficulty: BigInt!
+	transactions: [Transaction!]
+	transactionAt(index: Long!): Transaction
+	logs(filter: BlockFilterCriteria!): [Log!]!
+	account(address: Address!): Account!
+	call(data: CallData!): CallResult
+	estimateGas(data: CallData!): Long!
+}
+
+input CallData {
+	from: Address
+	to: Address
+	gas: Long
+	gasPrice: BigInt
+	maxFeePerGas: BigInt
+	maxPriorityFeePerGas: BigInt
+	value: BigInt
+	data: Bytes
+}
+
+type CallResult {
+	data: Bytes!
+	gasUsed: Long!
+	status: Long!
+}
+
+input FilterCriteria {
+	fromBlock: Long
+	toBlock: Long
+	addresses: [Address!]
+	topics: [[Bytes32!]!]
+}
+
+type Query {
+	block(number: Long, hash: Bytes32): Block
+	blocks(from: Long!, to: Long): [Block!]!
+	transaction(hash: Bytes32!): Transaction
+	logs(filter: FilterCriteria!): [Log!]!
+	gasPrice: BigInt!
+	maxPriorityFeePerGas: BigInt!
+	chainID: BigInt!
+}
+`
+
+const (
+	// graphqlMethod is the method GraphQL queries are rate limited, restricted
+	// and denied as, so that they share the budgets of the JSON-RPC server
+	graphqlMethod = "graphql_query"
+
+	// queries can't nest deeper than this
+	graphqlMaxDepth = 10
+
+	// number of blocks, transactions, receipts, log queries, account reads and
+	// calls a query may load
+	graphqlMaxComplexity = 1000
+)
+
+var errGraphQLTooComplex = fmt.Errorf("query exceeds the complexity limit of %d lookups", graphqlMaxComplexity)
+
+type graphqlBudgetKey struct{}
+
+// spendGraphQLBudget accounts for a lookup of the query being resolved with
+// ctx, failing once the query made more than graphqlMaxComplexity of them.
+func spendGraphQLBudget(ctx context.Context) error {
+	budget, ok := ctx.Value(graphqlBudgetKey{}).(*atomic.Int64)
+	if ok && budget.Add(-1) < 0 {
+		return errGraphQLTooComplex
+	}
+	return nil
+}
+
+// graphqlLong is the input type of Long arguments.
+type graphqlLong int64
+
+func (graphqlLong) ImplementsGraphQLType(name string) bool { return name == "Long" }
+
+func (l *graphqlLong) UnmarshalGraphQL(input interface{}) error {
+	switch input := input.(type) {
+	case string:
+		if strings.HasPrefix(input, "0x") {
+			value, err := hexutil.DecodeUint64(input)
+			*l = graphqlLong(value)
+			return err
+		}
+		value, err := strconv.ParseInt(input, 10, 64)
+		*l = graphqlLong(value)
+		return err
+	case int32:
+		*l = graphqlLong(input)
+	case int64:
+		*l = graphqlLong(input)
+	case float64:
+		*l = graphqlLong(input)
+	default:
+		return fmt.Errorf("unexpected type %T for Long", input)
+	}
+	return nil
+}
+
+// NewGraphQLHandler returns a handler answering GraphQL queries (EIP-1767)
+// with the logic of the JSON-RPC services, so that a block can be fetched with
+// its transactions, receipts and logs in one round trip. Each query is a call
+// to graphql_query for the deny list, the access policy and the limiter.
+func NewGraphQLHandler(
+	logger log.Logger,
+	config Config,
+	tmClient rpcclient.Client,
+	k *keeper.Keeper,
+	ctxProvider func(int64) sdk.Context,
+	txConfig client.TxConfig,
+	homeDir string,
+	archive *ArchiveFallback,
+	limiter *RPCLimiter,
+	access *AccessPolicy,
+) (http.Handler, error) {
+	ctxProvider = NewPendingCtxProvider(logger, config, tmClient, k, ctxProvider, txConfig.TxDecoder())
+	filterConfig := newFilterConfig(config)
+	cache := NewResponseCache(config.ResponseCacheSize)
+	r := &graphqlResolver{
+		ctxProvider:  ctxProvider,
+		filterConfig: filterConfig,
+		blockAPI:     NewBlockAPI(tmClient, k, ctxProvider, txConfig, cache, ConnectionTypeGraphQL, "eth"),
+		txAPI:        NewTransactionAPI(tmClient, k, ctxProvider, txConfig, homeDir, cache, archive, ConnectionTypeGraphQL),
+		stateAPI:     NewStateAPI(tmClient, k, ctxProvider, archive, ConnectionTypeGraphQL),
+		infoAPI:      NewInfoAPI(tmClient, k, ctxProvider, txConfig.TxDecoder(), homeDir, config.MaxBlocksForLog, newGasPriceOracleConfig(config), ConnectionTypeGraphQL),
+		simAPI:       NewSimulationAPI(ctxProvider, k, txConfig.TxDecoder(), tmClient, newSimulateConfig(config), archive, ConnectionTypeGraphQL),
+		logFetcher:   &LogFetcher{tmClient: tmClient, k: k, ctxProvider: ctxProvider, filterConfig: filterConfig, includeSyntheticReceipts: shouldIncludeSynthetic("eth"), cache: cache},
+	}
+	schema, err := graphql.ParseSchema(graphqlSchema, r, graphql.MaxDepth(graphqlMaxDepth))
+	if err != nil {
+		return nil, err
+	}
+	return &graphqlHandler{schema: schema, filters: []wsRequestFilter{denyListFilter(config.DenyList)}, access: access, limiter: limiter}, nil
+}
+
+type graphqlHandler struct {
+	schema  *graphql.Schema
+	filters []wsRequestFilter
+	access  *AccessPolicy // nil if nothing is restricted
+	limiter *RPCLimiter   // nil if queries are not limited
+}
+
+func (h *graphqlHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	for _, filter := range h.filters {
+		if err := filter(r, graphqlMethod); err != nil {
+			writeGraphQLError(w, http.StatusForbidden, err)
+			return
+		}
+	}
+	if h.access != nil {
+		if err := h.access.check(r, graphqlMethod); err != nil {
+			writeGraphQLError(w, graphqlErrorStatus(err), err)
+			return
+		}
+	}
+	if h.limiter != nil {
+		release, err := h.limiter.checkRequests(r, []rpcRequestHeader{{Method: graphqlMethod}}, false)
+		if err != nil {
+			writeGraphQLError(w, http.StatusTooManyRequests, err)
+			return
+		}
+		if release != nil {
+			defer release()
+		}
+	}
+	var params struct {
+		Query         string                 `json:"query"`
+		OperationName string                 `json:"operationName"`
+		Variables     map[string]interface{} `json:"variables"`
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, maxRequestContentLength)
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		status := http.StatusBadRequest
+		if maxBytesErr := new(http.MaxBytesError); errors.As(err, &maxBytesErr) {
+			status = http.StatusRequestEntityTooLarge
+		}
+		http.Error(w, err.Error(), status)
+		return
+	}
+	budget := &atomic.Int64{}
+	budget.Store(graphqlMaxComplexity)
+	ctx := context.WithValue(r.Context(), graphqlBudgetKey{}, budget)
+	response := h.schema.Exec(ctx, params.Query, params.OperationName, params.Variables)
+	responseJSON, err := json.Marshal(response)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if h.limiter != nil {
+		if err := h.limiter.checkResponseSize(responseJSON); err != nil {
+			writeGraphQLError(w, http.StatusTooManyRequests, err)
+			return
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if len(response.Errors) > 0 {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	_, _ = w.Write(responseJSON)
+}
+
+// graphqlErrorStatus returns the HTTP status of a query rejected by the
+// access policy.
+func graphqlErrorStatus(err error) int {
+	switch err.(type) {
+	case *unauthorizedError:
+		return http.StatusUnauthorized
+	case *limitExceededError:
+		return http.StatusTooManyRequests
+	default:
+		return http.StatusForbidden
+	}
+}
+
+func writeGraphQLError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"errors": []map[string]string{{"message": err.Error()}},
+	})
+}
+
+// graphqlResolver resolves the Query type.
+type graphqlResolver struct {
+	ctxProvider  func(int64) sdk.Context
+	filterConfig *FilterConfig
+	blockAPI     *BlockAPI
+	txAPI        *TransactionAPI
+	stateAPI     *StateAPI
+	infoAPI      *InfoAPI
+	simAPI       *SimulationAPI
+	logFetcher   *LogFetcher
+}
+
+func (r *graphqlResolver) Block(ctx context.Context, args struct {
+	Number *graphqlLong
+	Hash   *common.Hash
+}) (*graphqlBlock, error) {
+	if args.Hash != nil {
+		return r.blockByHash(ctx, *args.Hash)
+	}
+	number := rpc.LatestBlockNumber
+	if args.Number != nil {
+		number = rpc.BlockNumber(*args.Number)
+	}
+	return r.blockByNumber(ctx, number)
+}
+
+// Blocks returns the blocks from one height to another, the latest by default.
+// Ranges are capped by max_blocks_for_log.
+func (r *graphqlResolver) Blocks(ctx context.Context, args struct {
+	From graphqlLong
+	To   *graphqlLong
+}) ([]*graphqlBlock, error) {
+	from := int64(args.From)
+	to := r.ctxProvider(LatestCtxHeight).BlockHeight()
+	if args.To != nil {
+		to = int64(*args.To)
+	}
+	if from > to {
+		return nil, fmt.Errorf("from block %d is after to block %d", from, to)
+	}
+	if r.filterConfig.maxBlock > 0 && to-from >= r.filterConfig.maxBlock {
+		return nil, fmt.Errorf("a query can't span more than %d blocks", r.filterConfig.maxBlock)
+	}
+	blocks := []*graphqlBlock{}
+	for height := from; height <= to; height++ {
+		block, err := r.blockByNumber(ctx, rpc.BlockNumber(height))
+		if err != nil {
+			return nil, err
+		}
+		if block != nil {
+			blocks = append(blocks, block)
+		}
+	}
+	return blocks, nil
+}
+
+func (r *graphqlResolver) Transaction(ctx context.Context, args struct{ Hash common.Hash }) (*graphqlTransaction, error) {
+	if err := spendGraphQLBudget(ctx); err != nil {
+		return nil, err
+	}
+	tx, err := r.txAPI.GetTransactionByHash(ctx, args.Hash)
+	if err != nil || tx == nil {
+		return nil, err
+	}
+	return &graphqlTransaction{r: r, tx: tx}, nil
+}
+
+func (r *graphqlResolver) Logs(ctx context.Context, args struct{ Filter graphqlFilterCriteria }) ([]*graphqlLog, error) {
+	crit := filters.FilterCriteria{}
+	if args.Filter.FromBlock != nil {
+		crit.FromBlock = big.NewInt(int64(*args.Filter.FromBlock))
+	}
+	if args.Filter.ToBlock != nil {
+		crit.ToBlock = big.NewInt(int64(*args.Filter.ToBlock))
+	}
+	args.Filter.apply(&crit)
+	return r.logs(ctx, crit, nil)
+}
+
+func (r *graphqlResolver) GasPrice(ctx context.Context) (hexutil.Big, error) {
+	if err := spendGraphQLBudget(ctx); err != nil {
+		return hexutil.Big{}, err
+	}
+	price, err := r.infoAPI.GasPrice(ctx)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return *price, nil
+}
+
+func (r *graphqlResolver) MaxPriorityFeePerGas(ctx context.Context) (hexutil.Big, error) {
+	if err := spendGraphQLBudget(ctx); err != nil {
+		return hexutil.Big{}, err
+	}
+	tip, err := r.infoAPI.MaxPriorityFeePerGas(ctx)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return *tip, nil
+}
+
+func (r *graphqlResolver) ChainID() hexutil.Big {
+	return *r.infoAPI.ChainId()
+}
+
+func (r *graphqlResolver) blockByNumber(ctx context.Context, number rpc.BlockNumber) (*graphqlBlock, error) {
+	if err := spendGraphQLBudget(ctx); err != nil {
+		return nil, err
+	}
+	data, err := r.blockAPI.GetBlockByNumber(ctx, number, true)
+	if err != nil || data == nil {
+		return nil, err
+	}
+	return r.newBlock(data)
+}
+
+func (r *graphqlResolver) blockByHash(ctx context.Context, hash common.Hash) (*graphqlBlock, error) {
+	if err := spendGraphQLBudget(ctx); err != nil {
+		return nil, err
+	}
+	data, err := r.blockAPI.GetBlockByHash(ctx, hash, true)
+	if err != nil || data == nil {
+		return nil, err
+	}
+	return r.newBlock(data)
+}
+
+func (r *graphqlResolver) logs(ctx context.Context, crit filters.FilterCriteria, tx *graphqlTransaction) ([]*graphqlLog, error) {
+	if err := spendGraphQLBudget(ctx); err != nil {
+		return nil, err
+	}
+	logs, _, err := r.logFetcher.GetLogsByFilters(ctx, crit, 0)
+	if err != nil {
+		return nil, err
+	}
+	return r.newLogs(logs, tx), nil
+}
+
+func (r *graphqlResolver) newLogs(logs []*ethtypes.Log, tx *graphqlTransaction) []*graphqlLog {
+	res := make([]*graphqlLog, 0, len(logs))
+	for _, l := range logs {
+		res = append(res, &graphqlLog{r: r, log: l, tx: tx})
+	}
+	return res
+}
+
+func (r *graphqlResolver) account(address common.Address, height int64, block *graphqlLong) *graphqlAccount {
+	if block != nil {
+		height = int64(*block)
+	}
+	return &graphqlAccount{r: r, address: address, blockNrOrHash: rpc.BlockNumberOrHashWithNumber(rpc.BlockNumber(height))}
+}
+
+type graphqlFilterCriteria struct {
+	FromBlock *graphqlLong
+	ToBlock   *graphqlLong
+	Addresses *[]common.Address
+	Topics    *[][]common.Hash
+}
+
+type graphqlBlockFilterCriteria struct {
+	Addresses *[]common.Address
+	Topics    *[][]common.Hash
+}
+
+func (c graphqlFilterCriteria) apply(crit *filters.FilterCriteria) {
+	graphqlBlockFilterCriteria{Addresses: c.Addresses, Topics: c.Topics}.apply(crit)
+}
+
+func (c graphqlBlockFilterCriteria) apply(crit *filters.FilterCriteria) {
+	if c.Addresses != nil {
+		crit.Addresses = *c.Addresses
+	}
+	if c.Topics != nil {
+		crit.Topics = *c.Topics
+	}
+}
+
+// graphqlBlockData is a block as encoded by the eth_getBlockBy* methods with
+// full transactions.
+type graphqlBlockData struct {
+	Number           hexutil.Big              `json:"number"`
+	Hash             common.Hash              `json:"hash"`
+	ParentHash       common.Hash              `json:"parentHash"`
+	Nonce            hexutil.Bytes            `json:"nonce"`
+	MixHash          common.Hash              `json:"mixHash"`
+	LogsBloom        hexutil.Bytes            `json:"logsBloom"`
+	StateRoot        common.Hash              `json:"stateRoot"`
+	TransactionsRoot common.Hash              `json:"transactionsRoot"`
+	ReceiptsRoot     common.Hash              `json:"receiptsRoot"`
+	Miner            common.Address           `json:"miner"`
+	Difficulty       hexutil.Big              `json:"difficulty"`
+	ExtraData        hexutil.Bytes            `json:"extraData"`
+	GasLimit         hexutil.Uint64           `json:"gasLimit"`
+	GasUsed          hexutil.Uint64           `json:"gasUsed"`
+	Timestamp        hexutil.Uint64           `json:"timestamp"`
+	BaseFeePerGas    *hexutil.Big             `json:"baseFeePerGas"`
+	Transactions     []*ethapi.RPCTransaction `json:"transactions"`
+}
+
+type graphqlBlock struct {
+	r    *graphqlResolver
+	data graphqlBlockData
+}
+
+func (r *graphqlResolver) newBlock(data map[string]interface{}) (*graphqlBlock, error) {
+	bz, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	b := &graphqlBlock{r: r}
+	if err := json.Unmarshal(bz, &b.data); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func (b *graphqlBlock) height() int64 { return b.data.Number.ToInt().Int64() }
+
+func (b *graphqlBlock) Number() hexutil.Uint64 { return hexutil.Uint64(b.height()) }
+
+func (b *graphqlBlock) Hash() common.Hash { return b.data.Hash }
+
+func (b *graphqlBlock) Parent(ctx context.Context) (*graphqlBlock, error) {
+	if b.height() == 0 {
+		return nil, nil
+	}
+	return b.r.blockByNumber(ctx, rpc.BlockNumber(b.height()-1))
+}
+
+func (b *graphqlBlock) Nonce() hexutil.Bytes { return b.data.Nonce }
+
+func (b *graphqlBlock) TransactionsRoot() common.Hash { return b.data.TransactionsRoot }
+
+func (b *graphqlBlock) TransactionCount() *hexutil.Uint64 {
+	count := hexutil.Uint64(len(b.data.Transactions))
+	return &count
+}
+
+func (b *graphqlBlock) StateRoot() common.Hash { return b.data.StateRoot }
+
+func (b *graphqlBlock) ReceiptsRoot() common.Hash { return b.data.ReceiptsRoot }
+
+func (b *graphqlBlock) Miner(args struct{ Block *graphqlLong }) *graphqlAccount {
+	return b.r.account(b.data.Miner, b.height(), args.Block)
+}
+
+func (b *graphqlBlock) ExtraData() hexutil.Bytes { return b.data.ExtraData }
+
+func (b *graphqlBlock) GasLimit() hexutil.Uint64 { return b.data.GasLimit }
+
+func (b *graphqlBlock) GasUsed() hexutil.Uint64 { return b.data.GasUsed }
+
+func (b *graphqlBlock) BaseFeePerGas() *hexutil.Big { return b.data.BaseFeePerGas }
+
+func (b *graphqlBlock) Timestamp() hexutil.Uint64 { return b.data.Timestamp }
+
+func (b *graphqlBlock) LogsBloom() hexutil.Bytes { return b.data.LogsBloom }
+
+func (b *graphqlBlock) MixHash() common.Hash { return b.data.MixHash }
+
+func (b *graphqlBlock) Difficulty() hexutil.Big { return b.data.Difficulty }
+
+func (b *graphqlBlock) Transactions() *[]*graphqlTransaction {
+	txs := make([]*graphqlTransaction, 0, len(b.data.Transactions))
+	for _, tx := range b.data.Transactions {
+		txs = append(txs, &graphqlTransaction{r: b.r, tx: tx, block: b})
+	}
+	return &txs
+}
+
+func (b *graphqlBlock) TransactionAt(args struct{ Index graphqlLong }) *graphqlTransaction {
+	if args.Index < 0 || int(args.Index) >= len(b.data.Transactions) {
+		return nil
+	}
+	return &graphqlTransaction{r: b.r, tx: b.data.Transactions[args.Index], block: b}
+}
+
+func (b *graphqlBlock) Logs(ctx context.Context, args struct{ Filter graphqlBlockFilterCriteria }) ([]*graphqlLog, error) {
+	hash := b.data.Hash
+	crit := filters.FilterCriteria{BlockHash: &hash}
+	args.Filter.apply(&crit)
+	return b.r.logs(ctx, crit, nil)
+}
+
+func (b *graphqlBlock) Account(args struct{ Address common.Address }) *graphqlAccount {
+	return b.r.account(args.Address, b.height(), nil)
+}
+
+func (b *graphqlBlock) Call(ctx context.Context, args struct{ Data ethapi.TransactionArgs }) (*graphqlCallResult, error) {
+	if err := spendGraphQLBudget(ctx); err != nil {
+		return nil, err
+	}
+	ctx = context.WithValue(ctx, CtxIsWasmdPrecompileCallKey, wasmd.IsWasmdCall(args.Data.To))
+	backend := b.r.simAPI.backend
+	result, err := ethapi.DoCall(ctx, backend, args.Data, rpc.BlockNumberOrHashWithNumber(rpc.BlockNumber(b.height())), nil, nil, backend.RPCEVMTimeout(), backend.RPCGasCap())
+	if err != nil {
+		return nil, err
+	}
+	status := hexutil.Uint64(ethtypes.ReceiptStatusSuccessful)
+	if result.Failed() {
+		status = hexutil.Uint64(ethtypes.ReceiptStatusFailed)
+	}
+	return &graphqlCallResult{data: result.ReturnData, gasUsed: hexutil.Uint64(result.UsedGas), status: status}, nil
+}
+
+func (b *graphqlBlock) EstimateGas(ctx context.Context, args struct{ Data ethapi.TransactionArgs }) (hexutil.Uint64, error) {
+	if err := spendGraphQLBudget(ctx); err != nil {
+		return 0, err
+	}
+	blockNrOrHash := rpc.BlockNumberOrHashWithNumber(rpc.BlockNumber(b.height()))
+	return b.r.simAPI.EstimateGas(ctx, args.Data, &blockNrOrHash, nil)
+}
+
+type graphqlCallResult struct {
+	data    hexutil.Bytes
+	gasUsed hexutil.Uint64
+	status  hexutil.Uint64
+}
+
+func (c *graphqlCallResult) Data() hexutil.Bytes { return c.data }
+
+func (c *graphqlCallResult) GasUsed() hexutil.Uint64 { return c.gasUsed }
+
+func (c *graphqlCallResult) Status() hexutil.Uint64 { return c.status }
+
+// graphqlReceiptData is the part of a receipt as encoded by
+// eth_getTransactionReceipt that isn't in the transaction.
+type graphqlReceiptData struct {
+	Status            hexutil.Uint64  `json:"status"`
+	GasUsed           hexutil.Uint64  `json:"gasUsed"`
+	CumulativeGasUsed hexutil.Uint64  `json:"cumulativeGasUsed"`
+	EffectiveGasPrice *hexutil.Big    `json:"effectiveGasPrice"`
+	ContractAddress   *common.Address `json:"contractAddress"`
+	Logs              []*ethtypes.Log `json:"logs"`
+}
+
+type graphqlTransaction struct {
+	r  *graphqlResolver
+	tx *ethapi.RPCTransaction
+
+	mu      sync.Mutex
+	block   *graphqlBlock       // nil until loaded
+	receipt *graphqlReceiptData // nil until loaded
+}
+
+// getReceipt loads the receipt of the transaction, or returns nil if it is
+// still pending.
+func (t *graphqlTransaction) getReceipt(ctx context.Context) (*graphqlReceiptData, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.receipt != nil || t.tx.BlockHash == nil {
+		return t.receipt, nil
+	}
+	if err := spendGraphQLBudget(ctx); err != nil {
+		return nil, err
+	}
+	data, err := t.r.txAPI.GetTransactionReceipt(ctx, t.tx.Hash)
+	if err != nil || data == nil {
+		return nil, err
+	}
+	bz, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	receipt := &graphqlReceiptData{}
+	if err := json.Unmarshal(bz, receipt); err != nil {
+		return nil, err
+	}
+	t.receipt = receipt
+	return receipt, nil
+}
+
+// height returns the height of the block of the transaction, or the latest
+// height if it is still pending.
+func (t *graphqlTransaction) height() int64 {
+	if t.tx.BlockNumber == nil {
+		return t.r.ctxProvider(LatestCtxHeight).BlockHeight()
+	}
+	return t.tx.BlockNumber.ToInt().Int64()
+}
+
+func (t *graphqlTransaction) Hash() common.Hash { return t.tx.Hash }
+
+func (t *graphqlTransaction) Nonce() hexutil.Uint64 { return t.tx.Nonce }
+
+func (t *graphqlTransaction) Index() *hexutil.Uint64 { return t.tx.TransactionIndex }
+
+func (t *graphqlTransaction) From(args struct{ Block *graphqlLong }) *graphqlAccount {
+	return t.r.account(t.tx.From, t.height(), args.Block)
+}
+
+func (t *graphqlTransaction) To(args struct{ Block *graphqlLong }) *graphqlAccount {
+	if t.tx.To == nil {
+		return nil
+	}
+	return t.r.account(*t.tx.To, t.height(), args.Block)
+}
+
+func (t *graphqlTransaction) Value() hexutil.Big { return *bigOrZero(t.tx.Value) }
+
+func (t *graphqlTransaction) GasPrice() hexutil.Big { return *bigOrZero(t.tx.GasPrice) }
+
+func (t *graphqlTransaction) MaxFeePerGas() *hexutil.Big { return t.tx.GasFeeCap }
+
+func (t *graphqlTransaction) MaxPriorityFeePerGas() *hexutil.Big { return t.tx.GasTipCap }
+
+func (t *graphqlTransaction) EffectiveGasPrice(ctx context.Context) (*hexutil.Big, error) {
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	return receipt.EffectiveGasPrice, nil
+}
+
+func (t *graphqlTransaction) Gas() hexutil.Uint64 { return t.tx.Gas }
+
+func (t *graphqlTransaction) InputData() hexutil.Bytes { return t.tx.Input }
+
+func (t *graphqlTransaction) Block(ctx context.Context) (*graphqlBlock, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.block != nil || t.tx.BlockNumber == nil {
+		return t.block, nil
+	}
+	block, err := t.r.blockByNumber(ctx, rpc.BlockNumber(t.height()))
+	if err != nil {
+		return nil, err
+	}
+	t.block = block
+	return block, nil
+}
+
+func (t *graphqlTransaction) Status(ctx context.Context) (*hexutil.Uint64, error) {
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	return &receipt.Status, nil
+}
+
+func (t *graphqlTransaction) GasUsed(ctx context.Context) (*hexutil.Uint64, error) {
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	return &receipt.GasUsed, nil
+}
+
+func (t *graphqlTransaction) CumulativeGasUsed(ctx context.Context) (*hexutil.Uint64, error) {
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	return &receipt.CumulativeGasUsed, nil
+}
+
+func (t *graphqlTransaction) CreatedContract(ctx context.Context, args struct{ Block *graphqlLong }) (*graphqlAccount, error) {
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil || receipt.ContractAddress == nil {
+		return nil, err
+	}
+	return t.r.account(*receipt.ContractAddress, t.height(), args.Block), nil
+}
+
+func (t *graphqlTransaction) Logs(ctx context.Context) (*[]*graphqlLog, error) {
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	logs := t.r.newLogs(receipt.Logs, t)
+	return &logs, nil
+}
+
+func (t *graphqlTransaction) R() hexutil.Big { return *bigOrZero(t.tx.R) }
+
+func (t *graphqlTransaction) S() hexutil.Big { return *bigOrZero(t.tx.S) }
+
+func (t *graphqlTransaction) V() hexutil.Big { return *bigOrZero(t.tx.V) }
+
+func (t *graphqlTransaction) Type() *hexutil.Uint64 { return &t.tx.Type }
+
+type graphqlLog struct {
+	r   *graphqlResolver
+	log *ethtypes.Log
+	tx  *graphqlTransaction // nil until loaded
+}
+
+func (l *graphqlLog) Index() hexutil.Uint64 { return hexutil.Uint64(l.log.Index) }
+
+func (l *graphqlLog) Account(args struct{ Block *graphqlLong }) *graphqlAccount {
+	return l.r.account(l.log.Address, int64(l.log.BlockNumber), args.Block)
+}
+
+func (l *graphqlLog) Topics() []common.Hash { return l.log.Topics }
+
+func (l *graphqlLog) Data() hexutil.Bytes { return l.log.Data }
+
+func (l *graphqlLog) Transaction(ctx context.Context) (*graphqlTransaction, error) {
+	if l.tx != nil {
+		return l.tx, nil
+	}
+	return l.r.Transaction(ctx, struct{ Hash common.Hash }{Hash: l.log.TxHash})
+}
+
+type graphqlAccount struct {
+	r             *graphqlResolver
+	address       common.Address
+	blockNrOrHash rpc.BlockNumberOrHash
+}
+
+func (a *graphqlAccount) Address() common.Address { return a.address }
+
+func (a *graphqlAccount) Balance(ctx context.Context) (hexutil.Big, error) {
+	if err := spendGraphQLBudget(ctx); err != nil {
+		return hexutil.Big{}, err
+	}
+	balance, err := a.r.stateAPI.GetBalance(ctx, a.address, a.blockNrOrHash)
+	if err != nil {
+		return hexutil.Big{}, err
+	}
+	return *balance, nil
+}
+
+func (a *graphqlAccount) TransactionCount(ctx context.Context) (hexutil.Uint64, error) {
+	if err := spendGraphQLBudget(ctx); err != nil {
+		return 0, err
+	}
+	count, err := a.r.txAPI.GetTransactionCount(ctx, a.address, a.blockNrOrHash)
+	if err != nil {
+		return 0, err
+	}
+	return *count, nil
+}
+
+func (a *graphqlAccount) Code(ctx context.Context) (hexutil.Bytes, error) {
+	if err := spendGraphQLBudget(ctx); err != nil {
+		return nil, err
+	}
+	return a.r.stateAPI.GetCode(ctx, a.address, a.blockNrOrHash)
+}
+
+func (a *graphqlAccount) Storage(ctx context.Context, args struct{ Slot common.Hash }) (common.Hash, error) {
+	if err := spendGraphQLBudget(ctx); err != nil {
+		return common.Hash{}, err
+	}
+	value, err := a.r.stateAPI.GetStorageAt(ctx, a.address, args.Slot.Hex(), a.blockNrOrHash)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(value), nil
+}
@@ -0,0 +1,166 @@
+package evmrpc_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kiichain/kiichain3/evmrpc"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func sendGraphQLQuery(t *testing.T, query string) (int, map[string]interface{}) {
+	body, err := json.Marshal(map[string]interface{}{"query": query})
+	require.Nil(t, err)
+	res, err := http.Post(fmt.Sprintf("http://%s:%d/graphql", TestAddr, TestPort), "application/json", strings.NewReader(string(body)))
+	require.Nil(t, err)
+	defer res.Body.Close()
+	resObj := map[string]interface{}{}
+	require.Nil(t, json.NewDecoder(res.Body).Decode(&resObj))
+	return res.StatusCode, resObj
+}
+
+func TestGraphQLBlock(t *testing.T) {
+	status, resObj := sendGraphQLQuery(t, `{
+		block(number: 8) {
+			number
+			hash
+			parent { hash }
+			miner { address }
+			gasLimit
+			baseFeePerGas
+			transactionCount
+			transactions {
+				hash
+				from { address }
+				to { address }
+				value
+				maxFeePerGas
+				status
+				gasUsed
+				effectiveGasPrice
+				logs { index topics }
+			}
+			logs(filter: {}) {
+				topics
+				transaction { hash }
+			}
+		}
+	}`)
+	require.Equal(t, http.StatusOK, status, resObj)
+	block := resObj["data"].(map[string]interface{})["block"].(map[string]interface{})
+	require.Equal(t, "0x8", block["number"])
+	require.Equal(t, "0x0000000000000000000000000000000000000000000000000000000000000001", block["hash"])
+	require.Equal(t, "0x0000000000000000000000000000000000000005", block["miner"].(map[string]interface{})["address"])
+	require.Equal(t, "0xbebc200", block["gasLimit"])
+	require.Equal(t, "0x3b9aca00", block["baseFeePerGas"])
+	require.Equal(t, "0x1", block["transactionCount"])
+
+	tx := block["transactions"].([]interface{})[0].(map[string]interface{})
+	require.Equal(t, "0xc1f0d26c419dea496540ab96a3331a9a79f084d7bc9662178dcd7c0bc407dc33", tx["hash"])
+	require.Equal(t, "0x5b4eba929f3811980f5ae0c5d04fa200f837df4e", tx["from"].(map[string]interface{})["address"])
+	require.Equal(t, "0x0000000000000000000000000000000000010203", tx["to"].(map[string]interface{})["address"])
+	require.Equal(t, "0x3e8", tx["value"])
+	require.Equal(t, "0xa", tx["maxFeePerGas"])
+	// receipt fields
+	require.Equal(t, "0x0", tx["status"])
+	require.Equal(t, "0x37", tx["gasUsed"])
+	require.Equal(t, "0x174876e800", tx["effectiveGasPrice"])
+	txLogs := tx["logs"].([]interface{})
+	require.Equal(t, 1, len(txLogs))
+	require.Equal(t, []interface{}{
+		"0x1111111111111111111111111111111111111111111111111111111111111111",
+		"0x1111111111111111111111111111111111111111111111111111111111111112",
+	}, txLogs[0].(map[string]interface{})["topics"])
+
+	logs := block["logs"].([]interface{})
+	require.NotEmpty(t, logs)
+	for _, l := range logs {
+		require.NotNil(t, l.(map[string]interface{})["transaction"].(map[string]interface{})["hash"])
+	}
+}
+
+func TestGraphQLQueries(t *testing.T) {
+	status, resObj := sendGraphQLQuery(t, `{
+		chainID
+		transaction(hash: "0xc1f0d26c419dea496540ab96a3331a9a79f084d7bc9662178dcd7c0bc407dc33") {
+			nonce
+			index
+			block { number }
+		}
+		missing: transaction(hash: "0x0000000000000000000000000000000000000000000000000000000000000000") { hash }
+	}`)
+	require.Equal(t, http.StatusOK, status, resObj)
+	data := resObj["data"].(map[string]interface{})
+	require.Equal(t, "0x538", data["chainID"])
+	tx := data["transaction"].(map[string]interface{})
+	require.Equal(t, "0x1", tx["nonce"])
+	require.Equal(t, "0x0", tx["index"])
+	require.Equal(t, "0x8", tx["block"].(map[string]interface{})["number"])
+	require.Nil(t, data["missing"])
+
+	// invalid queries are rejected
+	status, resObj = sendGraphQLQuery(t, `{ block { unknownField } }`)
+	require.Equal(t, http.StatusBadRequest, status)
+	require.NotEmpty(t, resObj["errors"])
+}
+
+func TestGraphQLQueryLimits(t *testing.T) {
+	// nesting is capped
+	status, resObj := sendGraphQLQuery(t, `{ block(number: 8) { parent { parent { parent { parent { parent { parent { parent { parent { parent { parent { number } } } } } } } } } } } }`)
+	require.Equal(t, http.StatusBadRequest, status)
+	require.Contains(t, fmt.Sprint(resObj["errors"]), "exceeds max depth")
+
+	// so is the number of lookups
+	var query strings.Builder
+	query.WriteString(`{ block(number: 8) {`)
+	for i := 0; i < 1000; i++ {
+		fmt.Fprintf(&query, ` a%d: account(address: "0x0000000000000000000000000000000000010203") { balance }`, i)
+	}
+	query.WriteString(` } }`)
+	status, resObj = sendGraphQLQuery(t, query.String())
+	require.Equal(t, http.StatusBadRequest, status)
+	require.Contains(t, fmt.Sprint(resObj["errors"]), "complexity limit")
+
+	// and the size of requests
+	res, err := http.Post(fmt.Sprintf("http://%s:%d/graphql", TestAddr, TestPort), "application/json", strings.NewReader(`{"query":"`+strings.Repeat(" ", 6*1024*1024)+`{ chainID }"}`))
+	require.Nil(t, err)
+	res.Body.Close()
+	require.Equal(t, http.StatusRequestEntityTooLarge, res.StatusCode)
+}
+
+func TestGraphQLAccessAndLimits(t *testing.T) {
+	config := evmrpc.DefaultConfig
+	config.HTTPPort = 0
+	config.EnableGraphQL = true
+	config.RestrictedMethods = []string{"graphql"}
+	config.AccessPolicies = []string{"key:indexer:s3cret=graphql"}
+	config.RateLimits = []string{"graphql=0.001:1"}
+	server, err := evmrpc.NewEVMHTTPServer(log.NewNopLogger(), config, &MockClient{}, EVMKeeper, func(int64) sdk.Context { return Ctx }, TxConfig, "")
+	require.Nil(t, err)
+	require.Nil(t, server.Start())
+	httpServer := server.(*evmrpc.HTTPServer)
+	defer httpServer.Stop()
+
+	query := func(apiKey string) (int, string) {
+		req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("http://%s/graphql", httpServer.ListenAddr()), strings.NewReader(`{"query":"{ chainID }"}`))
+		require.Nil(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Api-Key", apiKey)
+		res, err := http.DefaultClient.Do(req)
+		require.Nil(t, err)
+		return res.StatusCode, readBody(t, res.Body)
+	}
+	status, body := query("")
+	require.Equal(t, http.StatusUnauthorized, status)
+	require.Contains(t, body, "the method graphql_query requires an API key")
+	status, body = query("s3cret")
+	require.Equal(t, http.StatusOK, status, body)
+	status, body = query("s3cret")
+	require.Equal(t, http.StatusTooManyRequests, status)
+	require.Contains(t, body, "rate limit exceeded for graphql_query")
+}
@@ -25,10 +25,11 @@ type InfoAPI struct {
 	homeDir        string
 	connectionType ConnectionType
 	maxBlocks      int64
+	oracle         *gasPriceOracle
 }
 
-func NewInfoAPI(tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, txDecoder sdk.TxDecoder, homeDir string, maxBlocks int64, connectionType ConnectionType) *InfoAPI {
-	return &InfoAPI{tmClient: tmClient, keeper: k, ctxProvider: ctxProvider, txDecoder: txDecoder, homeDir: homeDir, connectionType: connectionType, maxBlocks: maxBlocks}
+func NewInfoAPI(tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, txDecoder sdk.TxDecoder, homeDir string, maxBlocks int64, gasPriceOracleConfig *GasPriceOracleConfig, connectionType ConnectionType) *InfoAPI {
+	return &InfoAPI{tmClient: tmClient, keeper: k, ctxProvider: ctxProvider, txDecoder: txDecoder, homeDir: homeDir, connectionType: connectionType, maxBlocks: maxBlocks, oracle: newGasPriceOracle(gasPriceOracleConfig)}
 }
 
 type FeeHistoryResult struct {
@@ -70,25 +71,17 @@ func (i *InfoAPI) Accounts() (result []common.Address, returnErr error) {
 	return result, nil
 }
 
+// GasPrice returns the dynamic base fee plus the priority fee suggested by the
+// gas price oracle.
 func (i *InfoAPI) GasPrice(ctx context.Context) (result *hexutil.Big, returnErr error) {
 	startTime := time.Now()
 	defer recordMetrics("eth_GasPrice", i.connectionType, startTime, returnErr == nil)
-	// get fee history of the most recent block with 50% reward percentile
-	feeHist, err := i.FeeHistory(ctx, 1, rpc.LatestBlockNumber, []float64{0.5})
+	tip, err := i.suggestTip(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if len(feeHist.Reward) == 0 || len(feeHist.Reward[0]) == 0 {
-		// if there is no EVM tx in the most recent block, return the minimum fee param
-		baseFee := i.keeper.GetMinimumFeePerGas(i.ctxProvider(LatestCtxHeight)).TruncateInt().BigInt()
-		return (*hexutil.Big)(baseFee), nil
-	}
 	baseFee := i.keeper.GetDynamicBaseFeePerGas(i.ctxProvider(LatestCtxHeight)).TruncateInt().BigInt()
-	sum := new(big.Int).Add(
-		feeHist.Reward[0][0].ToInt(),
-		baseFee,
-	)
-	return (*hexutil.Big)(sum), nil
+	return (*hexutil.Big)(new(big.Int).Add(tip, baseFee)), nil
 }
 
 // lastBlock is inclusive
@@ -178,28 +171,27 @@ func (i *InfoAPI) FeeHistory(ctx context.Context, blockCount math.HexOrDecimal64
 	return result, nil
 }
 
-func (i *InfoAPI) MaxPriorityFeePerGas(ctx context.Context) (*hexutil.Big, error) {
+// MaxPriorityFeePerGas returns the priority fee suggested by the gas price
+// oracle.
+func (i *InfoAPI) MaxPriorityFeePerGas(ctx context.Context) (result *hexutil.Big, returnErr error) {
 	startTime := time.Now()
-	defer recordMetrics("eth_maxPriorityFeePerGas", i.connectionType, startTime, true)
-	feeHist, err := i.FeeHistory(ctx, 1, rpc.LatestBlockNumber, []float64{0.5})
+	defer recordMetrics("eth_maxPriorityFeePerGas", i.connectionType, startTime, returnErr == nil)
+	tip, err := i.suggestTip(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if len(feeHist.Reward) == 0 || len(feeHist.Reward[0]) == 0 {
-		// if there is no EVM tx in the most recent block, return 0
-		return (*hexutil.Big)(big.NewInt(0)), nil
-	}
-	return (*hexutil.Big)(feeHist.Reward[0][0].ToInt()), nil
+	return (*hexutil.Big)(tip), nil
 }
 
+// safeGetBaseFee returns the base fee a block was executed with, or nil if it
+// has been pruned.
 func (i *InfoAPI) safeGetBaseFee(targetHeight int64) (res *big.Int) {
 	defer func() {
 		if err := recover(); err != nil {
 			res = nil
 		}
 	}()
-	baseFee := i.keeper.GetDynamicBaseFeePerGas(i.ctxProvider(targetHeight))
-	res = baseFee.TruncateInt().BigInt()
+	res = getBlockBaseFee(i.keeper, i.ctxProvider, targetHeight)
 	return
 }
 
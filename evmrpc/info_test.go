@@ -1,6 +1,7 @@
 package evmrpc_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/big"
@@ -12,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/go-bip39"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/kiichain/kiichain3/evmrpc"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -31,7 +33,7 @@ func TestChainID(t *testing.T) {
 
 func TestAccounts(t *testing.T) {
 	homeDir := t.TempDir()
-	api := evmrpc.NewInfoAPI(nil, nil, nil, nil, homeDir, 1024, evmrpc.ConnectionTypeHTTP)
+	api := evmrpc.NewInfoAPI(nil, nil, nil, nil, homeDir, 1024, &evmrpc.GasPriceOracleConfig{}, evmrpc.ConnectionTypeHTTP)
 	clientCtx := client.Context{}.WithViper("").WithHomeDir(homeDir)
 	clientCtx, err := config.ReadFromClientConfig(clientCtx)
 	require.Nil(t, err)
@@ -170,3 +172,75 @@ func TestMaxPriorityFeePerGas(t *testing.T) {
 	resObj := sendRequestGood(t, "maxPriorityFeePerGas")
 	assert.Equal(t, "0x170cdc1e00", resObj["result"])
 }
+
+func TestGasPriceOracle(t *testing.T) {
+	ctxProvider := func(int64) sdk.Context { return Ctx.WithBlockHeight(1) }
+	newAPI := func(config evmrpc.GasPriceOracleConfig) *evmrpc.InfoAPI {
+		return evmrpc.NewInfoAPI(&MockClient{}, EVMKeeper, ctxProvider, Decoder, "", 1024, &config, evmrpc.ConnectionTypeHTTP)
+	}
+	baseFee := EVMKeeper.GetDynamicBaseFeePerGas(Ctx).TruncateInt().BigInt()
+
+	// the tip of the only EVM tx of the window
+	api := newAPI(evmrpc.GasPriceOracleConfig{Blocks: 20, Percentile: 60})
+	tip, err := api.MaxPriorityFeePerGas(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(99_000_000_000), tip.ToInt())
+	price, err := api.GasPrice(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, new(big.Int).Add(baseFee, big.NewInt(99_000_000_000)), price.ToInt())
+
+	// capped by the max price
+	api = newAPI(evmrpc.GasPriceOracleConfig{Blocks: 20, Percentile: 60, MaxPrice: big.NewInt(1000)})
+	tip, err = api.MaxPriorityFeePerGas(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(1000), tip.ToInt())
+	// unless there is no max price
+	api = newAPI(evmrpc.GasPriceOracleConfig{Blocks: 20, Percentile: 60, MaxPrice: big.NewInt(0)})
+	tip, err = api.MaxPriorityFeePerGas(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(99_000_000_000), tip.ToInt())
+
+	// tips below the ignore price aren't sampled
+	api = newAPI(evmrpc.GasPriceOracleConfig{Blocks: 20, Percentile: 60, IgnorePrice: big.NewInt(100_000_000_000)})
+	tip, err = api.MaxPriorityFeePerGas(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(0), tip.ToInt())
+	price, err = api.GasPrice(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, baseFee, price.ToInt())
+}
+
+func TestBlockBaseFee(t *testing.T) {
+	// the EndBlock of block 2 stores the base fee of block 3
+	prevCtx, _ := Ctx.WithBlockHeight(1).CacheContext()
+	EVMKeeper.SetDynamicBaseFeePerGas(prevCtx, sdk.NewDec(2_000_000_000))
+	latestCtx, _ := Ctx.WithBlockHeight(2).CacheContext()
+	EVMKeeper.SetDynamicBaseFeePerGas(latestCtx, sdk.NewDec(3_000_000_000))
+	ctxProvider := func(h int64) sdk.Context {
+		switch h {
+		case evmrpc.LatestCtxHeight:
+			return latestCtx
+		case 1:
+			return prevCtx
+		default:
+			// the test store only has the version of height 1
+			return latestCtx.WithBlockHeight(1)
+		}
+	}
+	infoAPI := evmrpc.NewInfoAPI(&MockClient{}, EVMKeeper, ctxProvider, Decoder, "", 1024, &evmrpc.GasPriceOracleConfig{Blocks: 20, Percentile: 60}, evmrpc.ConnectionTypeHTTP)
+	blockAPI := evmrpc.NewBlockAPI(&MockClient{}, EVMKeeper, ctxProvider, TxConfig, nil, evmrpc.ConnectionTypeHTTP, "eth")
+	requireBaseFee := func(expected *big.Int) {
+		history, err := infoAPI.FeeHistory(context.Background(), 1, 2, nil)
+		require.Nil(t, err)
+		require.Equal(t, expected, history.BaseFee[0].ToInt())
+		block, err := blockAPI.GetBlockByNumber(context.Background(), 2, false)
+		require.Nil(t, err)
+		require.Equal(t, expected, block["baseFeePerGas"].(*hexutil.Big).ToInt())
+	}
+
+	// outside of the base fee history, the fee is read from the previous block
+	requireBaseFee(big.NewInt(2_000_000_000))
+
+	EVMKeeper.SetBaseFeePerGasAtHeight(latestCtx, 2, sdk.NewDec(4_000_000_000))
+	requireBaseFee(big.NewInt(4_000_000_000))
+}
@@ -75,6 +75,7 @@ func (handler *jwtHandler) ServeHTTP(out http.ResponseWriter, r *http.Request) {
 	case time.Until(claims.IssuedAt.Time) > JwtExpiryTimeout:
 		http.Error(out, "future token", http.StatusUnauthorized)
 	default:
-		handler.next.ServeHTTP(out, r)
+		// the subject identifies the client for rate limiting
+		handler.next.ServeHTTP(out, withJWTSubject(r, claims.Subject))
 	}
 }
@@ -0,0 +1,179 @@
+package evmrpc
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/kiichain/kiichain3/precompiles/wasmd"
+	"github.com/kiichain/kiichain3/x/evm/keeper"
+	"github.com/kiichain/kiichain3/x/evm/state"
+	"github.com/kiichain/kiichain3/x/evm/types"
+	"github.com/tendermint/tendermint/libs/log"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+)
+
+// PendingCtxHeight is the height to pass to a ctx provider for the state at
+// the "pending" block tag.
+const PendingCtxHeight int64 = -2
+
+// latestOrPendingHeight returns the ctx height of the state that a block tag
+// without a height refers to.
+func latestOrPendingHeight(blockNrOrHash rpc.BlockNumberOrHash) int64 {
+	if blockNr, ok := blockNrOrHash.Number(); ok && blockNr == rpc.PendingBlockNumber {
+		return PendingCtxHeight
+	}
+	return LatestCtxHeight
+}
+
+// pendingState speculatively applies the executable EVM txs of the mempool on
+// top of the latest state, so that queries at the "pending" block tag see
+// their effects. It is built on demand and reused for the refresh interval.
+type pendingState struct {
+	logger          log.Logger
+	tmClient        rpcclient.Client
+	keeper          *keeper.Keeper
+	ctxProvider     func(int64) sdk.Context
+	txDecoder       sdk.TxDecoder
+	maxPoolTxs      int
+	maxTxs          int
+	refreshInterval time.Duration
+
+	mtx     sync.Mutex
+	ctx     sdk.Context
+	builtAt time.Time
+}
+
+// NewPendingCtxProvider wraps a ctx provider so that PendingCtxHeight
+// provides the pending state if it is enabled, and the latest state otherwise.
+func NewPendingCtxProvider(logger log.Logger, config Config, tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, txDecoder sdk.TxDecoder) func(int64) sdk.Context {
+	if !config.EnablePendingState {
+		return func(height int64) sdk.Context {
+			if height == PendingCtxHeight {
+				return ctxProvider(LatestCtxHeight)
+			}
+			return ctxProvider(height)
+		}
+	}
+	p := &pendingState{
+		logger:          logger,
+		tmClient:        tmClient,
+		keeper:          k,
+		ctxProvider:     ctxProvider,
+		txDecoder:       txDecoder,
+		maxPoolTxs:      int(config.MaxTxPoolTxs),
+		maxTxs:          config.PendingStateMaxTxs,
+		refreshInterval: config.PendingStateRefreshInterval,
+	}
+	return func(height int64) sdk.Context {
+		if height == PendingCtxHeight {
+			return p.Ctx()
+		}
+		return ctxProvider(height)
+	}
+}
+
+// Ctx returns a branch of the pending state that the caller may write to. It
+// falls back to the latest state if the pending state can't be built.
+func (p *pendingState) Ctx() sdk.Context {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	if p.builtAt.IsZero() || time.Since(p.builtAt) >= p.refreshInterval {
+		ctx, err := p.build()
+		if err != nil {
+			p.logger.Error("failed to build pending state; using latest state instead", "err", err)
+			return p.ctxProvider(LatestCtxHeight)
+		}
+		p.ctx, p.builtAt = ctx, time.Now()
+	}
+	return p.ctx.WithMultiStore(p.ctx.MultiStore().CacheMultiStore()).WithEventManager(sdk.NewEventManager())
+}
+
+// build applies up to maxTxs pending txs of the mempool, in nonce order for
+// each sender and by effective tip across senders. Txs that fail are skipped
+// along with the later txs of their sender.
+func (p *pendingState) build() (sdk.Context, error) {
+	latestCtx := p.ctxProvider(LatestCtxHeight).WithIsEVM(true)
+	pool, err := snapshotTxPool(context.Background(), p.tmClient, p.keeper, latestCtx, p.txDecoder, p.maxPoolTxs)
+	if err != nil {
+		return sdk.Context{}, err
+	}
+	ctx := latestCtx.WithMultiStore(latestCtx.MultiStore().CacheMultiStore()).WithEventManager(sdk.NewEventManager())
+	blockCtx, err := p.keeper.GetVMBlockContext(ctx, core.GasPool(math.MaxUint64))
+	if err != nil {
+		return sdk.Context{}, err
+	}
+	chainConfig := types.DefaultChainConfig().EthereumConfig(p.keeper.ChainID(ctx))
+	signer := ethtypes.MakeSigner(chainConfig, blockCtx.BlockNumber, blockCtx.Time)
+
+	// the executable txs of each sender, in nonce order
+	queues := map[common.Address][]*ethtypes.Transaction{}
+	for addr, txs := range pool.pending {
+		nonces := make([]uint64, 0, len(txs))
+		for nonce := range txs {
+			nonces = append(nonces, nonce)
+		}
+		sort.Slice(nonces, func(i, j int) bool { return nonces[i] < nonces[j] })
+		for _, nonce := range nonces {
+			if txs[nonce] == nil { // the txs after it aren't executable without it
+				break
+			}
+			queues[addr] = append(queues[addr], txs[nonce])
+		}
+	}
+	for applied := 0; applied < p.maxTxs && len(queues) > 0; {
+		var best common.Address
+		found := false
+		for addr, queue := range queues {
+			if !found {
+				best, found = addr, true
+				continue
+			}
+			cmp := queue[0].EffectiveGasTipCmp(queues[best][0], blockCtx.BaseFee)
+			if cmp > 0 || (cmp == 0 && addr.Cmp(best) < 0) {
+				best = addr
+			}
+		}
+		if !p.apply(ctx, blockCtx, chainConfig, signer, queues[best][0]) {
+			delete(queues, best)
+			continue
+		}
+		applied++
+		if queues[best] = queues[best][1:]; len(queues[best]) == 0 {
+			delete(queues, best)
+		}
+	}
+	return ctx, nil
+}
+
+// apply applies a tx to ctx, leaving ctx unchanged if it fails.
+func (p *pendingState) apply(ctx sdk.Context, blockCtx *vm.BlockContext, chainConfig *params.ChainConfig, signer ethtypes.Signer, tx *ethtypes.Transaction) bool {
+	msg, err := core.TransactionToMessage(tx, signer, blockCtx.BaseFee)
+	if err != nil {
+		return false
+	}
+	// senders are associated when their first tx enters the mempool
+	if _, associated := p.keeper.GetKiiAddress(ctx, msg.From); !associated {
+		return false
+	}
+	txCtx := ctx.WithMultiStore(ctx.MultiStore().CacheMultiStore()).WithEVMEntryViaWasmdPrecompile(wasmd.IsWasmdCall(tx.To()))
+	statedb := state.NewDBImpl(txCtx, p.keeper, false)
+	evm := vm.NewEVM(*blockCtx, core.NewEVMTxContext(msg), statedb, chainConfig, vm.Config{})
+	if _, err := core.ApplyMessage(evm, msg, new(core.GasPool).AddGas(tx.Gas())); err != nil {
+		return false
+	}
+	if _, err := statedb.Finalize(); err != nil {
+		return false
+	}
+	txCtx.MultiStore().(sdk.CacheMultiStore).Write()
+	return true
+}
@@ -0,0 +1,87 @@
+package evmrpc_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/kiichain/kiichain3/evmrpc"
+	"github.com/kiichain/kiichain3/x/evm/config"
+	"github.com/kiichain/kiichain3/x/evm/state"
+	"github.com/kiichain/kiichain3/x/evm/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/rpc/coretypes"
+)
+
+// pendingClient is a client whose mempool has the given txs.
+type pendingClient struct {
+	*MockClient
+	txs []sdk.Tx
+}
+
+func (c *pendingClient) UnconfirmedTxs(context.Context, *int, *int) (*coretypes.ResultUnconfirmedTxs, error) {
+	res := &coretypes.ResultUnconfirmedTxs{}
+	for _, tx := range c.txs {
+		bz, err := Encoder(tx)
+		if err != nil {
+			return nil, err
+		}
+		res.Txs = append(res.Txs, bz)
+	}
+	res.Count, res.Total = len(res.Txs), len(res.Txs)
+	return res, nil
+}
+
+func TestPendingCtxProvider(t *testing.T) {
+	ctx, _ := Ctx.CacheContext()
+	ctxProvider := func(int64) sdk.Context { return ctx }
+	to := common.HexToAddress("0x9999999999999999999999999999999999999999")
+
+	// the sender of the txs built by buildTx
+	_, tx := buildTx(ethtypes.DynamicFeeTx{ChainID: big.NewInt(config.DefaultChainID)})
+	sender, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(tx.ChainId()), tx)
+	require.Nil(t, err)
+	kiiAddr := sdk.AccAddress(sender[:])
+	EVMKeeper.SetAddressMapping(ctx, kiiAddr, sender)
+	EVMKeeper.SetNonce(ctx, sender, 7)
+	amts := sdk.NewCoins(sdk.NewCoin(EVMKeeper.GetBaseDenom(ctx), sdk.NewInt(1_000_000)))
+	require.Nil(t, EVMKeeper.BankKeeper().MintCoins(ctx, types.ModuleName, amts))
+	require.Nil(t, EVMKeeper.BankKeeper().SendCoinsFromModuleToAccount(ctx, types.ModuleName, kiiAddr, amts))
+
+	var txs []sdk.Tx
+	for _, nonce := range []uint64{7, 8, 10} { // 10 is not executable
+		builder, _ := buildTx(ethtypes.DynamicFeeTx{
+			Nonce:     nonce,
+			GasFeeCap: big.NewInt(1_000_000_000_000),
+			GasTipCap: big.NewInt(0),
+			Gas:       21000,
+			To:        &to,
+			Value:     big.NewInt(1_000_000_000_000),
+			ChainID:   big.NewInt(config.DefaultChainID),
+		})
+		txs = append(txs, builder.GetTx())
+	}
+	client := &pendingClient{MockClient: &MockClient{}, txs: txs}
+
+	// disabled: pending is latest
+	disabled := evmrpc.NewPendingCtxProvider(log.NewNopLogger(), evmrpc.DefaultConfig, client, EVMKeeper, ctxProvider, Decoder)
+	require.Equal(t, uint64(7), EVMKeeper.GetNonce(disabled(evmrpc.PendingCtxHeight), sender))
+
+	cfg := evmrpc.DefaultConfig
+	cfg.EnablePendingState = true
+	cfg.PendingStateRefreshInterval = time.Hour
+	provider := evmrpc.NewPendingCtxProvider(log.NewNopLogger(), cfg, client, EVMKeeper, ctxProvider, Decoder)
+	pendingCtx := provider(evmrpc.PendingCtxHeight)
+	require.Equal(t, uint64(9), EVMKeeper.GetNonce(pendingCtx, sender))
+	require.Equal(t, big.NewInt(2_000_000_000_000), state.NewDBImpl(pendingCtx, EVMKeeper, true).GetBalance(to))
+
+	// the latest state is untouched, and writes to a pending ctx don't leak
+	require.Equal(t, uint64(7), EVMKeeper.GetNonce(provider(evmrpc.LatestCtxHeight), sender))
+	EVMKeeper.SetNonce(pendingCtx, sender, 100)
+	require.Equal(t, uint64(9), EVMKeeper.GetNonce(provider(evmrpc.PendingCtxHeight), sender))
+}
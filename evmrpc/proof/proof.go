@@ -0,0 +1,326 @@
+// Package proof defines the result of eth_getProof and verifies it against
+// the app hash of a block, so that it can be checked without trusting the RPC
+// node.
+//
+// Unlike Ethereum, accounts have no storage trie of their own: every value is
+// a key of an IAVL store, and each proof is a Tendermint ProofOps made of an
+// IAVL commitment of the key under the root of its store followed by a simple
+// merkle commitment of that root under the app hash. The app hash committing
+// to the state after block N is in the header of block N+1.
+package proof
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/kiichain/kiichain3/x/evm/keeper"
+	"github.com/kiichain/kiichain3/x/evm/state"
+	"github.com/kiichain/kiichain3/x/evm/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	tmcrypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
+)
+
+// Indices of the proofs in AccountResult.AccountProof.
+const (
+	AccountProofNonce = iota
+	AccountProofCodeHash
+	AccountProofAssociation
+	AccountProofBalance
+	AccountProofWeiBalance
+
+	AccountProofLen
+)
+
+// AccountResult is the result of eth_getProof. It has the shape of EIP-1186,
+// but each entry of AccountProof and StorageProof is a hex-encoded ProofOps
+// rather than a trie node. AccountProof holds, in this order, the proofs of
+// the nonce, the code hash, the association with a Kii address, the balance
+// and the wei balance of the account. StorageHash is the root of the EVM store,
+// which all proofs of the nonce, code hash, association and storage lead to.
+//
+// Balance is the full balance held in the bank module, including coins locked
+// by vesting, so it may exceed the balance returned by eth_getBalance.
+type AccountResult struct {
+	Address      common.Address  `json:"address"`
+	AccountProof []string        `json:"accountProof"`
+	Balance      *hexutil.Big    `json:"balance"`
+	CodeHash     common.Hash     `json:"codeHash"`
+	Nonce        hexutil.Uint64  `json:"nonce"`
+	StorageHash  common.Hash     `json:"storageHash"`
+	StorageProof []StorageResult `json:"storageProof"`
+}
+
+type StorageResult struct {
+	Key   string       `json:"key"`
+	Value *hexutil.Big `json:"value"`
+	Proof []string     `json:"proof"`
+}
+
+// Key is a key of a store of the multistore.
+type Key struct {
+	StoreName string
+	Key       []byte
+}
+
+// Path returns the path of the ABCI query that proves the key.
+func (k Key) Path() string {
+	return fmt.Sprintf("/store/%s/key", k.StoreName)
+}
+
+// KeyPath returns the key path that the proof of the key is verified with.
+func (k Key) KeyPath() string {
+	return merkle.KeyPath{}.AppendKey([]byte(k.StoreName), merkle.KeyEncodingURL).AppendKey(k.Key, merkle.KeyEncodingHex).String()
+}
+
+func NonceKey(addr common.Address) Key {
+	return Key{StoreName: types.StoreKey, Key: append(types.NonceKeyPrefix, addr[:]...)}
+}
+
+func CodeHashKey(addr common.Address) Key {
+	return Key{StoreName: types.StoreKey, Key: append(types.CodeHashKeyPrefix, addr[:]...)}
+}
+
+func AssociationKey(addr common.Address) Key {
+	return Key{StoreName: types.StoreKey, Key: types.EVMAddressToKiiAddressKey(addr)}
+}
+
+func BalanceKey(kiiAddr sdk.AccAddress) Key {
+	return Key{StoreName: banktypes.StoreKey, Key: banktypes.CreatePrefixedAccountStoreKey(kiiAddr, []byte(keeper.BaseDenom))}
+}
+
+func WeiBalanceKey(kiiAddr sdk.AccAddress) Key {
+	return Key{StoreName: banktypes.StoreKey, Key: append(banktypes.WeiBalancesPrefix, kiiAddr...)}
+}
+
+func StorageKey(addr common.Address, slot common.Hash) Key {
+	return Key{StoreName: types.StoreKey, Key: append(types.StateKey(addr), slot[:]...)}
+}
+
+// KiiAddress returns the Kii address that holds the balance of addr given the
+// value of its association key, which is nil if addr is not associated.
+func KiiAddress(addr common.Address, association []byte) sdk.AccAddress {
+	if association == nil {
+		return sdk.AccAddress(addr[:])
+	}
+	return association
+}
+
+// DecodeNonce decodes the value of a nonce key.
+func DecodeNonce(value []byte) uint64 {
+	if len(value) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(value)
+}
+
+// DecodeBalance decodes the values of the balance and wei balance keys of an
+// account into its balance in wei.
+func DecodeBalance(balance, weiBalance []byte) (*big.Int, error) {
+	res := sdk.ZeroInt()
+	if balance != nil {
+		var coin sdk.Coin
+		if err := coin.Unmarshal(balance); err != nil {
+			return nil, fmt.Errorf("invalid balance: %w", err)
+		}
+		res = coin.Amount.Mul(state.SdkUkiiToSweiMultiplier)
+	}
+	if weiBalance != nil {
+		var wei sdk.Int
+		if err := wei.Unmarshal(weiBalance); err != nil {
+			return nil, fmt.Errorf("invalid wei balance: %w", err)
+		}
+		res = res.Add(wei)
+	}
+	return res.BigInt(), nil
+}
+
+// DecodeCodeHash decodes the value of a code hash key. Accounts without code
+// have the empty code hash if they have a balance and the zero hash otherwise.
+func DecodeCodeHash(value []byte, balance *big.Int) common.Hash {
+	if value != nil {
+		return common.BytesToHash(value)
+	}
+	if balance.Sign() == 0 {
+		return common.Hash{}
+	}
+	return ethtypes.EmptyCodeHash
+}
+
+// Encode encodes a proof for a result.
+func Encode(proofOps *tmcrypto.ProofOps) (string, error) {
+	if proofOps == nil {
+		return "", errors.New("missing proof")
+	}
+	bz, err := proofOps.Marshal()
+	if err != nil {
+		return "", err
+	}
+	return hexutil.Encode(bz), nil
+}
+
+// VerifyAccount verifies every proof of res against appHash, and that the
+// fields of res are the values they prove.
+func VerifyAccount(appHash []byte, res *AccountResult) error {
+	if len(res.AccountProof) != AccountProofLen {
+		return fmt.Errorf("expected %d account proofs, got %d", AccountProofLen, len(res.AccountProof))
+	}
+	storageHash := res.StorageHash[:]
+	nonce, err := verifyEVMStore(appHash, storageHash, NonceKey(res.Address), res.AccountProof[AccountProofNonce])
+	if err != nil {
+		return fmt.Errorf("nonce: %w", err)
+	}
+	if DecodeNonce(nonce) != uint64(res.Nonce) {
+		return fmt.Errorf("nonce: proven %d, got %d", DecodeNonce(nonce), res.Nonce)
+	}
+	association, err := verifyEVMStore(appHash, storageHash, AssociationKey(res.Address), res.AccountProof[AccountProofAssociation])
+	if err != nil {
+		return fmt.Errorf("association: %w", err)
+	}
+	kiiAddr := KiiAddress(res.Address, association)
+	balance, _, err := Verify(appHash, BalanceKey(kiiAddr), res.AccountProof[AccountProofBalance])
+	if err != nil {
+		return fmt.Errorf("balance: %w", err)
+	}
+	weiBalance, _, err := Verify(appHash, WeiBalanceKey(kiiAddr), res.AccountProof[AccountProofWeiBalance])
+	if err != nil {
+		return fmt.Errorf("wei balance: %w", err)
+	}
+	provenBalance, err := DecodeBalance(balance, weiBalance)
+	if err != nil {
+		return err
+	}
+	if res.Balance == nil || provenBalance.Cmp(res.Balance.ToInt()) != 0 {
+		return fmt.Errorf("balance: proven %s, got %v", provenBalance, res.Balance)
+	}
+	codeHash, err := verifyEVMStore(appHash, storageHash, CodeHashKey(res.Address), res.AccountProof[AccountProofCodeHash])
+	if err != nil {
+		return fmt.Errorf("code hash: %w", err)
+	}
+	if provenCodeHash := DecodeCodeHash(codeHash, provenBalance); provenCodeHash != res.CodeHash {
+		return fmt.Errorf("code hash: proven %s, got %s", provenCodeHash, res.CodeHash)
+	}
+	for _, storage := range res.StorageProof {
+		if err := VerifyStorage(appHash, res.Address, res.StorageHash, storage); err != nil {
+			return fmt.Errorf("storage %s: %w", storage.Key, err)
+		}
+	}
+	return nil
+}
+
+// VerifyStorage verifies the proof of a storage slot of addr against appHash
+// and storageHash, and that the value of res is the proven one.
+func VerifyStorage(appHash []byte, addr common.Address, storageHash common.Hash, res StorageResult) error {
+	if len(res.Proof) != 1 {
+		return fmt.Errorf("expected 1 proof, got %d", len(res.Proof))
+	}
+	slot, err := decodeSlot(res.Key)
+	if err != nil {
+		return fmt.Errorf("invalid key: %w", err)
+	}
+	value, err := verifyEVMStore(appHash, storageHash[:], StorageKey(addr, slot), res.Proof[0])
+	if err != nil {
+		return err
+	}
+	proven := new(big.Int).SetBytes(value)
+	if res.Value == nil || proven.Cmp(res.Value.ToInt()) != 0 {
+		return fmt.Errorf("proven %s, got %v", proven, res.Value)
+	}
+	return nil
+}
+
+// Verify verifies a proof of key against appHash. It returns the proven value,
+// nil if the proof is a proof of absence, and the root of the store of the key.
+func Verify(appHash []byte, key Key, proof string) (value []byte, storeRoot []byte, err error) {
+	bz, err := hexutil.Decode(proof)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid proof: %w", err)
+	}
+	proofOps := &tmcrypto.ProofOps{}
+	if err := proofOps.Unmarshal(bz); err != nil {
+		return nil, nil, fmt.Errorf("invalid proof: %w", err)
+	}
+	if len(proofOps.Ops) != 2 {
+		return nil, nil, fmt.Errorf("expected 2 proof operations, got %d", len(proofOps.Ops))
+	}
+	op, err := storetypes.CommitmentOpDecoder(proofOps.Ops[0])
+	if err != nil {
+		return nil, nil, err
+	}
+	if exist := op.(storetypes.CommitmentOp).Proof.GetExist(); exist != nil {
+		value = exist.Value
+	}
+	if err := rootmulti.DefaultProofRuntime().Verify(proofOps, appHash, key.KeyPath(), args(value)); err != nil {
+		return nil, nil, err
+	}
+	storeRoot, err = StoreRoot(proofOps, value)
+	if err != nil {
+		return nil, nil, err
+	}
+	return value, storeRoot, nil
+}
+
+// StoreRoot returns the root of the store that a proof of value, or of absence
+// if value is nil, leads to.
+func StoreRoot(proofOps *tmcrypto.ProofOps, value []byte) ([]byte, error) {
+	if proofOps == nil || len(proofOps.Ops) == 0 {
+		return nil, errors.New("missing proof")
+	}
+	op, err := storetypes.CommitmentOpDecoder(proofOps.Ops[0])
+	if err != nil {
+		return nil, err
+	}
+	roots, err := op.Run(args(value))
+	if err != nil {
+		return nil, err
+	}
+	return roots[0], nil
+}
+
+func args(value []byte) [][]byte {
+	if value == nil {
+		return [][]byte{}
+	}
+	return [][]byte{value}
+}
+
+// decodeSlot parses a hex-encoded storage slot of up to 32 bytes, optionally
+// prefixed by 0x, as eth_getProof accepts it.
+func decodeSlot(s string) (common.Hash, error) {
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X")
+	if len(s)%2 == 1 {
+		s = "0" + s
+	}
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if len(b) > common.HashLength {
+		return common.Hash{}, errors.New("hex string too long, want at most 32 bytes")
+	}
+	return common.BytesToHash(b), nil
+}
+
+// verifyEVMStore verifies a proof of a key of the EVM store against appHash,
+// and that the root of the EVM store is storageHash.
+func verifyEVMStore(appHash []byte, storageHash []byte, key Key, proof string) ([]byte, error) {
+	value, storeRoot, err := Verify(appHash, key, proof)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(storeRoot, storageHash) {
+		return nil, fmt.Errorf("store root %X does not match storage hash %X", storeRoot, storageHash)
+	}
+	return value, nil
+}
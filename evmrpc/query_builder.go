@@ -1,6 +1,7 @@
 package evmrpc
 
 import (
+	"fmt"
 	"strings"
 )
 
@@ -24,6 +25,15 @@ func NewBlockQueryBuilder() *QueryBuilder {
 	}
 }
 
+func NewTxQueryBuilder(txHash string) *QueryBuilder {
+	return &QueryBuilder{
+		conditions: []string{
+			"tm.event = 'Tx'",
+			fmt.Sprintf("tx.hash = '%s'", txHash),
+		},
+	}
+}
+
 func (q *QueryBuilder) Build() string {
 	return strings.Join(q.conditions, " AND ")
 }
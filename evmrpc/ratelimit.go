@@ -0,0 +1,337 @@
+package evmrpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kiichain/kiichain3/utils/metrics"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// LimitExceededErrorCode is the JSON-RPC error code for requests rejected by a
+	// limit, as defined in EIP-1474.
+	LimitExceededErrorCode = -32005
+
+	// buckets of clients that have been idle for this long are dropped
+	rateLimitBucketTTL = 10 * time.Minute
+
+	// same as the request size limit enforced by the go-ethereum HTTP server
+	maxRequestContentLength = 1024 * 1024 * 5
+)
+
+var errRequestTooLarge = fmt.Errorf("content length too large (%d>%d)", maxRequestContentLength+1, maxRequestContentLength)
+
+type limitExceededError struct{ message string }
+
+func (e *limitExceededError) ErrorCode() int { return LimitExceededErrorCode }
+
+func (e *limitExceededError) Error() string { return e.message }
+
+type rateLimitRule struct {
+	limit rate.Limit
+	burst int
+}
+
+type rateLimitBucketKey struct {
+	client string
+	scope  string
+}
+
+type rateLimitBucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// RPCLimiter enforces the request budgets of one EVM RPC server: token bucket
+// rate limits per client and method/namespace, the size of batches and
+// responses, and the number of heavy calls served concurrently.
+type RPCLimiter struct {
+	connectionType   ConnectionType
+	rules            map[string]rateLimitRule // by method, namespace or "*"
+	maxBatchSize     int
+	maxResponseBytes int
+	heavyMethods     []string
+	heavySlots       chan struct{} // nil if heavy calls are not limited
+
+	bucketsMtx sync.Mutex
+	buckets    map[rateLimitBucketKey]*rateLimitBucket
+	lastPurge  time.Time
+}
+
+func NewRPCLimiter(config Config, connectionType ConnectionType) (*RPCLimiter, error) {
+	l := &RPCLimiter{
+		connectionType:   connectionType,
+		rules:            map[string]rateLimitRule{},
+		maxBatchSize:     config.MaxBatchSize,
+		maxResponseBytes: config.MaxResponseBytes,
+		heavyMethods:     config.HeavyMethods,
+		buckets:          map[rateLimitBucketKey]*rateLimitBucket{},
+		lastPurge:        time.Now(),
+	}
+	for _, entry := range config.RateLimits {
+		scope, rule, err := parseRateLimitRule(entry)
+		if err != nil {
+			return nil, err
+		}
+		l.rules[scope] = rule
+	}
+	if config.MaxConcurrentHeavyCalls > 0 {
+		l.heavySlots = make(chan struct{}, config.MaxConcurrentHeavyCalls)
+	}
+	return l, nil
+}
+
+// parseRateLimitRule parses "<method|namespace|*>=<requests per second>[:<burst>]".
+// The burst defaults to the rate, rounded up.
+func parseRateLimitRule(entry string) (string, rateLimitRule, error) {
+	scope, spec, found := strings.Cut(strings.TrimSpace(entry), "=")
+	if !found || scope == "" {
+		return "", rateLimitRule{}, fmt.Errorf("invalid rate limit %q, expected <method|namespace|*>=<rate>[:<burst>]", entry)
+	}
+	rule, err := parseRateSpec(spec)
+	if err != nil {
+		return "", rateLimitRule{}, fmt.Errorf("%s in rate limit %q", err, entry)
+	}
+	return scope, rule, nil
+}
+
+// parseRateSpec parses "<requests per second>[:<burst>]". The burst defaults to
+// the rate, rounded up.
+func parseRateSpec(spec string) (rateLimitRule, error) {
+	rateStr, burstStr, hasBurst := strings.Cut(spec, ":")
+	perSecond, err := strconv.ParseFloat(rateStr, 64)
+	if err != nil || perSecond <= 0 {
+		return rateLimitRule{}, errors.New("invalid rate")
+	}
+	burst := int(math.Ceil(perSecond))
+	if hasBurst {
+		if burst, err = strconv.Atoi(burstStr); err != nil || burst <= 0 {
+			return rateLimitRule{}, errors.New("invalid burst")
+		}
+	}
+	return rateLimitRule{limit: rate.Limit(perSecond), burst: burst}, nil
+}
+
+// ruleFor returns the most specific rule for the method: the method itself,
+// then its namespace, then "*".
+func (l *RPCLimiter) ruleFor(method string) (string, rateLimitRule, bool) {
+	if rule, ok := l.rules[method]; ok {
+		return method, rule, true
+	}
+	if namespace, _, found := strings.Cut(method, "_"); found {
+		if rule, ok := l.rules[namespace]; ok {
+			return namespace, rule, true
+		}
+	}
+	rule, ok := l.rules["*"]
+	return "*", rule, ok
+}
+
+func (l *RPCLimiter) allow(client string, method string) error {
+	scope, rule, ok := l.ruleFor(method)
+	if !ok {
+		return nil
+	}
+	now := time.Now()
+	l.bucketsMtx.Lock()
+	defer l.bucketsMtx.Unlock()
+	if now.Sub(l.lastPurge) > rateLimitBucketTTL {
+		for key, bucket := range l.buckets {
+			if now.Sub(bucket.lastSeen) > rateLimitBucketTTL {
+				delete(l.buckets, key)
+			}
+		}
+		l.lastPurge = now
+	}
+	key := rateLimitBucketKey{client: client, scope: scope}
+	bucket, found := l.buckets[key]
+	if !found {
+		bucket = &rateLimitBucket{limiter: rate.NewLimiter(rule.limit, rule.burst)}
+		l.buckets[key] = bucket
+	}
+	bucket.lastSeen = now
+	if !bucket.limiter.AllowN(now, 1) {
+		return l.reject(method, "rate", fmt.Sprintf("rate limit exceeded for %s", method))
+	}
+	return nil
+}
+
+func (l *RPCLimiter) isHeavy(method string) bool {
+	for _, heavy := range l.heavyMethods {
+		if prefix, isPrefix := strings.CutSuffix(heavy, "*"); isPrefix && strings.HasPrefix(method, prefix) {
+			return true
+		}
+		if heavy == method {
+			return true
+		}
+	}
+	return false
+}
+
+// checkRequests applies the batch, rate and heavy call limits to a message. On
+// success, the returned function, if not nil, must be called once the message
+// has been answered to free its heavy call slots.
+func (l *RPCLimiter) checkRequests(r *http.Request, reqs []rpcRequestHeader, isBatch bool) (func(), error) {
+	if isBatch && l.maxBatchSize > 0 && len(reqs) > l.maxBatchSize {
+		return nil, l.reject("batch", "batch_size", fmt.Sprintf("batch of %d requests exceeds limit of %d", len(reqs), l.maxBatchSize))
+	}
+	client := rateLimitClient(r)
+	heavy := 0
+	for _, req := range reqs {
+		if err := l.allow(client, req.Method); err != nil {
+			return nil, err
+		}
+		if l.isHeavy(req.Method) {
+			heavy++
+		}
+	}
+	if l.heavySlots == nil || heavy == 0 {
+		return nil, nil
+	}
+	release := func(n int) {
+		for i := 0; i < n; i++ {
+			<-l.heavySlots
+		}
+	}
+	for i := 0; i < heavy; i++ {
+		select {
+		case l.heavySlots <- struct{}{}:
+		default:
+			release(i)
+			return nil, l.reject(reqs[0].Method, "concurrency", "too many concurrent heavy calls, please retry later")
+		}
+	}
+	return func() { release(heavy) }, nil
+}
+
+func (l *RPCLimiter) checkResponseSize(resp []byte) error {
+	if l.maxResponseBytes > 0 && len(resp) > l.maxResponseBytes {
+		return l.reject("response", "response_size", fmt.Sprintf("response of %d bytes exceeds limit of %d", len(resp), l.maxResponseBytes))
+	}
+	return nil
+}
+
+func (l *RPCLimiter) reject(endpoint string, limit string, message string) error {
+	metrics.IncrementRpcLimitExceededCounter(endpoint, string(l.connectionType), limit)
+	return &limitExceededError{message: message}
+}
+
+type jwtSubjectKey struct{}
+
+// rateLimitClient identifies the client of a request by its JWT subject if it
+// was authenticated with one, and by its IP otherwise.
+func rateLimitClient(r *http.Request) string {
+	if subject, ok := r.Context().Value(jwtSubjectKey{}).(string); ok && subject != "" {
+		return "jwt:" + subject
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	return "ip:" + host
+}
+
+func withJWTSubject(r *http.Request, subject string) *http.Request {
+	return r.WithContext(context.WithValue(r.Context(), jwtSubjectKey{}, subject))
+}
+
+type limitHandler struct {
+	limiter *RPCLimiter
+	next    http.Handler
+}
+
+// newLimitHandler applies the limiter to JSON-RPC requests served over HTTP.
+func newLimitHandler(limiter *RPCLimiter, next http.Handler) http.Handler {
+	return &limitHandler{limiter: limiter, next: next}
+}
+
+func (h *limitHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		h.next.ServeHTTP(w, r)
+		return
+	}
+	reqs, isBatch, ok, err := peekRequestHeaders(r)
+	if err != nil {
+		rejectUnreadableRequest(w, err)
+		return
+	}
+	if !ok {
+		// the server would still serve the calls it can parse
+		writeRPCErrorResponse(w, newRPCErrorResponse(nil, isBatch, &invalidMessageError{}))
+		return
+	}
+	release, err := h.limiter.checkRequests(r, reqs, isBatch)
+	if err != nil {
+		writeRPCErrorResponse(w, newRPCErrorResponse(reqs, isBatch, err))
+		return
+	}
+	if release != nil {
+		defer release()
+	}
+	if h.limiter.maxResponseBytes <= 0 {
+		h.next.ServeHTTP(w, r)
+		return
+	}
+	buffered := &bufferedResponseWriter{header: w.Header(), status: http.StatusOK}
+	h.next.ServeHTTP(buffered, r)
+	if err := h.limiter.checkResponseSize(buffered.body.Bytes()); err != nil {
+		writeRPCErrorResponse(w, newRPCErrorResponse(reqs, isBatch, err))
+		return
+	}
+	w.WriteHeader(buffered.status)
+	_, _ = w.Write(buffered.body.Bytes())
+}
+
+// peekRequestHeaders parses the calls of a JSON-RPC request served over HTTP
+// like parseRequestHeaders, leaving its body to be read again by the server.
+func peekRequestHeaders(r *http.Request) (reqs []rpcRequestHeader, isBatch bool, ok bool, err error) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestContentLength+1))
+	if err != nil {
+		return nil, false, false, err
+	}
+	if len(body) > maxRequestContentLength {
+		return nil, false, false, errRequestTooLarge
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	reqs, isBatch, ok = parseRequestHeaders(body)
+	return reqs, isBatch, ok, nil
+}
+
+// rejectUnreadableRequest answers a request whose body could not be peeked at.
+func rejectUnreadableRequest(w http.ResponseWriter, err error) {
+	status := http.StatusBadRequest
+	if errors.Is(err, errRequestTooLarge) {
+		status = http.StatusRequestEntityTooLarge
+	}
+	http.Error(w, err.Error(), status)
+}
+
+func writeRPCErrorResponse(w http.ResponseWriter, resp *rpcErrorResponse) {
+	w.Header().Set("content-type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// bufferedResponseWriter holds back a response until its size has been checked.
+type bufferedResponseWriter struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (w *bufferedResponseWriter) Header() http.Header { return w.header }
+
+func (w *bufferedResponseWriter) WriteHeader(status int) { w.status = status }
+
+func (w *bufferedResponseWriter) Write(b []byte) (int, error) { return w.body.Write(b) }
@@ -0,0 +1,135 @@
+package evmrpc_test
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/gorilla/websocket"
+	"github.com/kiichain/kiichain3/evmrpc"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func startLimitedServer(t *testing.T, config evmrpc.Config) string {
+	limiter, err := evmrpc.NewRPCLimiter(config, evmrpc.ConnectionTypeHTTP)
+	require.Nil(t, err)
+	srv := createAndStartServer(t, &evmrpc.HTTPConfig{
+		Modules:           []string{"test", "rpc"},
+		RPCEndpointConfig: evmrpc.RPCEndpointConfig{Limiter: limiter},
+	}, false, &evmrpc.WsConfig{}, nil)
+	t.Cleanup(srv.Stop)
+	return fmt.Sprintf("http://%v", srv.ListenAddr())
+}
+
+func readBody(t *testing.T, body io.Reader) string {
+	bz, err := io.ReadAll(body)
+	require.Nil(t, err)
+	return strings.TrimSpace(string(bz))
+}
+
+func TestRateLimit(t *testing.T) {
+	url := startLimitedServer(t, evmrpc.Config{RateLimits: []string{"test=0.001:2"}})
+	require.Equal(t, `{"jsonrpc":"2.0","id":1,"result":"Hello"}`, readBody(t, rpcRequest(t, url, "test_greet").Body))
+	require.Equal(t, `{"jsonrpc":"2.0","id":1,"result":"Hello"}`, readBody(t, rpcRequest(t, url, "test_greet").Body))
+	require.Equal(t, `{"jsonrpc":"2.0","id":1,"error":{"code":-32005,"message":"rate limit exceeded for test_greet"}}`, readBody(t, rpcRequest(t, url, "test_greet").Body))
+	// other namespaces have their own budget
+	require.NotContains(t, readBody(t, rpcRequest(t, url, "rpc_modules").Body), "error")
+
+	// the most specific rule applies
+	url = startLimitedServer(t, evmrpc.Config{RateLimits: []string{"*=0.001:1", "test_greet=1000"}})
+	for i := 0; i < 5; i++ {
+		require.NotContains(t, readBody(t, rpcRequest(t, url, "test_greet").Body), "error")
+	}
+	require.NotContains(t, readBody(t, rpcRequest(t, url, "rpc_modules").Body), "error")
+	require.Contains(t, readBody(t, rpcRequest(t, url, "rpc_modules").Body), "-32005")
+	// calls that cannot be counted are not served
+	body := `[{"jsonrpc":"2.0","id":1,"method":"rpc_modules","params":[]}, 1]`
+	require.Equal(t, `{"jsonrpc":"2.0","id":null,"error":{"code":-32600,"message":"invalid request"}}`, readBody(t, baseRpcRequest(t, url, body).Body))
+
+	_, err := evmrpc.NewRPCLimiter(evmrpc.Config{RateLimits: []string{"test"}}, evmrpc.ConnectionTypeHTTP)
+	require.NotNil(t, err)
+	_, err = evmrpc.NewRPCLimiter(evmrpc.Config{RateLimits: []string{"test=fast"}}, evmrpc.ConnectionTypeHTTP)
+	require.NotNil(t, err)
+	_, err = evmrpc.NewRPCLimiter(evmrpc.Config{RateLimits: []string{"test=1:0"}}, evmrpc.ConnectionTypeHTTP)
+	require.NotNil(t, err)
+}
+
+func TestBatchSizeLimit(t *testing.T) {
+	url := startLimitedServer(t, evmrpc.Config{MaxBatchSize: 2})
+	require.NotContains(t, readBody(t, batchRpcRequest(t, url, []string{"test_greet", "test_greet"}).Body), "error")
+	require.Equal(t, `{"jsonrpc":"2.0","id":null,"error":{"code":-32005,"message":"batch of 3 requests exceeds limit of 2"}}`, readBody(t, batchRpcRequest(t, url, []string{"test_greet", "test_greet", "test_greet"}).Body))
+}
+
+func TestResponseSizeLimit(t *testing.T) {
+	url := startLimitedServer(t, evmrpc.Config{MaxResponseBytes: 50})
+	require.Equal(t, `{"jsonrpc":"2.0","id":1,"result":"Hello"}`, readBody(t, rpcRequest(t, url, "test_greet").Body))
+	require.Contains(t, readBody(t, rpcRequest(t, url, "rpc_modules").Body), `"error":{"code":-32005,"message":"response of`)
+}
+
+func TestConcurrentHeavyCallLimit(t *testing.T) {
+	url := startLimitedServer(t, evmrpc.Config{MaxConcurrentHeavyCalls: 1, HeavyMethods: []string{"test_sl*"}})
+	wg := sync.WaitGroup{}
+	results := make([]string, 2)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = readBody(t, rpcRequest(t, url, "test_sleep").Body)
+		}(i)
+	}
+	wg.Wait()
+	require.ElementsMatch(t, []string{
+		`{"jsonrpc":"2.0","id":1,"result":null}`,
+		`{"jsonrpc":"2.0","id":1,"error":{"code":-32005,"message":"too many concurrent heavy calls, please retry later"}}`,
+	}, results)
+	// the slot is released once the call returns
+	require.Equal(t, `{"jsonrpc":"2.0","id":1,"result":null}`, readBody(t, rpcRequest(t, url, "test_sleep").Body))
+}
+
+func TestWsLimits(t *testing.T) {
+	limiter, err := evmrpc.NewRPCLimiter(evmrpc.Config{RateLimits: []string{"test_greet=0.001:1"}, MaxConcurrentHeavyCalls: 1, HeavyMethods: []string{"test_sleep"}}, evmrpc.ConnectionTypeWS)
+	require.Nil(t, err)
+	srv := evmrpc.NewHTTPServer(log.NewNopLogger(), rpc.DefaultHTTPTimeouts)
+	require.Nil(t, srv.EnableWS(apis(), evmrpc.WsConfig{Origins: []string{"*"}, RPCEndpointConfig: evmrpc.RPCEndpointConfig{Limiter: limiter}}))
+	require.Nil(t, srv.SetListenAddr("localhost", 0))
+	require.Nil(t, srv.Start())
+	defer srv.Stop()
+
+	conn, _, err := websocket.DefaultDialer.Dial(fmt.Sprintf("ws://%v", srv.ListenAddr()), nil)
+	require.Nil(t, err)
+	defer conn.Close()
+	send := func(id int, method string) {
+		require.Nil(t, conn.WriteMessage(websocket.TextMessage, []byte(fmt.Sprintf(`{"jsonrpc":"2.0","id":%d,"method":"%s","params":[]}`, id, method))))
+	}
+	read := func() string {
+		_, buf, err := conn.ReadMessage()
+		require.Nil(t, err)
+		return strings.TrimSpace(string(buf))
+	}
+
+	send(1, "test_greet")
+	require.Equal(t, `{"jsonrpc":"2.0","id":1,"result":"Hello"}`, read())
+	send(2, "test_greet")
+	require.Equal(t, `{"jsonrpc":"2.0","id":2,"error":{"code":-32005,"message":"rate limit exceeded for test_greet"}}`, read())
+
+	send(3, "test_sleep")
+	send(4, "test_sleep")
+	require.Equal(t, `{"jsonrpc":"2.0","id":4,"error":{"code":-32005,"message":"too many concurrent heavy calls, please retry later"}}`, read())
+	require.Equal(t, `{"jsonrpc":"2.0","id":3,"result":null}`, read())
+	send(5, "test_sleep")
+	require.Equal(t, `{"jsonrpc":"2.0","id":5,"result":null}`, read())
+
+	// ids of pending heavy calls cannot be reused, so their slots are always released
+	send(6, "test_sleep")
+	send(6, "test_greet")
+	require.Equal(t, `{"jsonrpc":"2.0","id":6,"error":{"code":-32600,"message":"id of a pending request reused"}}`, read())
+	require.Equal(t, `{"jsonrpc":"2.0","id":6,"result":null}`, read())
+	send(6, "test_sleep")
+	require.Equal(t, `{"jsonrpc":"2.0","id":6,"result":null}`, read())
+	require.Nil(t, conn.WriteMessage(websocket.TextMessage, []byte(`[{"jsonrpc":"2.0","id":7,"method":"test_sleep","params":[]}, 1]`)))
+	require.Equal(t, `{"jsonrpc":"2.0","id":null,"error":{"code":-32600,"message":"invalid request"}}`, read())
+}
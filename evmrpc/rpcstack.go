@@ -48,14 +48,17 @@ type HTTPConfig struct {
 
 // WsConfig is the JSON-RPC/Websocket configuration
 type WsConfig struct {
-	Origins []string
-	Modules []string
-	prefix  string // path prefix on which to mount ws handler
+	Origins  []string
+	Modules  []string
+	DenyList []string
+	prefix   string // path prefix on which to mount ws handler
 	RPCEndpointConfig
 }
 
 type RPCEndpointConfig struct {
-	JwtSecret              []byte // optional JWT secret
+	JwtSecret              []byte        // optional JWT secret
+	Limiter                *RPCLimiter   // optional request limits
+	Access                 *AccessPolicy // optional access control
 	batchItemLimit         int
 	batchResponseSizeLimit int
 }
@@ -231,6 +234,16 @@ func (h *HTTPServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotFound)
 }
 
+// RegisterHandler mounts a handler on a path of the server, next to the
+// JSON-RPC handler. Handlers must be registered before the server starts.
+func (h *HTTPServer) RegisterHandler(name string, path string, handler http.Handler) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.handlerNames[path] = name
+	h.mux.Handle(path, handler)
+}
+
 // CheckPath checks whether a given request URL matches a given path prefix.
 func CheckPath(r *http.Request, path string) bool {
 	// if no prefix has been specified, request URL must be on root
@@ -303,8 +316,16 @@ func (h *HTTPServer) EnableRPC(apis []rpc.API, config HTTPConfig) error {
 		srv.RegisterDenyList(method)
 	}
 	h.HTTPConfig = config
+	var handler http.Handler = srv
+	if config.Limiter != nil {
+		handler = newLimitHandler(config.Limiter, srv)
+	}
+	if config.Access != nil {
+		// denied calls don't count against the limits
+		handler = newAccessHandler(config.Access, handler)
+	}
 	h.httpHandler.Store(&rpcHandler{
-		Handler: NewHTTPHandlerStack(srv, config.CorsAllowedOrigins, config.Vhosts, config.JwtSecret),
+		Handler: NewHTTPHandlerStack(handler, config.CorsAllowedOrigins, config.Vhosts, config.JwtSecret),
 		server:  srv,
 	})
 	return nil
@@ -336,8 +357,12 @@ func (h *HTTPServer) EnableWS(apis []rpc.API, config WsConfig) error {
 		return err
 	}
 	h.WsConfig = config
+	filters := []wsRequestFilter{denyListFilter(config.DenyList)}
+	if config.Access != nil {
+		filters = append(filters, config.Access.check)
+	}
 	h.wsHandler.Store(&rpcHandler{
-		Handler: NewWSHandlerStack(srv.WebsocketHandler(config.Origins), config.JwtSecret),
+		Handler: NewWSHandlerStack(newWSHandler(srv, config.Origins, config.Limiter, filters...), config.JwtSecret),
 		server:  srv,
 	})
 	return nil
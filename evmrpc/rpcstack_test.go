@@ -624,3 +624,30 @@ func TestHttpDenyList(t *testing.T) {
 		}
 	})
 }
+
+func TestWsDenyList(t *testing.T) {
+	const (
+		expectRes = `{"jsonrpc":"2.0","id":1,"error":{"code":-32601,"message":"the method test_sleep does not exist/is not available"}}`
+	)
+	srv := evmrpc.NewHTTPServer(log.NewNopLogger(), rpc.DefaultHTTPTimeouts)
+	assert.NoError(t, srv.EnableWS(apis(), evmrpc.WsConfig{
+		Origins:  []string{"*"},
+		DenyList: []string{"test_sleep"},
+	}))
+	assert.NoError(t, srv.SetListenAddr("localhost", 0))
+	assert.NoError(t, srv.Start())
+	defer srv.Stop()
+
+	conn, _, err := websocket.DefaultDialer.Dial(fmt.Sprintf("ws://%v", srv.ListenAddr()), nil)
+	assert.NoError(t, err)
+	defer conn.Close()
+	assert.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","id":1,"method":"test_sleep","params":[]}`)))
+	_, buf, err := conn.ReadMessage()
+	assert.NoError(t, err)
+	assert.Equal(t, expectRes, strings.TrimSpace(string(buf)))
+	// batches with calls that cannot be checked are rejected as a whole
+	assert.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(`[{"jsonrpc":"2.0","id":1,"method":"test_sleep","params":[]}, 1]`)))
+	_, buf, err = conn.ReadMessage()
+	assert.NoError(t, err)
+	assert.Equal(t, `{"jsonrpc":"2.0","id":null,"error":{"code":-32600,"message":"invalid request"}}`, strings.TrimSpace(string(buf)))
+}
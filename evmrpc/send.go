@@ -3,6 +3,7 @@ package evmrpc
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -17,6 +18,7 @@ import (
 	"github.com/kiichain/kiichain3/x/evm/types"
 	"github.com/kiichain/kiichain3/x/evm/types/ethtx"
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	tmtypes "github.com/tendermint/tendermint/types"
 )
 
 type SendAPI struct {
@@ -27,14 +29,16 @@ type SendAPI struct {
 	ctxProvider    func(int64) sdk.Context
 	homeDir        string
 	backend        *Backend
+	txAPI          *TransactionAPI
 	connectionType ConnectionType
 }
 
 type SendConfig struct {
-	slow bool
+	slow           bool
+	syncMaxTimeout time.Duration
 }
 
-func NewSendAPI(tmClient rpcclient.Client, txConfig client.TxConfig, sendConfig *SendConfig, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, homeDir string, simulateConfig *SimulateConfig, connectionType ConnectionType) *SendAPI {
+func NewSendAPI(tmClient rpcclient.Client, txConfig client.TxConfig, sendConfig *SendConfig, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, homeDir string, simulateConfig *SimulateConfig, cache *ResponseCache, connectionType ConnectionType) *SendAPI {
 	return &SendAPI{
 		tmClient:       tmClient,
 		txConfig:       txConfig,
@@ -43,6 +47,7 @@ func NewSendAPI(tmClient rpcclient.Client, txConfig client.TxConfig, sendConfig
 		ctxProvider:    ctxProvider,
 		homeDir:        homeDir,
 		backend:        NewBackend(ctxProvider, k, txConfig.TxDecoder(), tmClient, simulateConfig),
+		txAPI:          NewTransactionAPI(tmClient, k, ctxProvider, txConfig, homeDir, cache, nil, connectionType),
 		connectionType: connectionType,
 	}
 }
@@ -50,27 +55,10 @@ func NewSendAPI(tmClient rpcclient.Client, txConfig client.TxConfig, sendConfig
 func (s *SendAPI) SendRawTransaction(ctx context.Context, input hexutil.Bytes) (hash common.Hash, err error) {
 	startTime := time.Now()
 	defer recordMetrics("eth_sendRawTransaction", s.connectionType, startTime, err == nil)
-	tx := new(ethtypes.Transaction)
-	if err = tx.UnmarshalBinary(input); err != nil {
-		return
-	}
-	hash = tx.Hash()
-	txData, err := ethtx.NewTxDataFromTx(tx)
-	if err != nil {
-		return
-	}
-	msg, err := types.NewMsgEVMTransaction(txData)
+	hash, txbz, err := s.encodeRawTransaction(input)
 	if err != nil {
 		return
 	}
-	txBuilder := s.txConfig.NewTxBuilder()
-	if err = txBuilder.SetMsgs(msg); err != nil {
-		return
-	}
-	txbz, encodeErr := s.txConfig.TxEncoder()(txBuilder.GetTx())
-	if encodeErr != nil {
-		return hash, encodeErr
-	}
 
 	if s.sendConfig.slow {
 		res, broadcastError := s.tmClient.BroadcastTxCommit(ctx, txbz)
@@ -82,18 +70,131 @@ func (s *SendAPI) SendRawTransaction(ctx context.Context, input hexutil.Bytes) (
 			err = sdkerrors.ABCIError(sdkerrors.RootCodespace, res.CheckTx.Code, "")
 		}
 	} else {
-		res, broadcastError := s.tmClient.BroadcastTx(ctx, txbz)
-		if broadcastError != nil {
-			err = broadcastError
-		} else if res == nil {
-			err = errors.New("missing broadcast response")
-		} else if res.Code != 0 {
-			err = sdkerrors.ABCIError(sdkerrors.RootCodespace, res.Code, "")
-		}
+		err = s.broadcastTx(ctx, txbz)
 	}
 	return
 }
 
+// SendRawTransactionSync sends a raw transaction and waits for its receipt, as
+// proposed by EIP-7966. The optional timeout is in milliseconds and is capped
+// by the configured max timeout.
+func (s *SendAPI) SendRawTransactionSync(ctx context.Context, input hexutil.Bytes, timeoutMs *hexutil.Uint64) (result map[string]interface{}, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("eth_sendRawTransactionSync", s.connectionType, startTime, returnErr == nil)
+	hash, txbz, err := s.encodeRawTransaction(input)
+	if err != nil {
+		return nil, err
+	}
+	timeout := s.sendConfig.syncMaxTimeout
+	if timeoutMs != nil && time.Duration(*timeoutMs)*time.Millisecond < timeout {
+		timeout = time.Duration(*timeoutMs) * time.Millisecond
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// subscribe before broadcasting so that the inclusion can't be missed
+	subscriber := fmt.Sprintf("%ssync.%s", SubscriberPrefix, hash.Hex())
+	query := NewTxQueryBuilder(fmt.Sprintf("%X", tmtypes.Tx(txbz).Hash())).Build()
+	// ignore deprecation here since the new endpoint does not support polling
+	//nolint:staticcheck
+	included, err := s.tmClient.Subscribe(ctx, subscriber, query)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		//nolint:staticcheck
+		_ = s.tmClient.Unsubscribe(context.Background(), subscriber, query)
+	}()
+	if err := s.broadcastTx(ctx, txbz); err != nil {
+		return nil, err
+	}
+
+	select {
+	case <-included:
+	case <-ctx.Done():
+		return nil, &SendTxTimeoutError{hash: hash, timeout: timeout}
+	}
+	// the receipt may be flushed shortly after the tx event
+	ticker := time.NewTicker(sendSyncReceiptPollInterval)
+	defer ticker.Stop()
+	for {
+		receipt, err := s.txAPI.GetTransactionReceipt(ctx, hash)
+		if err != nil {
+			return nil, err
+		}
+		if receipt != nil {
+			return receipt, nil
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil, &SendTxTimeoutError{hash: hash, timeout: timeout}
+		}
+	}
+}
+
+// encodeRawTransaction wraps a raw EVM transaction into a Cosmos transaction.
+func (s *SendAPI) encodeRawTransaction(input hexutil.Bytes) (common.Hash, []byte, error) {
+	tx := new(ethtypes.Transaction)
+	if err := tx.UnmarshalBinary(input); err != nil {
+		return common.Hash{}, nil, err
+	}
+	hash := tx.Hash()
+	txData, err := ethtx.NewTxDataFromTx(tx)
+	if err != nil {
+		return hash, nil, err
+	}
+	msg, err := types.NewMsgEVMTransaction(txData)
+	if err != nil {
+		return hash, nil, err
+	}
+	txBuilder := s.txConfig.NewTxBuilder()
+	if err := txBuilder.SetMsgs(msg); err != nil {
+		return hash, nil, err
+	}
+	txbz, err := s.txConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return hash, nil, err
+	}
+	return hash, txbz, nil
+}
+
+func (s *SendAPI) broadcastTx(ctx context.Context, txbz []byte) error {
+	res, err := s.tmClient.BroadcastTx(ctx, txbz)
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return errors.New("missing broadcast response")
+	}
+	if res.Code != 0 {
+		return sdkerrors.ABCIError(sdkerrors.RootCodespace, res.Code, "")
+	}
+	return nil
+}
+
+// SendTxTimeoutErrorCode is the JSON-RPC error code for a transaction that
+// wasn't included in time, as defined in EIP-7966.
+const SendTxTimeoutErrorCode = 4
+
+const sendSyncReceiptPollInterval = 100 * time.Millisecond
+
+// SendTxTimeoutError is returned when a transaction sent and awaited isn't
+// included in time. Its data is the hash of the transaction, which may still
+// be included later.
+type SendTxTimeoutError struct {
+	hash    common.Hash
+	timeout time.Duration
+}
+
+func (e *SendTxTimeoutError) Error() string {
+	return fmt.Sprintf("transaction %s was added to the mempool but wasn't included within %s", e.hash.Hex(), e.timeout)
+}
+
+func (e *SendTxTimeoutError) ErrorCode() int { return SendTxTimeoutErrorCode }
+
+func (e *SendTxTimeoutError) ErrorData() interface{} { return e.hash.Hex() }
+
 func (s *SendAPI) SignTransaction(_ context.Context, args apitypes.SendTxArgs, _ *string) (result *ethapi.SignTransactionResult, returnErr error) {
 	startTime := time.Now()
 	defer recordMetrics("eth_signTransaction", s.connectionType, startTime, returnErr == nil)
@@ -10,6 +10,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/kiichain/kiichain3/evmrpc"
 	"github.com/kiichain/kiichain3/x/evm/types"
 	"github.com/stretchr/testify/require"
 )
@@ -63,3 +64,31 @@ func TestSendRawTransaction(t *testing.T) {
 	errMap = resObj["error"].(map[string]interface{})
 	require.Equal(t, ": invalid sequence", errMap["message"].(string))
 }
+
+func TestSendRawTransactionSync(t *testing.T) {
+	// included, with a receipt
+	bz, err := tx1.MarshalBinary()
+	require.Nil(t, err)
+	resObj := sendRequestGood(t, "sendRawTransactionSync", "0x"+hex.EncodeToString(bz))
+	receipt := resObj["result"].(map[string]interface{})
+	require.Equal(t, tx1.Hash().Hex(), receipt["transactionHash"])
+	require.Equal(t, "0x8", receipt["blockNumber"])
+
+	// never gets a receipt
+	to := common.HexToAddress("010203")
+	_, tx := buildTx(ethtypes.DynamicFeeTx{
+		Nonce:     100,
+		GasFeeCap: big.NewInt(10),
+		Gas:       1000,
+		To:        &to,
+		Value:     big.NewInt(1000),
+		ChainID:   EVMKeeper.ChainID(Ctx),
+	})
+	bz, err = tx.MarshalBinary()
+	require.Nil(t, err)
+	resObj = sendRequestGood(t, "sendRawTransactionSync", "0x"+hex.EncodeToString(bz), "0xc8")
+	errMap := resObj["error"].(map[string]interface{})
+	require.Equal(t, float64(evmrpc.SendTxTimeoutErrorCode), errMap["code"])
+	require.Equal(t, tx.Hash().Hex(), errMap["data"])
+	require.Contains(t, errMap["message"], tx.Hash().Hex())
+}
@@ -1,12 +1,12 @@
 package evmrpc
 
 import (
+	"math/big"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/rpc"
-	evmCfg "github.com/kiichain/kiichain3/x/evm/config"
 	"github.com/kiichain/kiichain3/x/evm/keeper"
 	"github.com/tendermint/tendermint/libs/log"
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
@@ -16,6 +16,7 @@ type ConnectionType string
 
 var ConnectionTypeWS ConnectionType = "websocket"
 var ConnectionTypeHTTP ConnectionType = "http"
+var ConnectionTypeGraphQL ConnectionType = "graphql"
 
 const LocalAddress = "0.0.0.0"
 
@@ -41,92 +42,34 @@ func NewEVMHTTPServer(
 	if err := httpServer.SetListenAddr(LocalAddress, config.HTTPPort); err != nil {
 		return nil, err
 	}
-	simulateConfig := &SimulateConfig{GasCap: config.SimulationGasLimit, EVMTimeout: config.SimulationEVMTimeout}
-	sendAPI := NewSendAPI(tmClient, txConfig, &SendConfig{slow: config.Slow}, k, ctxProvider, homeDir, simulateConfig, ConnectionTypeHTTP)
-	ctx := ctxProvider(LatestCtxHeight)
-
-	txAPI := NewTransactionAPI(tmClient, k, ctxProvider, txConfig, homeDir, ConnectionTypeHTTP)
-	// filterAPI := NewFilterAPI(tmClient, &LogFetcher{tmClient: tmClient, k: k, ctxProvider: ctxProvider}, &FilterConfig{timeout: config.FilterTimeout, maxLog: config.MaxLogNoBlock, maxBlock: config.MaxBlocksForLog}, ConnectionTypeHTTP)
-
-	apis := []rpc.API{
-		{
-			Namespace: "echo",
-			Service:   NewEchoAPI(),
-		},
-		{
-			Namespace: "eth",
-			Service:   NewBlockAPI(tmClient, k, ctxProvider, txConfig, ConnectionTypeHTTP, "eth"),
-		},
-		{
-			Namespace: "kii",
-			Service:   NewBlockAPI(tmClient, k, ctxProvider, txConfig, ConnectionTypeHTTP, "kii"),
-		},
-		{
-			Namespace: "eth",
-			Service:   txAPI,
-		},
-		{
-			Namespace: "eth",
-			Service:   NewStateAPI(tmClient, k, ctxProvider, ConnectionTypeHTTP),
-		},
-		{
-			Namespace: "eth",
-			Service:   NewInfoAPI(tmClient, k, ctxProvider, txConfig.TxDecoder(), homeDir, config.MaxBlocksForLog, ConnectionTypeHTTP),
-		},
-		{
-			Namespace: "eth",
-			Service:   sendAPI,
-		},
-		{
-			Namespace: "eth",
-			Service:   NewSimulationAPI(ctxProvider, k, txConfig.TxDecoder(), tmClient, simulateConfig, ConnectionTypeHTTP),
-		},
-		{
-			Namespace: "net",
-			Service:   NewNetAPI(tmClient, k, ctxProvider, txConfig.TxDecoder(), ConnectionTypeHTTP),
-		},
-		{
-			Namespace: "eth",
-			Service:   NewFilterAPI(tmClient, k, ctxProvider, &FilterConfig{timeout: config.FilterTimeout, maxLog: config.MaxLogNoBlock, maxBlock: config.MaxBlocksForLog}, ConnectionTypeHTTP, "eth"),
-		},
-		{
-			Namespace: "kii",
-			Service:   NewFilterAPI(tmClient, k, ctxProvider, &FilterConfig{timeout: config.FilterTimeout, maxLog: config.MaxLogNoBlock, maxBlock: config.MaxBlocksForLog}, ConnectionTypeHTTP, "kii"),
-		},
-		{
-			Namespace: "kii",
-			Service:   NewAssociationAPI(tmClient, k, ctxProvider, txConfig.TxDecoder(), sendAPI, ConnectionTypeHTTP),
-		},
-		{
-			Namespace: "txpool",
-			Service:   NewTxPoolAPI(tmClient, k, ctxProvider, txConfig.TxDecoder(), &TxPoolConfig{maxNumTxs: int(config.MaxTxPoolTxs)}, ConnectionTypeHTTP),
-		},
-		{
-			Namespace: "web3",
-			Service:   &Web3API{},
-		},
-		{
-			Namespace: "debug",
-			Service:   NewDebugAPI(tmClient, k, ctxProvider, txConfig.TxDecoder(), simulateConfig, ConnectionTypeHTTP),
-		},
+	limiter, err := NewRPCLimiter(config, ConnectionTypeHTTP)
+	if err != nil {
+		return nil, err
 	}
-	// Test API can only exist on non-live chain IDs.  These APIs instrument certain overrides.
-	if config.EnableTestAPI && !evmCfg.IsLiveChainID(ctx) {
-		logger.Info("Enabling Test EVM APIs")
-		apis = append(apis, rpc.API{
-			Namespace: "test",
-			Service:   NewTestAPI(),
-		})
-	} else {
-		logger.Info("Disabling Test EVM APIs", "liveChainID", evmCfg.IsLiveChainID(ctx), "enableTestAPI", config.EnableTestAPI)
+	access, err := NewAccessPolicy(config, ConnectionTypeHTTP)
+	if err != nil {
+		return nil, err
 	}
-
+	archive, err := NewArchiveFallback(config, ConnectionTypeHTTP)
+	if err != nil {
+		return nil, err
+	}
+	apis := buildAPIs(logger, config, tmClient, k, ctxProvider, txConfig, homeDir, archive, ConnectionTypeHTTP)
 	if err := httpServer.EnableRPC(apis, HTTPConfig{
 		CorsAllowedOrigins: strings.Split(config.CORSOrigins, ","),
 		Vhosts:             []string{"*"},
+		DenyList:           config.DenyList,
+		RPCEndpointConfig:  RPCEndpointConfig{Limiter: limiter, Access: access},
 	}); err != nil {
 		return nil, err
 	}
+	if config.EnableGraphQL {
+		handler, err := NewGraphQLHandler(logger, config, tmClient, k, ctxProvider, txConfig, homeDir, archive, limiter, access)
+		if err != nil {
+			return nil, err
+		}
+		httpServer.RegisterHandler("GraphQL", "/graphql", NewHTTPHandlerStack(handler, strings.Split(config.CORSOrigins, ","), []string{"*"}, nil))
+	}
 	return httpServer, nil
 }
 
@@ -148,7 +91,51 @@ func NewEVMWebSocketServer(
 	if err := httpServer.SetListenAddr(LocalAddress, config.WSPort); err != nil {
 		return nil, err
 	}
-	simulateConfig := &SimulateConfig{GasCap: config.SimulationGasLimit, EVMTimeout: config.SimulationEVMTimeout}
+	limiter, err := NewRPCLimiter(config, ConnectionTypeWS)
+	if err != nil {
+		return nil, err
+	}
+	access, err := NewAccessPolicy(config, ConnectionTypeWS)
+	if err != nil {
+		return nil, err
+	}
+	archive, err := NewArchiveFallback(config, ConnectionTypeWS)
+	if err != nil {
+		return nil, err
+	}
+	apis := buildAPIs(logger, config, tmClient, k, ctxProvider, txConfig, homeDir, archive, ConnectionTypeWS)
+	if err := httpServer.EnableWS(apis, WsConfig{
+		Origins:           strings.Split(config.WSOrigins, ","),
+		DenyList:          config.DenyList,
+		RPCEndpointConfig: RPCEndpointConfig{Limiter: limiter, Access: access},
+	}); err != nil {
+		return nil, err
+	}
+	return httpServer, nil
+}
+
+// buildAPIs returns the registry of EVM RPC services shared by the HTTP and the
+// websocket servers. Subscriptions require a notifier and are therefore only
+// registered for websocket connections.
+func buildAPIs(
+	logger log.Logger,
+	config Config,
+	tmClient rpcclient.Client,
+	k *keeper.Keeper,
+	ctxProvider func(int64) sdk.Context,
+	txConfig client.TxConfig,
+	homeDir string,
+	archive *ArchiveFallback,
+	connectionType ConnectionType,
+) []rpc.API {
+	ctxProvider = NewPendingCtxProvider(logger, config, tmClient, k, ctxProvider, txConfig.TxDecoder())
+	simulateConfig := newSimulateConfig(config)
+	filterConfig := newFilterConfig(config)
+	cache := NewResponseCache(config.ResponseCacheSize)
+	gasPriceOracleConfig := newGasPriceOracleConfig(config)
+	sendAPI := NewSendAPI(tmClient, txConfig, &SendConfig{slow: config.Slow, syncMaxTimeout: config.SendRawTransactionSyncMaxTimeout}, k, ctxProvider, homeDir, simulateConfig, cache, connectionType)
+	ctx := ctxProvider(LatestCtxHeight)
+
 	apis := []rpc.API{
 		{
 			Namespace: "echo",
@@ -156,43 +143,117 @@ func NewEVMWebSocketServer(
 		},
 		{
 			Namespace: "eth",
-			Service:   NewBlockAPI(tmClient, k, ctxProvider, txConfig, ConnectionTypeWS, "eth"),
+			Service:   NewBlockAPI(tmClient, k, ctxProvider, txConfig, cache, connectionType, "eth"),
+		},
+		{
+			Namespace: "kii",
+			Service:   NewBlockAPI(tmClient, k, ctxProvider, txConfig, cache, connectionType, "kii"),
 		},
 		{
 			Namespace: "eth",
-			Service:   NewTransactionAPI(tmClient, k, ctxProvider, txConfig, homeDir, ConnectionTypeWS),
+			Service:   NewTransactionAPI(tmClient, k, ctxProvider, txConfig, homeDir, cache, archive, connectionType),
 		},
 		{
 			Namespace: "eth",
-			Service:   NewStateAPI(tmClient, k, ctxProvider, ConnectionTypeWS),
+			Service:   NewStateAPI(tmClient, k, ctxProvider, archive, connectionType),
 		},
 		{
 			Namespace: "eth",
-			Service:   NewInfoAPI(tmClient, k, ctxProvider, txConfig.TxDecoder(), homeDir, config.MaxBlocksForLog, ConnectionTypeWS),
+			Service:   NewInfoAPI(tmClient, k, ctxProvider, txConfig.TxDecoder(), homeDir, config.MaxBlocksForLog, gasPriceOracleConfig, connectionType),
 		},
 		{
 			Namespace: "eth",
-			Service:   NewSendAPI(tmClient, txConfig, &SendConfig{slow: config.Slow}, k, ctxProvider, homeDir, simulateConfig, ConnectionTypeWS),
+			Service:   sendAPI,
 		},
 		{
 			Namespace: "eth",
-			Service:   NewSimulationAPI(ctxProvider, k, txConfig.TxDecoder(), tmClient, simulateConfig, ConnectionTypeWS),
+			Service:   NewSimulationAPI(ctxProvider, k, txConfig.TxDecoder(), tmClient, simulateConfig, archive, connectionType),
 		},
 		{
 			Namespace: "net",
-			Service:   NewNetAPI(tmClient, k, ctxProvider, txConfig.TxDecoder(), ConnectionTypeWS),
+			Service:   NewNetAPI(tmClient, k, ctxProvider, txConfig.TxDecoder(), connectionType),
 		},
 		{
 			Namespace: "eth",
-			Service:   NewSubscriptionAPI(tmClient, &LogFetcher{tmClient: tmClient, k: k, ctxProvider: ctxProvider}, &SubscriptionConfig{subscriptionCapacity: 100, newHeadLimit: config.MaxSubscriptionsNewHead}, &FilterConfig{timeout: config.FilterTimeout, maxLog: config.MaxLogNoBlock, maxBlock: config.MaxBlocksForLog}, ConnectionTypeWS),
+			Service:   NewFilterAPI(tmClient, k, ctxProvider, filterConfig, cache, connectionType, "eth"),
+		},
+		{
+			Namespace: "kii",
+			Service:   NewFilterAPI(tmClient, k, ctxProvider, filterConfig, cache, connectionType, "kii"),
+		},
+		{
+			Namespace: "kii",
+			Service:   NewLogsPagedAPI(tmClient, k, ctxProvider, filterConfig, cache, connectionType),
+		},
+		{
+			Namespace: "kii",
+			Service:   NewAssociationAPI(tmClient, k, ctxProvider, txConfig.TxDecoder(), sendAPI, connectionType),
+		},
+		{
+			Namespace: "kii",
+			Service:   NewAddressTxsAPI(k, connectionType),
+		},
+		{
+			Namespace: "txpool",
+			Service:   NewTxPoolAPI(tmClient, k, ctxProvider, txConfig.TxDecoder(), &TxPoolConfig{maxNumTxs: int(config.MaxTxPoolTxs)}, connectionType),
 		},
 		{
 			Namespace: "web3",
 			Service:   &Web3API{},
 		},
+		{
+			Namespace: "debug",
+			Service:   NewDebugAPI(tmClient, k, ctxProvider, txConfig.TxDecoder(), simulateConfig, archive, connectionType),
+		},
+		{
+			Namespace: "trace",
+			Service:   NewTraceAPI(tmClient, k, ctxProvider, txConfig.TxDecoder(), simulateConfig, filterConfig, connectionType),
+		},
 	}
-	if err := httpServer.EnableWS(apis, WsConfig{Origins: strings.Split(config.WSOrigins, ",")}); err != nil {
-		return nil, err
+	if connectionType == ConnectionTypeWS {
+		apis = append(apis, rpc.API{
+			Namespace: "eth",
+			Service: NewSubscriptionAPI(tmClient, k, ctxProvider, txConfig.TxDecoder(), &LogFetcher{tmClient: tmClient, k: k, ctxProvider: ctxProvider, filterConfig: filterConfig, cache: cache}, &SubscriptionConfig{
+				subscriptionCapacity: 100,
+				newHeadLimit:         config.MaxSubscriptionsNewHead,
+				pendingTxLimit:       config.MaxSubscriptionsNewPendingTxs,
+				pendingTxPollLimit:   int(config.MaxTxPoolTxs),
+				syncingLimit:         config.MaxSubscriptionsSyncing,
+			}, filterConfig, connectionType),
+		})
+	}
+	// Test API can only exist on non-live chains.  These APIs instrument certain overrides.
+	if config.EnableTestAPI && !k.IsLiveChain(ctx) {
+		logger.Info("Enabling Test EVM APIs", "connectionType", connectionType)
+		apis = append(apis, rpc.API{
+			Namespace: "test",
+			Service:   NewTestAPI(),
+		})
+	} else {
+		logger.Info("Disabling Test EVM APIs", "connectionType", connectionType, "liveChain", k.IsLiveChain(ctx), "enableTestAPI", config.EnableTestAPI)
+	}
+	return apis
+}
+
+func newSimulateConfig(config Config) *SimulateConfig {
+	return &SimulateConfig{GasCap: config.SimulationGasLimit, EVMTimeout: config.SimulationEVMTimeout}
+}
+
+func newFilterConfig(config Config) *FilterConfig {
+	return &FilterConfig{
+		timeout:          config.FilterTimeout,
+		maxLog:           config.MaxLogNoBlock,
+		maxBlock:         config.MaxBlocksForLog,
+		bloomScanWorkers: config.BloomScanWorkers,
+		useBloomBits:     config.EnableBloomBitsIndex,
+	}
+}
+
+func newGasPriceOracleConfig(config Config) *GasPriceOracleConfig {
+	return &GasPriceOracleConfig{
+		Blocks:      config.GasPriceOracleBlocks,
+		Percentile:  config.GasPriceOraclePercentile,
+		IgnorePrice: new(big.Int).SetUint64(config.GasPriceOracleIgnorePrice),
+		MaxPrice:    new(big.Int).SetUint64(config.GasPriceOracleMaxPrice),
 	}
-	return httpServer, nil
 }
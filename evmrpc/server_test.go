@@ -0,0 +1,40 @@
+package evmrpc_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebSocketServesHTTPNamespaces(t *testing.T) {
+	headers := make(http.Header)
+	headers.Set("Origin", "localhost")
+	headers.Set("Content-Type", "application/json")
+	conn, _, err := websocket.DefaultDialer.Dial(fmt.Sprintf("ws://%s:%d", TestAddr, TestWSPort), headers)
+	require.Nil(t, err)
+	defer conn.Close()
+
+	for _, body := range []string{
+		`{"jsonrpc":"2.0","method":"eth_newFilter","params":[{}],"id":"test"}`,
+		`{"jsonrpc":"2.0","method":"kii_newFilter","params":[{}],"id":"test"}`,
+		`{"jsonrpc":"2.0","method":"kii_getBlockByHash","params":["0x0000000000000000000000000000000000000000000000000000000000000001",true],"id":"test"}`,
+		`{"jsonrpc":"2.0","method":"txpool_content","params":[],"id":"test"}`,
+		`{"jsonrpc":"2.0","method":"kii_getKiiAddress","params":["0x1df809C639027b465B931BD63Ce71c8E5834D9d6"],"id":"test"}`,
+	} {
+		require.Nil(t, conn.WriteMessage(websocket.TextMessage, []byte(body)))
+		_, buf, err := conn.ReadMessage()
+		require.Nil(t, err)
+		resObj := map[string]interface{}{}
+		require.Nil(t, json.Unmarshal(buf, &resObj))
+		if errObj, ok := resObj["error"]; ok {
+			// association lookups may legitimately fail, but the method must exist
+			require.NotEqual(t, float64(-32601), errObj.(map[string]interface{})["code"], body)
+			continue
+		}
+		require.NotNil(t, resObj["result"], body)
+	}
+}
@@ -311,6 +311,12 @@ func (c *MockClient) Subscribe(ctx context.Context, subscriber string, query str
 		return resCh, nil
 		// hardcoded test case for simplicity
 	}
+	if strings.HasPrefix(query, "tm.event = 'Tx'") {
+		// every tx is included right away
+		resCh := make(chan coretypes.ResultEvent, 1)
+		resCh <- coretypes.ResultEvent{SubscriptionID: subscriber, Query: query}
+		return resCh, nil
+	}
 	return nil, errors.New("unknown query")
 }
 
@@ -387,6 +393,17 @@ func (c *MockClient) UnconfirmedTxs(ctx context.Context, page, perPage *int) (*c
 	}, nil
 }
 
+func (c *MockClient) Status(context.Context) (*coretypes.ResultStatus, error) {
+	return &coretypes.ResultStatus{
+		SyncInfo: coretypes.SyncInfo{
+			LatestBlockHeight:   MockHeight,
+			EarliestBlockHeight: 1,
+			MaxPeerBlockHeight:  MockHeight,
+			CatchingUp:          false,
+		},
+	}, nil
+}
+
 type MockBadClient struct {
 	MockClient
 }
@@ -447,6 +464,7 @@ func init() {
 	goodConfig.WSPort = TestWSPort
 	goodConfig.FilterTimeout = 500 * time.Millisecond
 	goodConfig.MaxLogNoBlock = 4
+	goodConfig.EnableGraphQL = true
 	infoLog, err := log.NewDefaultLogger("text", "info")
 	if err != nil {
 		panic(err)
@@ -762,7 +780,10 @@ func setupLogs() {
 		BlockNumber:      DebugTraceMockHeight,
 		TransactionIndex: 0,
 		TxHashHex:        DebugTraceHashHex,
+		From:             "0x5B4eba929F3811980f5AE0c5D04fa200f837DF4E",
+		To:               "0x0000000000000000000000000000000000010203",
 	})
+	EVMKeeper.SetTxHashesOnHeight(Ctx, DebugTraceMockHeight, []common.Hash{common.HexToHash(DebugTraceHashHex)})
 	EVMKeeper.SetTxHashesOnHeight(Ctx, MultiTxBlockHeight, []common.Hash{
 		multiTxBlockTx1.Hash(),
 		multiTxBlockTx2.Hash(),
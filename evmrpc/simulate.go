@@ -41,6 +41,7 @@ const CtxIsWasmdPrecompileCallKey CtxIsWasmdPrecompileCallKeyType = "CtxIsWasmdP
 
 type SimulationAPI struct {
 	backend        *Backend
+	archive        *ArchiveFallback
 	connectionType ConnectionType
 }
 
@@ -50,10 +51,12 @@ func NewSimulationAPI(
 	txDecoder sdk.TxDecoder,
 	tmClient rpcclient.Client,
 	config *SimulateConfig,
+	archive *ArchiveFallback,
 	connectionType ConnectionType,
 ) *SimulationAPI {
 	return &SimulationAPI{
 		backend:        NewBackend(ctxProvider, keeper, txDecoder, tmClient, config),
+		archive:        archive,
 		connectionType: connectionType,
 	}
 }
@@ -92,6 +95,9 @@ func (s *SimulationAPI) EstimateGas(ctx context.Context, args ethapi.Transaction
 	}
 	ctx = context.WithValue(ctx, CtxIsWasmdPrecompileCallKey, wasmd.IsWasmdCall(args.To))
 	estimate, err := ethapi.DoEstimateGas(ctx, s.backend, args, bNrOrHash, overrides, s.backend.RPCGasCap())
+	if err != nil {
+		err = s.archive.forward(ctx, err, &estimate, "eth_estimateGas", args, bNrOrHash, overrides)
+	}
 	return estimate, err
 }
 
@@ -126,7 +132,8 @@ func (s *SimulationAPI) Call(ctx context.Context, args ethapi.TransactionArgs, b
 	ctx = context.WithValue(ctx, CtxIsWasmdPrecompileCallKey, wasmd.IsWasmdCall(args.To))
 	callResult, err := ethapi.DoCall(ctx, s.backend, args, *blockNrOrHash, overrides, blockOverrides, s.backend.RPCEVMTimeout(), s.backend.RPCGasCap())
 	if err != nil {
-		return nil, err
+		returnErr = s.archive.forward(ctx, err, &result, "eth_call", args, *blockNrOrHash, overrides, blockOverrides)
+		return
 	}
 	// If the result contains a revert reason, try to unpack and return it.
 	if len(callResult.Revert()) > 0 {
@@ -197,6 +204,10 @@ func (b *Backend) StateAndHeaderByNumberOrHash(ctx context.Context, blockNrOrHas
 		return nil, nil, err
 	}
 	isWasmdCall, ok := ctx.Value(CtxIsWasmdPrecompileCallKey).(bool)
+	if latestOrPendingHeight(blockNrOrHash) == PendingCtxHeight {
+		sdkCtx := b.ctxProvider(PendingCtxHeight).WithIsEVM(true).WithEVMEntryViaWasmdPrecompile(ok && isWasmdCall)
+		return state.NewDBImpl(sdkCtx, b.keeper, true), b.getHeader(big.NewInt(height)), nil
+	}
 	sdkCtx := b.ctxProvider(height).WithIsEVM(true).WithEVMEntryViaWasmdPrecompile(ok && isWasmdCall)
 	if err := CheckVersion(sdkCtx, b.keeper); err != nil {
 		return nil, nil, err
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/big"
 	"os"
+	"strings"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -203,3 +204,70 @@ func TestNewRevertError(t *testing.T) {
 	require.Equal(t, 3, err.ErrorCode())
 	require.Equal(t, "0x", err.ErrorData())
 }
+
+func TestSimulateV1(t *testing.T) {
+	Ctx = Ctx.WithBlockHeight(1)
+	defer func() { Ctx = Ctx.WithBlockHeight(8) }()
+
+	_, from := testkeeper.MockAddressPair()
+	_, to := testkeeper.MockAddressPair()
+	_, logger := testkeeper.MockAddressPair()
+	_, reverter := testkeeper.MockAddressPair()
+	opts := map[string]interface{}{
+		"traceTransfers": true,
+		"blockStateCalls": []interface{}{
+			map[string]interface{}{
+				"blockOverrides": map[string]interface{}{"time": "0x7fffffff", "baseFeePerGas": "0x0"},
+				"stateOverrides": map[string]interface{}{
+					from.Hex():     map[string]interface{}{"balance": "0xde0b6b3a7640000"},
+					logger.Hex():   map[string]interface{}{"code": "0x602a60005260206000a000"}, // LOG0 of 42
+					reverter.Hex(): map[string]interface{}{"code": "0x60006000fd"},             // REVERT
+				},
+				"calls": []interface{}{
+					map[string]interface{}{"from": from.Hex(), "to": to.Hex(), "value": "0x3e8"},
+					map[string]interface{}{"from": from.Hex(), "to": logger.Hex()},
+				},
+			},
+			map[string]interface{}{
+				"calls": []interface{}{
+					map[string]interface{}{"from": from.Hex(), "to": reverter.Hex()},
+				},
+			},
+		},
+	}
+	resObj := sendRequestGood(t, "simulateV1", opts, "latest")
+	blocks := resObj["result"].([]interface{})
+	require.Len(t, blocks, 2)
+
+	first := blocks[0].(map[string]interface{})
+	require.Equal(t, "0x7fffffff", first["timestamp"])
+	calls := first["calls"].([]interface{})
+	require.Len(t, calls, 2)
+	transfer := calls[0].(map[string]interface{})
+	require.Equal(t, "0x1", transfer["status"])
+	transferLog := transfer["logs"].([]interface{})[0].(map[string]interface{})
+	require.Equal(t, strings.ToLower(evmrpc.TransferLogAddress.Hex()), transferLog["address"])
+	logCall := calls[1].(map[string]interface{})
+	require.Equal(t, "0x1", logCall["status"])
+	emitted := logCall["logs"].([]interface{})
+	require.Len(t, emitted, 1)
+	require.Equal(t, strings.ToLower(logger.Hex()), emitted[0].(map[string]interface{})["address"])
+	require.Equal(t, "0x1", emitted[0].(map[string]interface{})["logIndex"])
+	require.Equal(t, first["hash"], emitted[0].(map[string]interface{})["blockHash"])
+
+	// the second block follows the first and sees its state
+	second := blocks[1].(map[string]interface{})
+	require.Equal(t, "0x80000000", second["timestamp"])
+	require.Equal(t, first["hash"], second["parentHash"])
+	reverted := second["calls"].([]interface{})[0].(map[string]interface{})
+	require.Equal(t, "0x0", reverted["status"])
+	require.Equal(t, float64(3), reverted["error"].(map[string]interface{})["code"])
+
+	// timestamps must increase
+	opts["blockStateCalls"] = []interface{}{
+		map[string]interface{}{"blockOverrides": map[string]interface{}{"time": "0x7fffffff"}},
+		map[string]interface{}{"blockOverrides": map[string]interface{}{"time": "0x7ffffffe"}},
+	}
+	resObj = sendRequestGood(t, "simulateV1", opts, "latest")
+	require.Contains(t, resObj["error"].(map[string]interface{})["message"], "timestamps must be increasing")
+}
@@ -0,0 +1,385 @@
+package evmrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/lib/ethapi"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/kiichain/kiichain3/precompiles/wasmd"
+	"github.com/kiichain/kiichain3/x/evm/state"
+)
+
+const (
+	// MaxSimulateBlocks is the maximum number of blocks of one eth_simulateV1 call.
+	MaxSimulateBlocks = 256
+
+	// error code of calls that ran out of gas, hit an invalid opcode etc.
+	simulateVMErrorCode = -32015
+	// error code of reverted calls, as in eth_call
+	simulateRevertErrorCode = 3
+)
+
+// TransferLogAddress is the address of the logs recording native transfers when
+// traceTransfers is set, as in go-ethereum.
+var TransferLogAddress = common.HexToAddress("0xEeeeeEeeeEeEeeEeEeEeeEEEeeeeEeeeeeeeEEeE")
+
+var transferTopic = common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+
+type SimulateOpts struct {
+	BlockStateCalls        []SimulateBlock `json:"blockStateCalls"`
+	TraceTransfers         bool            `json:"traceTransfers"`
+	Validation             bool            `json:"validation"`
+	ReturnFullTransactions bool            `json:"returnFullTransactions"`
+}
+
+type SimulateBlock struct {
+	BlockOverrides *SimulateBlockOverrides  `json:"blockOverrides"`
+	StateOverrides *ethapi.StateOverride    `json:"stateOverrides"`
+	Calls          []ethapi.TransactionArgs `json:"calls"`
+}
+
+type SimulateBlockOverrides struct {
+	Number        *hexutil.Big    `json:"number"`
+	Time          *hexutil.Uint64 `json:"time"`
+	GasLimit      *hexutil.Uint64 `json:"gasLimit"`
+	FeeRecipient  *common.Address `json:"feeRecipient"`
+	PrevRandao    *common.Hash    `json:"prevRandao"`
+	BaseFeePerGas *hexutil.Big    `json:"baseFeePerGas"`
+}
+
+type SimulateBlockResult struct {
+	Number        hexutil.Uint64        `json:"number"`
+	Hash          common.Hash           `json:"hash"`
+	ParentHash    common.Hash           `json:"parentHash"`
+	Timestamp     hexutil.Uint64        `json:"timestamp"`
+	GasLimit      hexutil.Uint64        `json:"gasLimit"`
+	GasUsed       hexutil.Uint64        `json:"gasUsed"`
+	Miner         common.Address        `json:"miner"`
+	BaseFeePerGas *hexutil.Big          `json:"baseFeePerGas"`
+	LogsBloom     ethtypes.Bloom        `json:"logsBloom"`
+	Transactions  []interface{}         `json:"transactions"`
+	Calls         []*SimulateCallResult `json:"calls"`
+}
+
+type SimulateCallResult struct {
+	ReturnData hexutil.Bytes      `json:"returnData"`
+	Logs       []*ethtypes.Log    `json:"logs"`
+	GasUsed    hexutil.Uint64     `json:"gasUsed"`
+	Status     hexutil.Uint64     `json:"status"`
+	Error      *SimulateCallError `json:"error,omitempty"`
+}
+
+type SimulateCallError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data,omitempty"`
+}
+
+// SimulateV1 runs the calls of a sequence of simulated blocks on top of the
+// given block, each block seeing the state left by the previous ones. The
+// simulation as a whole is bounded by the simulation gas limit and EVM timeout
+// of the node. Logs include those of native transfers if traceTransfers is set
+// and the logs of pointer contracts for the wasm events emitted by precompiles.
+func (s *SimulationAPI) SimulateV1(ctx context.Context, opts SimulateOpts, blockNrOrHash *rpc.BlockNumberOrHash) (result []*SimulateBlockResult, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("eth_simulateV1", s.connectionType, startTime, returnErr == nil)
+	defer func() {
+		if r := recover(); r != nil {
+			if strings.Contains(fmt.Sprintf("%s", r), "Int overflow") {
+				returnErr = errors.New("error: balance override overflow")
+			} else {
+				returnErr = fmt.Errorf("something went wrong: %v", r)
+			}
+		}
+	}()
+	if len(opts.BlockStateCalls) == 0 {
+		return nil, errors.New("empty input")
+	}
+	if len(opts.BlockStateCalls) > MaxSimulateBlocks {
+		return nil, fmt.Errorf("too many blocks, the maximum is %d", MaxSimulateBlocks)
+	}
+	bNrOrHash := rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber)
+	if blockNrOrHash != nil {
+		bNrOrHash = *blockNrOrHash
+	}
+	if timeout := s.backend.RPCEVMTimeout(); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	statedb, header, err := s.backend.StateAndHeaderByNumberOrHash(ctx, bNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	db := statedb.(*state.DBImpl)
+	sim := &simulator{
+		backend:      s.backend,
+		db:           db,
+		opts:         opts,
+		gasRemaining: s.backend.RPCGasCap(),
+	}
+	parent := header
+	if height := header.Number.Int64(); height > 0 {
+		if block, err := blockByNumber(ctx, s.backend.tmClient, &height); err == nil {
+			parent = ethtypes.CopyHeader(header)
+			parent.ParentHash = common.BytesToHash(block.Block.Header.LastBlockID.Hash)
+			sim.baseHash = common.BytesToHash(block.BlockID.Hash)
+		}
+	}
+	result = make([]*SimulateBlockResult, 0, len(opts.BlockStateCalls))
+	for i, block := range opts.BlockStateCalls {
+		res, next, err := sim.processBlock(ctx, i, &block, parent)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, res)
+		parent = next
+	}
+	return result, nil
+}
+
+type simulator struct {
+	backend      *Backend
+	db           *state.DBImpl
+	opts         SimulateOpts
+	gasRemaining uint64
+	baseHash     common.Hash // hash of the Tendermint block the simulation starts from
+}
+
+func (sim *simulator) processBlock(ctx context.Context, blockIdx int, block *SimulateBlock, parent *ethtypes.Header) (*SimulateBlockResult, *ethtypes.Header, error) {
+	header, err := sim.makeHeader(blockIdx, block.BlockOverrides, parent)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := block.StateOverrides.Apply(sim.db); err != nil {
+		return nil, nil, err
+	}
+	blockCtx, err := sim.backend.keeper.GetVMBlockContext(sim.db.Ctx(), core.GasPool(sim.backend.RPCGasCap()))
+	if err != nil {
+		return nil, nil, err
+	}
+	blockCtx.BlockNumber = header.Number
+	blockCtx.Time = header.Time
+	blockCtx.GasLimit = header.GasLimit
+	blockCtx.BaseFee = header.BaseFee
+	if block.BlockOverrides != nil && block.BlockOverrides.FeeRecipient != nil {
+		blockCtx.Coinbase = *block.BlockOverrides.FeeRecipient
+	}
+	header.Coinbase = blockCtx.Coinbase
+	if block.BlockOverrides != nil && block.BlockOverrides.PrevRandao != nil {
+		blockCtx.Random = block.BlockOverrides.PrevRandao
+	}
+
+	chainConfig := sim.backend.ChainConfig()
+	res := &SimulateBlockResult{Calls: make([]*SimulateCallResult, 0, len(block.Calls)), Transactions: []interface{}{}}
+	txs := make([]*ethtypes.Transaction, 0, len(block.Calls))
+	senders := make([]common.Address, 0, len(block.Calls))
+	logs := []*ethtypes.Log{}
+	for i := range block.Calls {
+		args := block.Calls[i]
+		if args.Nonce == nil && args.From != nil {
+			nonce := hexutil.Uint64(sim.db.GetNonce(*args.From))
+			args.Nonce = &nonce
+		}
+		if args.Gas != nil && uint64(*args.Gas) > sim.gasRemaining {
+			return nil, nil, fmt.Errorf("call %d of block %d requests more gas than the %d left for the simulation", i, blockIdx, sim.gasRemaining)
+		}
+		if sim.gasRemaining == 0 {
+			return nil, nil, errors.New("the simulation ran out of gas")
+		}
+		if err := args.CallDefaults(sim.gasRemaining, blockCtx.BaseFee, chainConfig.ChainID); err != nil {
+			return nil, nil, err
+		}
+		msg := args.ToMessage(blockCtx.BaseFee)
+		msg.SkipAccountChecks = !sim.opts.Validation
+		tx := args.ToTransaction()
+		callRes, err := sim.applyCall(ctx, blockCtx, msg, tx.Hash(), uint(i), uint(len(logs)))
+		if err != nil {
+			return nil, nil, fmt.Errorf("call %d of block %d: %w", i, blockIdx, err)
+		}
+		sim.gasRemaining -= uint64(callRes.GasUsed)
+		header.GasUsed += uint64(callRes.GasUsed)
+		logs = append(logs, callRes.Logs...)
+		res.Calls = append(res.Calls, callRes)
+		txs = append(txs, tx)
+		senders = append(senders, msg.From)
+	}
+
+	header.Bloom = ethtypes.CreateBloom(ethtypes.Receipts{&ethtypes.Receipt{Logs: logs}})
+	hash := header.Hash()
+	for _, l := range logs {
+		l.BlockHash = hash
+	}
+	for i, tx := range txs {
+		if !sim.opts.ReturnFullTransactions {
+			res.Transactions = append(res.Transactions, tx.Hash())
+			continue
+		}
+		rpcTx := ethapi.NewRPCTransaction(tx, hash, header.Number.Uint64(), header.Time, uint64(i), header.BaseFee, chainConfig)
+		// simulated transactions are not signed
+		rpcTx.From = senders[i]
+		res.Transactions = append(res.Transactions, rpcTx)
+	}
+	res.Number = hexutil.Uint64(header.Number.Uint64())
+	res.Hash = hash
+	res.ParentHash = header.ParentHash
+	res.Timestamp = hexutil.Uint64(header.Time)
+	res.GasLimit = hexutil.Uint64(header.GasLimit)
+	res.GasUsed = hexutil.Uint64(header.GasUsed)
+	res.Miner = header.Coinbase
+	res.BaseFeePerGas = (*hexutil.Big)(header.BaseFee)
+	res.LogsBloom = header.Bloom
+	return res, header, nil
+}
+
+// makeHeader returns the header of a simulated block. Blocks follow each other
+// by default; overridden numbers and timestamps must keep increasing.
+func (sim *simulator) makeHeader(blockIdx int, overrides *SimulateBlockOverrides, parent *ethtypes.Header) (*ethtypes.Header, error) {
+	parentHash := parent.Hash()
+	if blockIdx == 0 && sim.baseHash != (common.Hash{}) {
+		parentHash = sim.baseHash
+	}
+	header := &ethtypes.Header{
+		ParentHash: parentHash,
+		Number:     new(big.Int).Add(parent.Number, common.Big1),
+		Time:       parent.Time + 1,
+		GasLimit:   parent.GasLimit,
+		BaseFee:    parent.BaseFee,
+		Difficulty: common.Big0,
+	}
+	if overrides == nil {
+		return header, nil
+	}
+	if overrides.Number != nil {
+		if overrides.Number.ToInt().Cmp(parent.Number) <= 0 {
+			return nil, fmt.Errorf("block numbers must be increasing, block %d has number %d after %d", blockIdx, overrides.Number.ToInt(), parent.Number)
+		}
+		header.Number = overrides.Number.ToInt()
+	}
+	if overrides.Time != nil {
+		if uint64(*overrides.Time) <= parent.Time {
+			return nil, fmt.Errorf("block timestamps must be increasing, block %d has timestamp %d after %d", blockIdx, uint64(*overrides.Time), parent.Time)
+		}
+		header.Time = uint64(*overrides.Time)
+	}
+	if overrides.GasLimit != nil {
+		header.GasLimit = uint64(*overrides.GasLimit)
+	}
+	if overrides.BaseFeePerGas != nil {
+		header.BaseFee = overrides.BaseFeePerGas.ToInt()
+	}
+	return header, nil
+}
+
+func (sim *simulator) applyCall(ctx context.Context, blockCtx *vm.BlockContext, msg *core.Message, txHash common.Hash, txIndex uint, logIndex uint) (*SimulateCallResult, error) {
+	sim.db.WithCtx(sim.db.Ctx().WithEVMEntryViaWasmdPrecompile(wasmd.IsWasmdCall(msg.To)))
+	eventsBefore := len(sim.db.GetAllEvents())
+	tracer := newSimulateLogTracer(sim.opts.TraceTransfers)
+	sim.db.SetLogger(tracer.hooks())
+	defer sim.db.SetLogger(nil)
+
+	evm := sim.backend.GetEVM(ctx, msg, sim.db, nil, &vm.Config{NoBaseFee: !sim.opts.Validation, Tracer: tracer.hooks()}, blockCtx)
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			evm.Cancel()
+		case <-done:
+		}
+	}()
+	result, err := core.ApplyMessage(evm, msg, new(core.GasPool).AddGas(msg.GasLimit))
+	if err := sim.db.Error(); err != nil {
+		return nil, err
+	}
+	if evm.Cancelled() {
+		return nil, fmt.Errorf("execution aborted (timeout = %v)", sim.backend.RPCEVMTimeout())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	logs := tracer.logs()
+	if translate := sim.backend.keeper.CosmosEventsTranslator; translate != nil && result.Err == nil {
+		logs = append(logs, translate(sim.db.Ctx(), sim.db.GetAllEvents()[eventsBefore:])...)
+	}
+	for i, l := range logs {
+		l.BlockNumber = blockCtx.BlockNumber.Uint64()
+		l.TxHash = txHash
+		l.TxIndex = txIndex
+		l.Index = logIndex + uint(i)
+	}
+	res := &SimulateCallResult{
+		ReturnData: result.Return(),
+		Logs:       logs,
+		GasUsed:    hexutil.Uint64(result.UsedGas),
+		Status:     hexutil.Uint64(ethtypes.ReceiptStatusSuccessful),
+	}
+	if result.Err != nil {
+		res.Status = hexutil.Uint64(ethtypes.ReceiptStatusFailed)
+		res.Error = &SimulateCallError{Code: simulateVMErrorCode, Message: result.Err.Error()}
+		if errors.Is(result.Err, vm.ErrExecutionReverted) {
+			revertErr := NewRevertError(result)
+			res.ReturnData = result.Revert()
+			res.Error = &SimulateCallError{Code: simulateRevertErrorCode, Message: revertErr.Error(), Data: revertErr.reason}
+		}
+	}
+	return res, nil
+}
+
+// simulateLogTracer collects the logs of a call in order, dropping those of
+// reverted frames, and optionally records native transfers as logs.
+type simulateLogTracer struct {
+	traceTransfers bool
+	frames         [][]*ethtypes.Log
+	h              *tracing.Hooks
+}
+
+func newSimulateLogTracer(traceTransfers bool) *simulateLogTracer {
+	t := &simulateLogTracer{traceTransfers: traceTransfers, frames: [][]*ethtypes.Log{{}}}
+	t.h = &tracing.Hooks{OnEnter: t.onEnter, OnExit: t.onExit, OnLog: t.onLog}
+	return t
+}
+
+func (t *simulateLogTracer) hooks() *tracing.Hooks { return t.h }
+
+func (t *simulateLogTracer) onEnter(_ int, typ byte, from common.Address, to common.Address, _ []byte, _ uint64, value *big.Int) {
+	t.frames = append(t.frames, []*ethtypes.Log{})
+	if t.traceTransfers && value != nil && value.Sign() > 0 && vm.OpCode(typ) != vm.DELEGATECALL {
+		t.onLog(&ethtypes.Log{
+			Address: TransferLogAddress,
+			Topics:  []common.Hash{transferTopic, common.BytesToHash(from.Bytes()), common.BytesToHash(to.Bytes())},
+			Data:    common.BigToHash(value).Bytes(),
+		})
+	}
+}
+
+func (t *simulateLogTracer) onExit(_ int, _ []byte, _ uint64, _ error, reverted bool) {
+	if len(t.frames) < 2 {
+		return
+	}
+	frame := t.frames[len(t.frames)-1]
+	t.frames = t.frames[:len(t.frames)-1]
+	if !reverted {
+		t.frames[len(t.frames)-1] = append(t.frames[len(t.frames)-1], frame...)
+	}
+}
+
+func (t *simulateLogTracer) onLog(l *ethtypes.Log) {
+	t.frames[len(t.frames)-1] = append(t.frames[len(t.frames)-1], l)
+}
+
+func (t *simulateLogTracer) logs() []*ethtypes.Log {
+	return t.frames[0]
+}
@@ -5,18 +5,17 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"math/big"
 	"strings"
 	"time"
 
-	"github.com/cosmos/cosmos-sdk/store/cachekv"
-	iavlstore "github.com/cosmos/cosmos-sdk/store/iavl"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/kiichain/kiichain3/evmrpc/proof"
 	"github.com/kiichain/kiichain3/x/evm/keeper"
 	"github.com/kiichain/kiichain3/x/evm/state"
-	"github.com/kiichain/kiichain3/x/evm/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/proto/tendermint/crypto"
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
@@ -27,11 +26,12 @@ type StateAPI struct {
 	tmClient       rpcclient.Client
 	keeper         *keeper.Keeper
 	ctxProvider    func(int64) sdk.Context
+	archive        *ArchiveFallback
 	connectionType ConnectionType
 }
 
-func NewStateAPI(tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, connectionType ConnectionType) *StateAPI {
-	return &StateAPI{tmClient: tmClient, keeper: k, ctxProvider: ctxProvider, connectionType: connectionType}
+func NewStateAPI(tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, archive *ArchiveFallback, connectionType ConnectionType) *StateAPI {
+	return &StateAPI{tmClient: tmClient, keeper: k, ctxProvider: ctxProvider, archive: archive, connectionType: connectionType}
 }
 
 func (a *StateAPI) GetBalance(ctx context.Context, address common.Address, blockNrOrHash rpc.BlockNumberOrHash) (result *hexutil.Big, returnErr error) {
@@ -41,11 +41,12 @@ func (a *StateAPI) GetBalance(ctx context.Context, address common.Address, block
 	if err != nil {
 		return nil, err
 	}
-	sdkCtx := a.ctxProvider(LatestCtxHeight)
+	sdkCtx := a.ctxProvider(latestOrPendingHeight(blockNrOrHash))
 	if block != nil {
 		sdkCtx = a.ctxProvider(*block)
 		if err := CheckVersion(sdkCtx, a.keeper); err != nil {
-			return nil, err
+			returnErr = a.archive.forward(ctx, err, &result, "eth_getBalance", address, blockNrOrHash)
+			return
 		}
 	}
 	statedb := state.NewDBImpl(sdkCtx, a.keeper, true)
@@ -59,11 +60,12 @@ func (a *StateAPI) GetCode(ctx context.Context, address common.Address, blockNrO
 	if err != nil {
 		return nil, err
 	}
-	sdkCtx := a.ctxProvider(LatestCtxHeight)
+	sdkCtx := a.ctxProvider(latestOrPendingHeight(blockNrOrHash))
 	if block != nil {
 		sdkCtx = a.ctxProvider(*block)
 		if err := CheckVersion(sdkCtx, a.keeper); err != nil {
-			return nil, err
+			returnErr = a.archive.forward(ctx, err, &result, "eth_getCode", address, blockNrOrHash)
+			return
 		}
 	}
 	code := a.keeper.GetCode(sdkCtx, address)
@@ -77,11 +79,12 @@ func (a *StateAPI) GetStorageAt(ctx context.Context, address common.Address, hex
 	if err != nil {
 		return nil, err
 	}
-	sdkCtx := a.ctxProvider(LatestCtxHeight)
+	sdkCtx := a.ctxProvider(latestOrPendingHeight(blockNrOrHash))
 	if block != nil {
 		sdkCtx = a.ctxProvider(*block)
 		if err := CheckVersion(sdkCtx, a.keeper); err != nil {
-			return nil, err
+			returnErr = a.archive.forward(ctx, err, &result, "eth_getStorageAt", address, hexKey, blockNrOrHash)
+			return
 		}
 	}
 	key, _, err := decodeHash(hexKey)
@@ -92,17 +95,10 @@ func (a *StateAPI) GetStorageAt(ctx context.Context, address common.Address, hex
 	return state[:], nil
 }
 
-// Result structs for GetProof
-// This differs from go-ethereum AccountResult in two ways:
-// 1. Proof object is an iavl proof, not a trie proof
-// 2. Per-account fields are excluded because there is no per-account root
-type ProofResult struct {
-	Address      common.Address     `json:"address"`
-	HexValues    []string           `json:"hexValues"`
-	StorageProof []*crypto.ProofOps `json:"storageProof"`
-}
-
-func (a *StateAPI) GetProof(ctx context.Context, address common.Address, storageKeys []string, blockNrOrHash rpc.BlockNumberOrHash) (result *ProofResult, returnErr error) {
+// GetProof returns the EIP-1186 account and storage proofs of an address. The
+// proofs are IAVL and multistore proofs rather than trie proofs; see package
+// proof for their layout and for how to verify them.
+func (a *StateAPI) GetProof(ctx context.Context, address common.Address, storageKeys []string, blockNrOrHash rpc.BlockNumberOrHash) (result *proof.AccountResult, returnErr error) {
 	startTime := time.Now()
 	defer recordMetrics("eth_getProof", a.connectionType, startTime, returnErr == nil)
 	var block *coretypes.ResultBlock
@@ -119,42 +115,83 @@ func (a *StateAPI) GetProof(ctx context.Context, address common.Address, storage
 	if err != nil {
 		return nil, err
 	}
-	sdkCtx := a.ctxProvider(block.Block.Height)
-	if err := CheckVersion(sdkCtx, a.keeper); err != nil {
+	height := block.Block.Height
+	query := func(key proof.Key) ([]byte, *crypto.ProofOps, string, error) {
+		res, err := a.tmClient.ABCIQueryWithOptions(ctx, key.Path(), key.Key, rpcclient.ABCIQueryOptions{Height: height, Prove: true})
+		if err != nil {
+			return nil, nil, "", err
+		}
+		if res.Response.Code != abci.CodeTypeOK {
+			return nil, nil, "", fmt.Errorf("failed to prove key %X of store %s: %s", key.Key, key.StoreName, res.Response.Log)
+		}
+		encoded, err := proof.Encode(res.Response.ProofOps)
+		if err != nil {
+			return nil, nil, "", err
+		}
+		return res.Response.Value, res.Response.ProofOps, encoded, nil
+	}
+
+	accountProof := make([]string, proof.AccountProofLen)
+	nonce, nonceProof, encoded, err := query(proof.NonceKey(address))
+	if err != nil {
 		return nil, err
 	}
-	var iavl *iavlstore.Store
-	s := sdkCtx.MultiStore().GetKVStore((a.keeper.GetStoreKey()))
-OUTER:
-	for {
-		switch cast := s.(type) {
-		case *iavlstore.Store:
-			iavl = cast
-			break OUTER
-		case *cachekv.Store:
-			if cast.GetParent() == nil {
-				return nil, errors.New("cannot find EVM IAVL store")
-			}
-			s = cast.GetParent()
-		default:
-			return nil, errors.New("cannot find EVM IAVL store")
-		}
+	accountProof[proof.AccountProofNonce] = encoded
+	storageHash, err := proof.StoreRoot(nonceProof, nonce)
+	if err != nil {
+		return nil, err
 	}
-	proofResult := ProofResult{Address: address}
-	for _, key := range storageKeys {
-		paddedKey := common.BytesToHash([]byte(key))
-		formattedKey := append(types.StateKey(address), paddedKey[:]...)
-		qres := iavl.Query(abci.RequestQuery{
-			Path:   "/key",
-			Data:   formattedKey,
-			Height: block.Block.Height,
-			Prove:  true,
-		})
-		proofResult.HexValues = append(proofResult.HexValues, hex.EncodeToString(qres.Value))
-		proofResult.StorageProof = append(proofResult.StorageProof, qres.ProofOps)
+	association, _, encoded, err := query(proof.AssociationKey(address))
+	if err != nil {
+		return nil, err
 	}
+	accountProof[proof.AccountProofAssociation] = encoded
+	kiiAddr := proof.KiiAddress(address, association)
+	balance, _, encoded, err := query(proof.BalanceKey(kiiAddr))
+	if err != nil {
+		return nil, err
+	}
+	accountProof[proof.AccountProofBalance] = encoded
+	weiBalance, _, encoded, err := query(proof.WeiBalanceKey(kiiAddr))
+	if err != nil {
+		return nil, err
+	}
+	accountProof[proof.AccountProofWeiBalance] = encoded
+	totalBalance, err := proof.DecodeBalance(balance, weiBalance)
+	if err != nil {
+		return nil, err
+	}
+	codeHash, _, encoded, err := query(proof.CodeHashKey(address))
+	if err != nil {
+		return nil, err
+	}
+	accountProof[proof.AccountProofCodeHash] = encoded
 
-	return &proofResult, nil
+	result = &proof.AccountResult{
+		Address:      address,
+		AccountProof: accountProof,
+		Balance:      (*hexutil.Big)(totalBalance),
+		CodeHash:     proof.DecodeCodeHash(codeHash, totalBalance),
+		Nonce:        hexutil.Uint64(proof.DecodeNonce(nonce)),
+		StorageHash:  common.BytesToHash(storageHash),
+		StorageProof: make([]proof.StorageResult, 0, len(storageKeys)),
+	}
+	for _, hexKey := range storageKeys {
+		slot, _, err := decodeHash(hexKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode storage key: %s", err)
+		}
+		value, _, encoded, err := query(proof.StorageKey(address, slot))
+		if err != nil {
+			return nil, err
+		}
+		result.StorageProof = append(result.StorageProof, proof.StorageResult{
+			Key:   hexKey,
+			Value: (*hexutil.Big)(new(big.Int).SetBytes(value)),
+			Proof: []string{encoded},
+		})
+	}
+	return result, nil
 }
 
 func (a *StateAPI) GetNonce(_ context.Context, address common.Address) uint64 {
@@ -6,18 +6,26 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"math/big"
 	"net/http"
 	"strings"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/kiichain/kiichain3/app"
 	"github.com/kiichain/kiichain3/evmrpc"
+	"github.com/kiichain/kiichain3/evmrpc/proof"
 	testkeeper "github.com/kiichain/kiichain3/testutil/keeper"
+	"github.com/kiichain/kiichain3/x/evm/types"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/bytes"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	"github.com/tendermint/tendermint/rpc/coretypes"
 )
 
 func TestGetBalance(t *testing.T) {
@@ -205,47 +213,69 @@ func TestGetStorageAt(t *testing.T) {
 	Ctx = Ctx.WithBlockHeight(8)
 }
 
+// proofClient serves ABCI store queries from a test app.
+type proofClient struct {
+	*MockClient
+	app *app.App
+}
+
+func (c *proofClient) ABCIQueryWithOptions(ctx context.Context, path string, data bytes.HexBytes, opts rpcclient.ABCIQueryOptions) (*coretypes.ResultABCIQuery, error) {
+	res, err := c.app.Query(ctx, &abci.RequestQuery{Path: path, Data: data, Height: opts.Height, Prove: opts.Prove})
+	if err != nil {
+		return nil, err
+	}
+	return &coretypes.ResultABCIQuery{Response: *res}, nil
+}
+
 func TestGetProof(t *testing.T) {
 	testApp := app.Setup(false, false)
-	_, evmAddr := testkeeper.MockAddressPair()
-	key, val := []byte("test"), []byte("abc")
-	testApp.EvmKeeper.SetState(testApp.GetContextForDeliverTx([]byte{}), evmAddr, common.BytesToHash(key), common.BytesToHash(val))
+	kiiAddr, evmAddr := testkeeper.MockAddressPair()
+	_, unassociated := testkeeper.MockAddressPair()
+	key, val := common.HexToHash("0x74657374"), common.HexToHash("0x616263")
+	ctx := testApp.GetContextForDeliverTx([]byte{})
+	testApp.EvmKeeper.SetAddressMapping(ctx, kiiAddr, evmAddr)
+	testApp.EvmKeeper.SetState(ctx, evmAddr, key, val)
+	testApp.EvmKeeper.SetCode(ctx, evmAddr, []byte("abc"))
+	testApp.EvmKeeper.SetNonce(ctx, evmAddr, 5)
+	amt := sdk.NewCoins(sdk.NewCoin(testApp.EvmKeeper.GetBaseDenom(ctx), sdk.NewInt(10)))
+	require.Nil(t, testApp.BankKeeper.MintCoins(ctx, types.ModuleName, amt))
+	require.Nil(t, testApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, kiiAddr, amt))
 	for i := 0; i < MockHeight; i++ {
 		testApp.FinalizeBlock(context.Background(), &abci.RequestFinalizeBlock{Height: int64(i + 1)})
 		testApp.SetDeliverStateToCommit()
 		_, err := testApp.Commit(context.Background())
 		require.Nil(t, err)
 	}
-	stateAPI := evmrpc.NewStateAPI(&MockClient{}, &testApp.EvmKeeper, func(int64) sdk.Context { return testApp.GetCheckCtx() }, evmrpc.ConnectionTypeHTTP)
-	require.Equal(t, "0x0000000000000000000000000000000000000000000000000000000000616263", testApp.EvmKeeper.GetState(testApp.GetCheckCtx(), evmAddr, common.BytesToHash(key)).Hex())
-	tests := []struct {
-		key         string
-		blockNr     rpc.BlockNumber
-		expectedVal []byte
-	}{
-		{
-			key:         string(key),
-			blockNr:     rpc.BlockNumber(-2),
-			expectedVal: val,
-		},
-		{
-			key:         string(key),
-			blockNr:     rpc.BlockNumber(8),
-			expectedVal: val,
-		},
-		{
-			key:         "non existent",
-			blockNr:     rpc.BlockNumber(-2),
-			expectedVal: []byte{},
-		},
-	}
-	for _, test := range tests {
-		bptr := &rpc.BlockNumberOrHash{BlockNumber: &test.blockNr}
-		res, err := stateAPI.GetProof(context.Background(), evmAddr, []string{test.key}, *bptr)
+	appHash := testApp.LastCommitID().Hash
+	stateAPI := evmrpc.NewStateAPI(&proofClient{MockClient: &MockClient{}, app: testApp}, &testApp.EvmKeeper, func(int64) sdk.Context { return testApp.GetCheckCtx() }, nil, evmrpc.ConnectionTypeHTTP)
+	for _, blockNr := range []rpc.BlockNumber{rpc.LatestBlockNumber, rpc.BlockNumber(MockHeight)} {
+		res, err := stateAPI.GetProof(context.Background(), evmAddr, []string{key.Hex(), "0x74657374", "0x1"}, rpc.BlockNumberOrHashWithNumber(blockNr))
 		require.Nil(t, err)
-		vals := res.HexValues
-		require.Equal(t, common.BytesToHash(test.expectedVal), common.HexToHash(vals[0]))
-		proofs := res.StorageProof
-		require.Equal(t, "ics23:iavl", proofs[0].Ops[0].Type)
+		require.Equal(t, hexutil.Uint64(5), res.Nonce)
+		require.Equal(t, crypto.Keccak256Hash([]byte("abc")), res.CodeHash)
+		require.Equal(t, big.NewInt(10_000_000_000_000), res.Balance.ToInt())
+		require.Len(t, res.StorageProof, 3)
+		require.Equal(t, new(big.Int).SetBytes(val[:]), res.StorageProof[0].Value.ToInt())
+		require.Equal(t, "0x74657374", res.StorageProof[1].Key)
+		require.Equal(t, new(big.Int).SetBytes(val[:]), res.StorageProof[1].Value.ToInt())
+		require.Equal(t, 0, res.StorageProof[2].Value.ToInt().Sign())
+		require.Nil(t, proof.VerifyAccount(appHash, res))
+
+		// tampered values or a wrong app hash fail verification
+		res.Balance = (*hexutil.Big)(big.NewInt(1))
+		require.NotNil(t, proof.VerifyAccount(appHash, res))
+		res.Balance = (*hexutil.Big)(big.NewInt(10_000_000_000_000))
+		res.StorageProof[2].Value = (*hexutil.Big)(big.NewInt(1))
+		require.NotNil(t, proof.VerifyAccount(appHash, res))
+		res.StorageProof[2].Value = (*hexutil.Big)(big.NewInt(0))
+		require.NotNil(t, proof.VerifyAccount(make([]byte, 32), res))
 	}
+
+	// an account that doesn't exist is proven absent
+	res, err := stateAPI.GetProof(context.Background(), unassociated, nil, rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber))
+	require.Nil(t, err)
+	require.Equal(t, hexutil.Uint64(0), res.Nonce)
+	require.Equal(t, common.Hash{}, res.CodeHash)
+	require.Equal(t, 0, res.Balance.ToInt().Sign())
+	require.Nil(t, proof.VerifyAccount(appHash, res))
 }
@@ -2,18 +2,24 @@ package evmrpc
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
 	"sync"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/lib/ethapi"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/kiichain/kiichain3/utils"
+	"github.com/kiichain/kiichain3/x/evm/keeper"
+	"github.com/kiichain/kiichain3/x/evm/types"
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
 	"github.com/tendermint/tendermint/rpc/coretypes"
 	tmtypes "github.com/tendermint/tendermint/types"
@@ -21,34 +27,80 @@ import (
 
 const SleepInterval = 5 * time.Second
 const NewHeadsListenerBuffer = 10
+const PendingTxListenerBuffer = 100
+const SyncingListenerBuffer = 10
+const PendingTxPollInterval = 1 * time.Second
+const SyncingPollInterval = 5 * time.Second
 
 type SubscriptionAPI struct {
 	tmClient            rpcclient.Client
+	keeper              *keeper.Keeper
+	ctxProvider         func(int64) sdk.Context
+	txDecoder           sdk.TxDecoder
 	subscriptionManager *SubscriptionManager
 	subscriptonConfig   *SubscriptionConfig
 
 	logFetcher          *LogFetcher
 	newHeadListenersMtx *sync.RWMutex
 	newHeadListeners    map[rpc.ID]chan map[string]interface{}
-	connectionType      ConnectionType
+
+	pendingTxListenersMtx *sync.Mutex
+	pendingTxListeners    map[rpc.ID]*pendingTxListener
+	pendingTxPollNow      chan struct{}
+	syncingListenersMtx   *sync.Mutex
+	syncingListeners      map[rpc.ID]chan interface{}
+
+	connectionType ConnectionType
 }
 
 type SubscriptionConfig struct {
 	subscriptionCapacity int
 	newHeadLimit         uint64
+	pendingTxLimit       uint64
+	pendingTxPollLimit   int
+	syncingLimit         uint64
+}
+
+type pendingTxListener struct {
+	ch     chan interface{}
+	fullTx bool
+	primed bool // whether the current mempool content has been delivered
+}
+
+// SyncingResult is the payload of a "syncing" subscription while the node is
+// catching up. Once caught up, subscribers receive a plain false instead.
+type SyncingResult struct {
+	Syncing bool          `json:"syncing"`
+	Status  SyncingStatus `json:"status"`
+}
+
+type SyncingStatus struct {
+	StartingBlock hexutil.Uint64 `json:"startingBlock"`
+	CurrentBlock  hexutil.Uint64 `json:"currentBlock"`
+	HighestBlock  hexutil.Uint64 `json:"highestBlock"`
 }
 
-func NewSubscriptionAPI(tmClient rpcclient.Client, logFetcher *LogFetcher, subscriptionConfig *SubscriptionConfig, filterConfig *FilterConfig, connectionType ConnectionType) *SubscriptionAPI {
+func NewSubscriptionAPI(tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, txDecoder sdk.TxDecoder, logFetcher *LogFetcher, subscriptionConfig *SubscriptionConfig, filterConfig *FilterConfig, connectionType ConnectionType) *SubscriptionAPI {
 	logFetcher.filterConfig = filterConfig
 	api := &SubscriptionAPI{
-		tmClient:            tmClient,
-		subscriptionManager: NewSubscriptionManager(tmClient),
-		subscriptonConfig:   subscriptionConfig,
-		logFetcher:          logFetcher,
-		newHeadListenersMtx: &sync.RWMutex{},
-		newHeadListeners:    make(map[rpc.ID]chan map[string]interface{}),
-		connectionType:      connectionType,
+		tmClient:              tmClient,
+		keeper:                k,
+		ctxProvider:           ctxProvider,
+		txDecoder:             txDecoder,
+		subscriptionManager:   NewSubscriptionManager(tmClient),
+		subscriptonConfig:     subscriptionConfig,
+		logFetcher:            logFetcher,
+		newHeadListenersMtx:   &sync.RWMutex{},
+		newHeadListeners:      make(map[rpc.ID]chan map[string]interface{}),
+		pendingTxListenersMtx: &sync.Mutex{},
+		pendingTxListeners:    make(map[rpc.ID]*pendingTxListener),
+		pendingTxPollNow:      make(chan struct{}, 1),
+		syncingListenersMtx:   &sync.Mutex{},
+		syncingListeners:      make(map[rpc.ID]chan interface{}),
+		connectionType:        connectionType,
 	}
+	go api.pendingTxLoop()
+	go api.syncingLoop()
 	id, subCh, err := api.subscriptionManager.Subscribe(context.Background(), NewHeadQueryBuilder(), api.subscriptonConfig.subscriptionCapacity)
 	if err != nil {
 		panic(err)
@@ -80,11 +132,11 @@ func NewSubscriptionAPI(tmClient rpcclient.Client, logFetcher *LogFetcher, subsc
 	return api
 }
 
-func handleListener(c chan map[string]interface{}, ethHeader map[string]interface{}) bool {
+func handleListener[T any](c chan T, event T) bool {
 	// if the channel is already closed, sending to it/closing it will panic
 	defer func() { _ = recover() }()
 	select {
-	case c <- ethHeader:
+	case c <- event:
 		return true
 	default:
 		// this path is hit when the buffer is full, meaning that the subscriber is not consuming
@@ -138,7 +190,9 @@ func (a *SubscriptionAPI) NewHeads(ctx context.Context) (s *rpc.Subscription, er
 	return rpcSub, nil
 }
 
-func (a *SubscriptionAPI) Logs(ctx context.Context, filter *filters.FilterCriteria) (s *rpc.Subscription, err error) {
+// Logs notifies subscribers of the logs matching the filter, starting with the
+// historical logs from fromBlock, or right after the cursor if one is given.
+func (a *SubscriptionAPI) Logs(ctx context.Context, filter *filters.FilterCriteria, cursor *LogSubscriptionCursor) (s *rpc.Subscription, err error) {
 	defer recordMetrics("eth_logs", a.connectionType, time.Now(), err == nil)
 	notifier, supported := rpc.NotifierFromContext(ctx)
 	if !supported {
@@ -158,7 +212,7 @@ func (a *SubscriptionAPI) Logs(ctx context.Context, filter *filters.FilterCriter
 				return
 			}
 			for _, log := range logs {
-				if err := notifier.Notify(rpcSub.ID, log); err != nil {
+				if err := notifier.Notify(rpcSub.ID, newLogNotification(log)); err != nil {
 					return
 				}
 			}
@@ -166,32 +220,348 @@ func (a *SubscriptionAPI) Logs(ctx context.Context, filter *filters.FilterCriter
 		return rpcSub, nil
 	}
 
-	go func() {
-		begin := int64(0)
-		for {
-			logs, lastToHeight, err := a.logFetcher.GetLogsByFilters(ctx, *filter, begin)
+	go a.streamLogs(ctx, notifier, rpcSub, *filter, cursor)
+	return rpcSub, nil
+}
+
+// LogSubscriptionCursor is the position of a log delivered by a logs
+// subscription. Passing the cursor of the last log received to a new
+// subscription with the same filter resumes delivery right after that log.
+type LogSubscriptionCursor struct {
+	BlockNumber hexutil.Uint64 `json:"blockNumber"`
+	TxIndex     hexutil.Uint   `json:"transactionIndex"`
+	LogIndex    hexutil.Uint   `json:"logIndex"`
+}
+
+// logNotification is a log as delivered by a logs subscription: the fields of
+// the log plus its cursor.
+type logNotification struct {
+	log    *ethtypes.Log
+	cursor LogSubscriptionCursor
+}
+
+func newLogNotification(log *ethtypes.Log) *logNotification {
+	return &logNotification{log: log, cursor: LogSubscriptionCursor{
+		BlockNumber: hexutil.Uint64(log.BlockNumber),
+		TxIndex:     hexutil.Uint(log.TxIndex),
+		LogIndex:    hexutil.Uint(log.Index),
+	}}
+}
+
+func (n *logNotification) MarshalJSON() ([]byte, error) {
+	bz, err := json.Marshal(n.log)
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(bz, &fields); err != nil {
+		return nil, err
+	}
+	if fields["cursor"], err = json.Marshal(n.cursor); err != nil {
+		return nil, err
+	}
+	return json.Marshal(fields)
+}
+
+// streamLogs delivers the logs matching the filter in order, page by page,
+// from fromBlock (or right after the cursor) up to the latest block, and then
+// the logs of new blocks as they are committed. Historical and new logs go
+// through the same pages, so there is neither a gap nor a duplicate between
+// the two. A numeric toBlock ends the subscription once it is reached; block
+// tags don't.
+func (a *SubscriptionAPI) streamLogs(ctx context.Context, notifier *rpc.Notifier, rpcSub *rpc.Subscription, filter filters.FilterCriteria, cursor *LogSubscriptionCursor) {
+	next, _ := a.logFetcher.blockRange(filters.FilterCriteria{FromBlock: filter.FromBlock})
+	var pageCursor *LogsCursor
+	if cursor != nil {
+		next = int64(cursor.BlockNumber)
+		pageCursor = &LogsCursor{BlockNumber: cursor.BlockNumber, TxIndex: cursor.TxIndex, LogIndex: cursor.LogIndex + 1}
+	}
+	toBlock := int64(-1)
+	if filter.ToBlock != nil && filter.ToBlock.Sign() >= 0 {
+		toBlock = filter.ToBlock.Int64()
+	}
+	for {
+		end := a.ctxProvider(LatestCtxHeight).BlockHeight()
+		if toBlock >= 0 && toBlock < end {
+			end = toBlock
+		}
+		for next <= end {
+			crit := filter
+			crit.FromBlock, crit.ToBlock = big.NewInt(next), big.NewInt(end)
+			if pageCursor != nil {
+				pageCursor.ToBlock = hexutil.Uint64(end)
+			}
+			page, err := a.logFetcher.GetLogsPage(ctx, crit, pageCursor, a.logFetcher.filterConfig.logsPageSize())
 			if err != nil {
 				_ = notifier.Notify(rpcSub.ID, err)
 				return
 			}
-			for _, log := range logs {
-				if err := notifier.Notify(rpcSub.ID, log); err != nil {
+			for _, log := range page.Logs {
+				if err := notifier.Notify(rpcSub.ID, newLogNotification(log)); err != nil {
 					return
 				}
 			}
-			if filter.ToBlock != nil && lastToHeight >= filter.ToBlock.Int64() {
-				return
+			if !page.Truncated {
+				next, pageCursor = end+1, nil
+				break
 			}
-			begin = lastToHeight
-			filter.FromBlock = big.NewInt(lastToHeight + 1)
-
-			time.Sleep(SleepInterval)
+			next, pageCursor = int64(page.Cursor.BlockNumber), page.Cursor
 		}
-	}()
+		if toBlock >= 0 && next > toBlock {
+			return
+		}
+		select {
+		case <-rpcSub.Err():
+			return
+		case <-time.After(SleepInterval):
+		}
+	}
+}
+
+// NewPendingTransactions notifies subscribers of EVM transactions entering the mempool.
+// Only hashes are sent unless fullTx is set, in which case the full transaction is sent.
+func (a *SubscriptionAPI) NewPendingTransactions(ctx context.Context, fullTx *bool) (s *rpc.Subscription, err error) {
+	defer recordMetrics("eth_newPendingTransactions", a.connectionType, time.Now(), err == nil)
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+
+	rpcSub := notifier.CreateSubscription()
+	listener := &pendingTxListener{ch: make(chan interface{}, PendingTxListenerBuffer), fullTx: fullTx != nil && *fullTx}
+	a.pendingTxListenersMtx.Lock()
+	defer a.pendingTxListenersMtx.Unlock()
+	if uint64(len(a.pendingTxListeners)) >= a.subscriptonConfig.pendingTxLimit {
+		return nil, errors.New("no new subscription can be created")
+	}
+	a.pendingTxListeners[rpcSub.ID] = listener
+	select {
+	case a.pendingTxPollNow <- struct{}{}:
+	default:
+	}
+
+	go forwardToSubscriber(notifier, rpcSub, listener.ch, func() {
+		a.pendingTxListenersMtx.Lock()
+		defer a.pendingTxListenersMtx.Unlock()
+		delete(a.pendingTxListeners, rpcSub.ID)
+	})
 
 	return rpcSub, nil
 }
 
+// Syncing notifies subscribers of changes in the node's catch-up status. The
+// current status is sent as soon as the subscription is created.
+func (a *SubscriptionAPI) Syncing(ctx context.Context) (s *rpc.Subscription, err error) {
+	defer recordMetrics("eth_syncing", a.connectionType, time.Now(), err == nil)
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+	status, err := a.tmClient.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcSub := notifier.CreateSubscription()
+	listener := make(chan interface{}, SyncingListenerBuffer)
+	a.syncingListenersMtx.Lock()
+	defer a.syncingListenersMtx.Unlock()
+	if uint64(len(a.syncingListeners)) >= a.subscriptonConfig.syncingLimit {
+		return nil, errors.New("no new subscription can be created")
+	}
+	listener <- encodeSyncInfo(status.SyncInfo)
+	a.syncingListeners[rpcSub.ID] = listener
+
+	go forwardToSubscriber(notifier, rpcSub, listener, func() {
+		a.syncingListenersMtx.Lock()
+		defer a.syncingListenersMtx.Unlock()
+		delete(a.syncingListeners, rpcSub.ID)
+	})
+
+	return rpcSub, nil
+}
+
+// forwardToSubscriber relays events from listener to the subscriber until the
+// listener is closed or the subscription goes away, then calls unregister.
+func forwardToSubscriber(notifier *rpc.Notifier, rpcSub *rpc.Subscription, listener chan interface{}, unregister func()) {
+OUTER:
+	for {
+		select {
+		case res, ok := <-listener:
+			if !ok {
+				break OUTER
+			}
+			if err := notifier.Notify(rpcSub.ID, res); err != nil {
+				break OUTER
+			}
+		case <-rpcSub.Err():
+			break OUTER
+		case <-notifier.Closed():
+			break OUTER
+		}
+	}
+	unregister()
+	defer func() { _ = recover() }() // might have already been closed
+	close(listener)
+}
+
+// pendingTxLoop polls the mempool and broadcasts EVM transactions that were not
+// present in the previous poll. A listener's first poll delivers everything that
+// is currently pending. Polling only happens while there are listeners, and a new
+// subscription triggers an immediate poll.
+func (a *SubscriptionAPI) pendingTxLoop() {
+	seen := map[common.Hash]struct{}{}
+	ticker := time.NewTicker(PendingTxPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-a.pendingTxPollNow:
+		}
+		a.pendingTxListenersMtx.Lock()
+		hasListeners := len(a.pendingTxListeners) > 0
+		a.pendingTxListenersMtx.Unlock()
+		if !hasListeners {
+			seen = map[common.Hash]struct{}{}
+			continue
+		}
+
+		pending, err := a.pendingEVMTxs()
+		if err != nil {
+			fmt.Printf("error polling pending transactions due to %s\n", err)
+			continue
+		}
+		newTxs := []*ethtypes.Transaction{}
+		current := make(map[common.Hash]struct{}, len(pending))
+		for _, tx := range pending {
+			current[tx.Hash()] = struct{}{}
+			if _, ok := seen[tx.Hash()]; !ok {
+				newTxs = append(newTxs, tx)
+			}
+		}
+		seen = current
+		encoder := a.pendingTxEncoder()
+
+		a.pendingTxListenersMtx.Lock()
+		toDelete := []rpc.ID{}
+		for id, l := range a.pendingTxListeners {
+			toSend := newTxs
+			if !l.primed {
+				toSend = pending
+				l.primed = true
+			}
+			for _, tx := range toSend {
+				var event interface{} = tx.Hash()
+				if l.fullTx {
+					event = encoder(tx)
+				}
+				if !handleListener(l.ch, event) {
+					toDelete = append(toDelete, id)
+					break
+				}
+			}
+		}
+		for _, id := range toDelete {
+			delete(a.pendingTxListeners, id)
+		}
+		a.pendingTxListenersMtx.Unlock()
+	}
+}
+
+// pendingEVMTxs returns the EVM transactions currently in the mempool.
+func (a *SubscriptionAPI) pendingEVMTxs() ([]*ethtypes.Transaction, error) {
+	total := a.subscriptonConfig.pendingTxPollLimit
+	resUnconfirmedTxs, err := a.tmClient.UnconfirmedTxs(context.Background(), nil, &total)
+	if err != nil {
+		return nil, err
+	}
+	txs := []*ethtypes.Transaction{}
+	for _, tx := range resUnconfirmedTxs.Txs {
+		ethTx := getEthTxForTxBz(tx, a.txDecoder)
+		if ethTx == nil { // not an evm tx
+			continue
+		}
+		txs = append(txs, ethTx)
+	}
+	return txs, nil
+}
+
+// pendingTxEncoder returns a function that converts a pending transaction into its
+// RPC representation, encoding each transaction at most once.
+func (a *SubscriptionAPI) pendingTxEncoder() func(*ethtypes.Transaction) *ethapi.RPCTransaction {
+	encoded := map[common.Hash]*ethapi.RPCTransaction{}
+	var chainConfig *params.ChainConfig
+	return func(tx *ethtypes.Transaction) *ethapi.RPCTransaction {
+		if res, ok := encoded[tx.Hash()]; ok {
+			return res
+		}
+		if chainConfig == nil {
+			sdkCtx := a.ctxProvider(LatestCtxHeight)
+			chainConfig = types.DefaultChainConfig().EthereumConfig(a.keeper.ChainID(sdkCtx))
+		}
+		res := ethapi.NewRPCPendingTransaction(tx, nil, chainConfig)
+		encoded[tx.Hash()] = res
+		return res
+	}
+}
+
+// syncingLoop polls the node status and broadcasts whenever the catch-up
+// status or, while catching up, the current height changes.
+func (a *SubscriptionAPI) syncingLoop() {
+	var last interface{}
+	ticker := time.NewTicker(SyncingPollInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		a.syncingListenersMtx.Lock()
+		hasListeners := len(a.syncingListeners) > 0
+		a.syncingListenersMtx.Unlock()
+		if !hasListeners {
+			last = nil
+			continue
+		}
+		status, err := a.tmClient.Status(context.Background())
+		if err != nil {
+			fmt.Printf("error polling sync status due to %s\n", err)
+			continue
+		}
+		res := encodeSyncInfo(status.SyncInfo)
+		if res == last {
+			continue
+		}
+		last = res
+
+		a.syncingListenersMtx.Lock()
+		toDelete := []rpc.ID{}
+		for id, c := range a.syncingListeners {
+			if !handleListener(c, res) {
+				toDelete = append(toDelete, id)
+			}
+		}
+		for _, id := range toDelete {
+			delete(a.syncingListeners, id)
+		}
+		a.syncingListenersMtx.Unlock()
+	}
+}
+
+func encodeSyncInfo(info coretypes.SyncInfo) interface{} {
+	if !info.CatchingUp {
+		return false
+	}
+	highest := info.MaxPeerBlockHeight
+	if highest < info.LatestBlockHeight {
+		highest = info.LatestBlockHeight
+	}
+	return SyncingResult{
+		Syncing: true,
+		Status: SyncingStatus{
+			StartingBlock: hexutil.Uint64(info.EarliestBlockHeight),
+			CurrentBlock:  hexutil.Uint64(info.LatestBlockHeight),
+			HighestBlock:  hexutil.Uint64(highest),
+		},
+	}
+}
+
 const SubscriberPrefix = "evm.rpc."
 
 type SubscriberID uint64
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/kiichain/kiichain3/evmrpc"
 	"github.com/stretchr/testify/require"
 )
@@ -181,3 +182,111 @@ func TestSubscriptionManager(t *testing.T) {
 	require.NotNil(t, err)
 	require.Nil(t, subCh)
 }
+
+func TestSubscribeNewPendingTransactions(t *testing.T) {
+	t.Parallel()
+	for _, fullTx := range []bool{false, true} {
+		recvCh, done := sendWSRequestGood(t, "subscribe", "newPendingTransactions", fullTx)
+
+		var subscriptionId string
+		received := false
+		timer := time.NewTimer(1 * time.Second)
+	LOOP:
+		for {
+			select {
+			case resObj := <-recvCh:
+				if _, ok := resObj["error"]; ok {
+					t.Fatal("Received error:", resObj["error"])
+				}
+				if subscriptionId == "" {
+					subscriptionId = resObj["result"].(string)
+					continue
+				}
+				paramMap := resObj["params"].(map[string]interface{})
+				require.Equal(t, "eth_subscription", resObj["method"])
+				require.Equal(t, subscriptionId, paramMap["subscription"])
+				if fullTx {
+					txMap := paramMap["result"].(map[string]interface{})
+					require.Nil(t, txMap["blockHash"])
+					require.Equal(t, "0x538", txMap["chainId"])
+					requireNotZeroHex(t, txMap["hash"].(string))
+				} else {
+					requireNotZeroHex(t, paramMap["result"].(string))
+				}
+				received = true
+				break LOOP
+			case <-timer.C:
+				break LOOP
+			}
+		}
+		done <- struct{}{}
+		require.True(t, received, "no pending transaction received (fullTx=%v)", fullTx)
+	}
+}
+
+func TestSubscribeSyncing(t *testing.T) {
+	t.Parallel()
+	recvCh, done := sendWSRequestGood(t, "subscribe", "syncing")
+	defer func() { done <- struct{}{} }()
+
+	var subscriptionId string
+	timer := time.NewTimer(1 * time.Second)
+	for {
+		select {
+		case resObj := <-recvCh:
+			if _, ok := resObj["error"]; ok {
+				t.Fatal("Received error:", resObj["error"])
+			}
+			if subscriptionId == "" {
+				subscriptionId = resObj["result"].(string)
+				continue
+			}
+			paramMap := resObj["params"].(map[string]interface{})
+			require.Equal(t, subscriptionId, paramMap["subscription"])
+			require.Equal(t, false, paramMap["result"])
+			return
+		case <-timer.C:
+			t.Fatal("No syncing status received within 1 second")
+		}
+	}
+}
+
+func TestSubscribeLogsBackfillAndResume(t *testing.T) {
+	t.Parallel()
+	height := hexutil.EncodeUint64(MultiTxBlockHeight)
+	filter := map[string]interface{}{"fromBlock": height, "toBlock": height}
+	collect := func(params ...interface{}) []map[string]interface{} {
+		recvCh, done := sendWSRequestGood(t, "subscribe", params...)
+		defer func() { done <- struct{}{} }()
+		logs := []map[string]interface{}{}
+		timer := time.NewTimer(2 * time.Second)
+		first := true
+		for {
+			select {
+			case resObj := <-recvCh:
+				require.Nil(t, resObj["error"])
+				if first {
+					first = false
+					continue
+				}
+				logs = append(logs, resObj["params"].(map[string]interface{})["result"].(map[string]interface{}))
+			case <-timer.C:
+				return logs
+			}
+		}
+	}
+
+	logs := collect("logs", filter)
+	require.Len(t, logs, 4)
+	for _, log := range logs {
+		require.Equal(t, map[string]interface{}{
+			"blockNumber":      log["blockNumber"],
+			"transactionIndex": log["transactionIndex"],
+			"logIndex":         log["logIndex"],
+		}, log["cursor"])
+	}
+
+	// resuming after a log delivers the ones after it
+	resumed := collect("logs", filter, logs[2]["cursor"])
+	require.Equal(t, logs[3:], resumed)
+}
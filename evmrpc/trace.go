@@ -0,0 +1,527 @@
+package evmrpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"slices"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/kiichain/kiichain3/x/evm/keeper"
+	"github.com/kiichain/kiichain3/x/evm/types"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+)
+
+const (
+	TraceTypeTrace     = "trace"
+	TraceTypeStateDiff = "stateDiff"
+	TraceTypeVMTrace   = "vmTrace"
+)
+
+const (
+	// DefaultTraceFilterMaxBlocks caps the block range of trace_filter when
+	// max_blocks_for_log does not
+	DefaultTraceFilterMaxBlocks = 2000
+
+	// number of txs read at once from the address tx index by trace_filter
+	traceFilterIndexPageSize = 1000
+)
+
+var (
+	flatCallTracerConfig = json.RawMessage(`{"convertParityErrors":true}`)
+	stateDiffConfig      = json.RawMessage(`{"diffMode":true}`)
+	outputTracerConfig   = json.RawMessage(`{"onlyTopCall":true}`)
+)
+
+// TraceAPI serves the OpenEthereum trace_* namespace on top of the go-ethereum
+// tracers: flat call traces come from flatCallTracer, state diffs from
+// prestateTracer in diff mode and VM traces from parityVmTracer.
+type TraceAPI struct {
+	tracersAPI     *tracers.API
+	tmClient       rpcclient.Client
+	keeper         *keeper.Keeper
+	ctxProvider    func(int64) sdk.Context
+	logFetcher     *LogFetcher
+	filterConfig   *FilterConfig
+	connectionType ConnectionType
+}
+
+func NewTraceAPI(tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, txDecoder sdk.TxDecoder, config *SimulateConfig, filterConfig *FilterConfig, connectionType ConnectionType) *TraceAPI {
+	backend := NewBackend(ctxProvider, k, txDecoder, tmClient, config)
+	return &TraceAPI{
+		tracersAPI:     tracers.NewAPI(backend),
+		tmClient:       tmClient,
+		keeper:         k,
+		ctxProvider:    ctxProvider,
+		logFetcher:     &LogFetcher{tmClient: tmClient, k: k, ctxProvider: ctxProvider, filterConfig: filterConfig},
+		filterConfig:   filterConfig,
+		connectionType: connectionType,
+	}
+}
+
+// TraceResults is the result of replaying a transaction. Trace types that were
+// not requested are null.
+type TraceResults struct {
+	Output          hexutil.Bytes     `json:"output"`
+	StateDiff       StateDiff         `json:"stateDiff"`
+	Trace           []json.RawMessage `json:"trace"`
+	VMTrace         json.RawMessage   `json:"vmTrace"`
+	TransactionHash *common.Hash      `json:"transactionHash,omitempty"`
+}
+
+// TraceFilterCriteria selects the traces returned by trace_filter. A trace
+// matches if its sender is in FromAddress and its recipient in ToAddress, where
+// an empty list matches any address.
+type TraceFilterCriteria struct {
+	FromBlock   *rpc.BlockNumber `json:"fromBlock"`
+	ToBlock     *rpc.BlockNumber `json:"toBlock"`
+	FromAddress []common.Address `json:"fromAddress"`
+	ToAddress   []common.Address `json:"toAddress"`
+	After       *uint64          `json:"after"`
+	Count       *uint64          `json:"count"`
+}
+
+type txTraceResult struct {
+	TxHash common.Hash     `json:"txHash"`
+	Result json.RawMessage `json:"result"`
+	Error  string          `json:"error"`
+}
+
+func (api *TraceAPI) Block(ctx context.Context, number rpc.BlockNumber) (result []json.RawMessage, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("trace_block", api.connectionType, startTime, returnErr == nil)
+	txResults, err := api.traceBlock(ctx, number, "flatCallTracer", flatCallTracerConfig)
+	if err != nil {
+		return nil, err
+	}
+	result = []json.RawMessage{}
+	for _, txResult := range txResults {
+		var traces []json.RawMessage
+		if err := json.Unmarshal(txResult.Result, &traces); err != nil {
+			return nil, err
+		}
+		result = append(result, traces...)
+	}
+	return result, nil
+}
+
+func (api *TraceAPI) Transaction(ctx context.Context, hash common.Hash) (result []json.RawMessage, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("trace_transaction", api.connectionType, startTime, returnErr == nil)
+	return api.traceTransaction(ctx, hash)
+}
+
+func (api *TraceAPI) ReplayBlockTransactions(ctx context.Context, number rpc.BlockNumber, traceTypes []string) (result []*TraceResults, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("trace_replayBlockTransactions", api.connectionType, startTime, returnErr == nil)
+	muxConfig := map[string]json.RawMessage{"callTracer": outputTracerConfig}
+	for _, traceType := range traceTypes {
+		switch traceType {
+		case TraceTypeTrace:
+			muxConfig["flatCallTracer"] = flatCallTracerConfig
+		case TraceTypeStateDiff:
+			muxConfig["prestateTracer"] = stateDiffConfig
+		case TraceTypeVMTrace:
+			muxConfig[ParityVMTracerName] = nil
+		default:
+			return nil, fmt.Errorf("invalid trace type %s, expected one of %s, %s or %s", traceType, TraceTypeTrace, TraceTypeStateDiff, TraceTypeVMTrace)
+		}
+	}
+	muxConfigBz, err := json.Marshal(muxConfig)
+	if err != nil {
+		return nil, err
+	}
+	txResults, err := api.traceBlock(ctx, number, "muxTracer", muxConfigBz)
+	if err != nil {
+		return nil, err
+	}
+	result = make([]*TraceResults, 0, len(txResults))
+	for _, txResult := range txResults {
+		var outputs map[string]json.RawMessage
+		if err := json.Unmarshal(txResult.Result, &outputs); err != nil {
+			return nil, err
+		}
+		res, err := newTraceResults(outputs)
+		if err != nil {
+			return nil, err
+		}
+		txHash := txResult.TxHash
+		res.TransactionHash = &txHash
+		result = append(result, res)
+	}
+	return result, nil
+}
+
+func (api *TraceAPI) Filter(ctx context.Context, crit TraceFilterCriteria) (result []json.RawMessage, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("trace_filter", api.connectionType, startTime, returnErr == nil)
+	latest := api.ctxProvider(LatestCtxHeight).BlockHeight()
+	begin, end := latest, latest
+	if crit.FromBlock != nil {
+		begin = getHeightFromBigIntBlockNumber(latest, big.NewInt(crit.FromBlock.Int64()))
+	}
+	if crit.ToBlock != nil {
+		end = getHeightFromBigIntBlockNumber(latest, big.NewInt(crit.ToBlock.Int64()))
+	}
+	if begin > end {
+		return nil, fmt.Errorf("fromBlock %d is after toBlock %d", begin, end)
+	}
+	maxBlocks := api.filterConfig.maxBlock
+	if maxBlocks <= 0 {
+		maxBlocks = DefaultTraceFilterMaxBlocks
+	}
+	if end-begin+1 > maxBlocks {
+		return nil, fmt.Errorf("block range of %d exceeds the maximum of %d blocks", end-begin+1, maxBlocks)
+	}
+
+	fromAddresses := addressSet(crit.FromAddress)
+	toAddresses := addressSet(crit.ToAddress)
+	heights, err := api.findTraceBlocks(max(begin, 1), end, crit)
+	if err != nil {
+		return nil, err
+	}
+	candidates, err := parallelMap(len(heights), api.logFetcher.workers(), func(i int) ([]common.Hash, error) {
+		return api.findTraceCandidates(heights[i], crit), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result = []json.RawMessage{}
+	skip := uint64(0)
+	if crit.After != nil {
+		skip = *crit.After
+	}
+	for _, txHashes := range candidates {
+		for _, txHash := range txHashes {
+			traces, err := api.traceTransaction(ctx, txHash)
+			if err != nil {
+				return nil, err
+			}
+			for _, trace := range traces {
+				if !traceMatchesAddresses(trace, fromAddresses, toAddresses) {
+					continue
+				}
+				if skip > 0 {
+					skip--
+					continue
+				}
+				result = append(result, trace)
+				if crit.Count != nil && uint64(len(result)) >= *crit.Count {
+					return result, nil
+				}
+			}
+		}
+	}
+	return result, nil
+}
+
+// findTraceBlocks returns the heights in [begin, end] that may contain a trace
+// matching the criteria. Like eth_getLogs, blocks are first filtered by their
+// bloom, which covers the addresses that emitted logs. Senders, recipients and
+// created contracts are not in any bloom, so they are looked up in the address
+// tx index; if it is disabled or pruned in the range, every block is a
+// candidate.
+func (api *TraceAPI) findTraceBlocks(begin, end int64, crit TraceFilterCriteria) ([]int64, error) {
+	addresses := append(append([]common.Address{}, crit.FromAddress...), crit.ToAddress...)
+	retention := api.keeper.AddressTxIndexRetention
+	latest := api.ctxProvider(LatestCtxHeight).BlockHeight()
+	if len(addresses) == 0 || !api.keeper.AddressTxIndexEnabled || (retention > 0 && begin <= latest-retention) {
+		heights := []int64{}
+		for height := begin; height <= end; height++ {
+			heights = append(heights, height)
+		}
+		return heights, nil
+	}
+	found := map[int64]struct{}{}
+	for _, height := range api.logFetcher.FindBlockesByBloom(begin, end, EncodeFilters(addresses, nil)) {
+		found[height] = struct{}{}
+	}
+	roles := []byte{types.AddressTxRoleFrom, types.AddressTxRoleTo, types.AddressTxRoleCreated}
+	for _, addr := range addresses {
+		after := &keeper.AddressTxPosition{Height: begin - 1, TxIndex: math.MaxUint32}
+		for after != nil {
+			txs, err := api.keeper.GetAddressTxs(addr, roles, after, traceFilterIndexPageSize, false)
+			if err != nil {
+				return nil, err
+			}
+			after = nil
+			for _, tx := range txs {
+				if tx.Height > end {
+					break
+				}
+				found[tx.Height] = struct{}{}
+				after = &keeper.AddressTxPosition{Height: tx.Height, TxIndex: tx.TxIndex}
+			}
+			if len(txs) < traceFilterIndexPageSize {
+				after = nil
+			}
+		}
+	}
+	heights := make([]int64, 0, len(found))
+	for height := range found {
+		heights = append(heights, height)
+	}
+	slices.Sort(heights)
+	return heights, nil
+}
+
+// findTraceCandidates returns the transactions of a block that may contain a
+// trace matching the criteria, based on the receipt index: the sender and
+// recipient of each transaction and the addresses in its logs bloom. Traces of
+// internal calls are therefore only found if their transaction was sent from
+// or to a filtered address, or if a filtered address emitted a log in it.
+func (api *TraceAPI) findTraceCandidates(height int64, crit TraceFilterCriteria) []common.Hash {
+	ctx := api.ctxProvider(LatestCtxHeight)
+	filterAll := len(crit.FromAddress) == 0 && len(crit.ToAddress) == 0
+	fromAddresses := addressSet(crit.FromAddress)
+	toAddresses := addressSet(crit.ToAddress)
+	bloomIndexes := EncodeFilters(append(append([]common.Address{}, crit.FromAddress...), crit.ToAddress...), nil)
+	res := []common.Hash{}
+	for _, hash := range api.keeper.GetTxHashesOnHeight(ctx, height) {
+		receipt, err := api.keeper.GetReceipt(ctx, hash)
+		if err != nil || receipt.TxType == ShellEVMTxType {
+			continue
+		}
+		_, fromMatches := fromAddresses[common.HexToAddress(receipt.From)]
+		_, toMatches := toAddresses[common.HexToAddress(receipt.To)]
+		_, createMatches := toAddresses[common.HexToAddress(receipt.ContractAddress)]
+		logsMatch := len(receipt.LogsBloom) > 0 && MatchFilters(ethtypes.Bloom(receipt.LogsBloom), bloomIndexes)
+		if filterAll || fromMatches || toMatches || createMatches || logsMatch {
+			res = append(res, hash)
+		}
+	}
+	return res
+}
+
+func (api *TraceAPI) traceTransaction(ctx context.Context, hash common.Hash) ([]json.RawMessage, error) {
+	tracer := "flatCallTracer"
+	result, err := api.tracersAPI.TraceTransaction(ctx, hash, &tracers.TraceConfig{Tracer: &tracer, TracerConfig: flatCallTracerConfig})
+	if err != nil {
+		return nil, err
+	}
+	raw, ok := result.(json.RawMessage)
+	if !ok {
+		return nil, errors.New("unexpected trace result")
+	}
+	var traces []json.RawMessage
+	if err := json.Unmarshal(raw, &traces); err != nil {
+		return nil, err
+	}
+	return traces, nil
+}
+
+func (api *TraceAPI) traceBlock(ctx context.Context, number rpc.BlockNumber, tracer string, tracerConfig json.RawMessage) ([]txTraceResult, error) {
+	results, err := api.tracersAPI.TraceBlockByNumber(ctx, number, &tracers.TraceConfig{Tracer: &tracer, TracerConfig: tracerConfig})
+	if err != nil {
+		return nil, err
+	}
+	// the result type of the tracers API is not exported
+	bz, err := json.Marshal(results)
+	if err != nil {
+		return nil, err
+	}
+	var txResults []txTraceResult
+	if err := json.Unmarshal(bz, &txResults); err != nil {
+		return nil, err
+	}
+	for _, txResult := range txResults {
+		if txResult.Error != "" {
+			return nil, fmt.Errorf("failed to trace transaction %s: %s", txResult.TxHash.Hex(), txResult.Error)
+		}
+	}
+	return txResults, nil
+}
+
+func newTraceResults(outputs map[string]json.RawMessage) (*TraceResults, error) {
+	res := &TraceResults{}
+	var top struct {
+		Output hexutil.Bytes `json:"output"`
+	}
+	if err := json.Unmarshal(outputs["callTracer"], &top); err != nil {
+		return nil, err
+	}
+	res.Output = top.Output
+	if res.Output == nil {
+		res.Output = hexutil.Bytes{}
+	}
+	if raw, ok := outputs["flatCallTracer"]; ok {
+		if err := json.Unmarshal(raw, &res.Trace); err != nil {
+			return nil, err
+		}
+	}
+	if raw, ok := outputs["prestateTracer"]; ok {
+		stateDiff, err := newStateDiff(raw)
+		if err != nil {
+			return nil, err
+		}
+		res.StateDiff = stateDiff
+	}
+	if raw, ok := outputs[ParityVMTracerName]; ok {
+		res.VMTrace = raw
+	}
+	return res, nil
+}
+
+func traceMatchesAddresses(trace json.RawMessage, fromAddresses, toAddresses map[common.Address]struct{}) bool {
+	var parsed struct {
+		Action struct {
+			From          *common.Address `json:"from"`
+			To            *common.Address `json:"to"`
+			Address       *common.Address `json:"address"`
+			RefundAddress *common.Address `json:"refundAddress"`
+		} `json:"action"`
+		Result *struct {
+			Address *common.Address `json:"address"`
+		} `json:"result"`
+	}
+	if err := json.Unmarshal(trace, &parsed); err != nil {
+		return false
+	}
+	// self-destructs are sent from the destructed contract to the refund address
+	from, to := parsed.Action.From, parsed.Action.To
+	if parsed.Action.Address != nil {
+		from, to = parsed.Action.Address, parsed.Action.RefundAddress
+	}
+	if to == nil && parsed.Result != nil {
+		// contract creations are sent to the created contract
+		to = parsed.Result.Address
+	}
+	return addressMatches(fromAddresses, from) && addressMatches(toAddresses, to)
+}
+
+func addressSet(addresses []common.Address) map[common.Address]struct{} {
+	set := make(map[common.Address]struct{}, len(addresses))
+	for _, addr := range addresses {
+		set[addr] = struct{}{}
+	}
+	return set
+}
+
+func addressMatches(set map[common.Address]struct{}, addr *common.Address) bool {
+	if len(set) == 0 {
+		return true
+	}
+	if addr == nil {
+		return false
+	}
+	_, ok := set[*addr]
+	return ok
+}
+
+// StateDiff is the OpenEthereum state diff of a transaction, by account.
+type StateDiff map[common.Address]*AccountDiff
+
+// AccountDiff holds the diffs of the fields of an account. Each diff is "=" if
+// the field is unchanged, {"+": value} if the account was created, {"-": value}
+// if it was destroyed and {"*": {"from": before, "to": after}} otherwise.
+type AccountDiff struct {
+	Balance interface{}                 `json:"balance"`
+	Code    interface{}                 `json:"code"`
+	Nonce   interface{}                 `json:"nonce"`
+	Storage map[common.Hash]interface{} `json:"storage"`
+}
+
+type prestateAccount struct {
+	Balance *hexutil.Big                `json:"balance"`
+	Code    hexutil.Bytes               `json:"code"`
+	Nonce   uint64                      `json:"nonce"`
+	Storage map[common.Hash]common.Hash `json:"storage"`
+}
+
+// newStateDiff converts the output of prestateTracer in diff mode, where pre
+// holds the modified accounts as they were and post only their changed fields,
+// into an OpenEthereum state diff.
+func newStateDiff(raw json.RawMessage) (StateDiff, error) {
+	var diff struct {
+		Pre  map[common.Address]*prestateAccount `json:"pre"`
+		Post map[common.Address]*prestateAccount `json:"post"`
+	}
+	if err := json.Unmarshal(raw, &diff); err != nil {
+		return nil, err
+	}
+	res := StateDiff{}
+	for addr, post := range diff.Post {
+		if _, ok := diff.Pre[addr]; ok {
+			continue
+		}
+		created := &AccountDiff{
+			Balance: map[string]interface{}{"+": bigOrZero(post.Balance)},
+			Code:    map[string]interface{}{"+": bytesOrEmpty(post.Code)},
+			Nonce:   map[string]interface{}{"+": hexutil.Uint64(post.Nonce)},
+			Storage: map[common.Hash]interface{}{},
+		}
+		for key, val := range post.Storage {
+			created.Storage[key] = map[string]interface{}{"+": val}
+		}
+		res[addr] = created
+	}
+	for addr, pre := range diff.Pre {
+		// modified accounts are always in post, so accounts only in pre were destroyed
+		post, ok := diff.Post[addr]
+		if !ok {
+			destroyed := &AccountDiff{
+				Balance: map[string]interface{}{"-": bigOrZero(pre.Balance)},
+				Code:    map[string]interface{}{"-": bytesOrEmpty(pre.Code)},
+				Nonce:   map[string]interface{}{"-": hexutil.Uint64(pre.Nonce)},
+				Storage: map[common.Hash]interface{}{},
+			}
+			for key, val := range pre.Storage {
+				destroyed.Storage[key] = map[string]interface{}{"-": val}
+			}
+			res[addr] = destroyed
+			continue
+		}
+		changed := &AccountDiff{Balance: "=", Code: "=", Nonce: "=", Storage: map[common.Hash]interface{}{}}
+		if post.Balance != nil && bigOrZero(pre.Balance).ToInt().Cmp(post.Balance.ToInt()) != 0 {
+			changed.Balance = fieldChange(bigOrZero(pre.Balance), post.Balance)
+		}
+		if post.Code != nil {
+			changed.Code = fieldChange(bytesOrEmpty(pre.Code), post.Code)
+		}
+		if post.Nonce != 0 && post.Nonce != pre.Nonce {
+			changed.Nonce = fieldChange(hexutil.Uint64(pre.Nonce), hexutil.Uint64(post.Nonce))
+		}
+		for key, before := range pre.Storage {
+			// slots cleared by the transaction are absent from post
+			after := post.Storage[key]
+			if before != after {
+				changed.Storage[key] = fieldChange(before, after)
+			}
+		}
+		for key, after := range post.Storage {
+			if _, ok := pre.Storage[key]; !ok {
+				changed.Storage[key] = fieldChange(common.Hash{}, after)
+			}
+		}
+		res[addr] = changed
+	}
+	return res, nil
+}
+
+func fieldChange(from interface{}, to interface{}) map[string]interface{} {
+	return map[string]interface{}{"*": map[string]interface{}{"from": from, "to": to}}
+}
+
+func bigOrZero(b *hexutil.Big) *hexutil.Big {
+	if b == nil {
+		return (*hexutil.Big)(new(big.Int))
+	}
+	return b
+}
+
+func bytesOrEmpty(b hexutil.Bytes) hexutil.Bytes {
+	if b == nil {
+		return hexutil.Bytes{}
+	}
+	return b
+}
@@ -0,0 +1,229 @@
+package evmrpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	pcommon "github.com/kiichain/kiichain3/precompiles/common"
+)
+
+// CosmosCallTracerName is the name under which the call tracer that also shows
+// the Cosmos side of precompile calls is registered.
+const CosmosCallTracerName = "cosmosCallTracer"
+
+func init() {
+	tracers.DefaultDirectory.Register(CosmosCallTracerName, newCosmosCallTracer, false)
+}
+
+// cosmosCallFrame is a call frame in the format of callTracer, with the
+// Cosmos side of the call if it is a precompile call.
+type cosmosCallFrame struct {
+	Type       string               `json:"type"`
+	From       common.Address       `json:"from"`
+	To         common.Address       `json:"to"`
+	Value      *hexutil.Big         `json:"value,omitempty"`
+	Gas        hexutil.Uint64       `json:"gas"`
+	GasUsed    hexutil.Uint64       `json:"gasUsed"`
+	Input      hexutil.Bytes        `json:"input"`
+	Output     hexutil.Bytes        `json:"output,omitempty"`
+	Error      string               `json:"error,omitempty"`
+	Precompile *cosmosPrecompileRun `json:"precompile,omitempty"`
+	Calls      []*cosmosCallFrame   `json:"calls,omitempty"`
+
+	msgs []sdk.Msg // Cosmos messages dispatched so far by the precompile
+}
+
+type cosmosPrecompileRun struct {
+	Name          string                 `json:"name"`
+	Method        string                 `json:"method"`
+	Args          map[string]interface{} `json:"args"`
+	Messages      []json.RawMessage      `json:"messages"`
+	Events        []cosmosEvent          `json:"events"`
+	CosmosGasUsed hexutil.Uint64         `json:"cosmosGasUsed"`
+}
+
+type cosmosEvent struct {
+	Type       string                 `json:"type"`
+	Attributes []cosmosEventAttribute `json:"attributes"`
+}
+
+type cosmosEventAttribute struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// cosmosCallTracer records the call frames of a tx like callTracer. Frames of
+// precompile calls also carry the decoded method and arguments, the Cosmos
+// messages the precompile dispatched, the SDK events it emitted and the Cosmos
+// gas it consumed, which precompiles report through the context of the StateDB.
+type cosmosCallTracer struct {
+	stateDB tracing.StateDB
+	frames  []*cosmosCallFrame
+	root    *cosmosCallFrame
+	reason  error
+}
+
+var _ pcommon.PrecompileTracer = &cosmosCallTracer{}
+
+// contextStateDB is implemented by the StateDB of the EVM module.
+type contextStateDB interface {
+	Ctx() sdk.Context
+	WithCtx(sdk.Context)
+}
+
+func newCosmosCallTracer(_ *tracers.Context, _ json.RawMessage) (*tracers.Tracer, error) {
+	t := &cosmosCallTracer{}
+	return &tracers.Tracer{
+		Hooks: &tracing.Hooks{
+			OnTxStart: t.OnTxStart,
+			OnTxEnd:   t.OnTxEnd,
+			OnEnter:   t.OnEnter,
+			OnExit:    t.OnExit,
+		},
+		GetResult: t.GetResult,
+		Stop:      t.Stop,
+	}, nil
+}
+
+func (t *cosmosCallTracer) OnTxStart(env *tracing.VMContext, _ *ethtypes.Transaction, _ common.Address) {
+	t.stateDB = env.StateDB
+	if db, ok := t.stateDB.(contextStateDB); ok {
+		db.WithCtx(pcommon.WithPrecompileTracer(db.Ctx(), t))
+	}
+}
+
+func (t *cosmosCallTracer) OnTxEnd(*ethtypes.Receipt, error) {
+	// the StateDB may be reused for the next tx of the block
+	if db, ok := t.stateDB.(contextStateDB); ok {
+		db.WithCtx(pcommon.WithPrecompileTracer(db.Ctx(), nil))
+	}
+}
+
+func (t *cosmosCallTracer) OnEnter(_ int, typ byte, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	op := vm.OpCode(typ)
+	frame := &cosmosCallFrame{
+		Type:  op.String(),
+		From:  from,
+		To:    to,
+		Gas:   hexutil.Uint64(gas),
+		Input: common.CopyBytes(input),
+	}
+	if value != nil && op != vm.STATICCALL && op != vm.DELEGATECALL {
+		frame.Value = (*hexutil.Big)(new(big.Int).Set(value))
+	}
+	if len(t.frames) > 0 {
+		parent := t.frames[len(t.frames)-1]
+		parent.Calls = append(parent.Calls, frame)
+	} else {
+		t.root = frame
+	}
+	t.frames = append(t.frames, frame)
+}
+
+func (t *cosmosCallTracer) OnExit(_ int, output []byte, gasUsed uint64, err error, reverted bool) {
+	if len(t.frames) == 0 {
+		return
+	}
+	frame := t.frames[len(t.frames)-1]
+	t.frames = t.frames[:len(t.frames)-1]
+	frame.GasUsed = hexutil.Uint64(gasUsed)
+	frame.Output = common.CopyBytes(output)
+	if err != nil {
+		frame.Error = err.Error()
+		if !reverted {
+			frame.Output = nil
+		}
+	}
+}
+
+func (t *cosmosCallTracer) OnCosmosMsg(msg sdk.Msg) {
+	if len(t.frames) == 0 {
+		return
+	}
+	frame := t.frames[len(t.frames)-1]
+	frame.msgs = append(frame.msgs, msg)
+}
+
+func (t *cosmosCallTracer) OnPrecompileCall(call *pcommon.PrecompileCall) {
+	if len(t.frames) == 0 {
+		return
+	}
+	frame := t.frames[len(t.frames)-1]
+	if frame.To != call.Address {
+		return
+	}
+	run := &cosmosPrecompileRun{
+		Name:          call.Name,
+		Method:        call.Method.Name,
+		Args:          map[string]interface{}{},
+		Messages:      []json.RawMessage{},
+		Events:        []cosmosEvent{},
+		CosmosGasUsed: hexutil.Uint64(call.CosmosGasUsed),
+	}
+	for i, arg := range call.Args {
+		name := fmt.Sprintf("arg%d", i)
+		if i < len(call.Method.Inputs) && call.Method.Inputs[i].Name != "" {
+			name = call.Method.Inputs[i].Name
+		}
+		run.Args[name] = cosmosTraceArg(arg)
+	}
+	for _, msg := range frame.msgs {
+		run.Messages = append(run.Messages, cosmosTraceMsg(msg))
+	}
+	for _, event := range call.Events {
+		e := cosmosEvent{Type: event.Type, Attributes: []cosmosEventAttribute{}}
+		for _, attr := range event.Attributes {
+			e.Attributes = append(e.Attributes, cosmosEventAttribute{Key: string(attr.Key), Value: string(attr.Value)})
+		}
+		run.Events = append(run.Events, e)
+	}
+	frame.Precompile = run
+}
+
+func (t *cosmosCallTracer) GetResult() (json.RawMessage, error) {
+	if t.root == nil {
+		return json.RawMessage("null"), t.reason
+	}
+	res, err := json.Marshal(t.root)
+	if err != nil {
+		return nil, err
+	}
+	return res, t.reason
+}
+
+func (t *cosmosCallTracer) Stop(err error) {
+	t.reason = err
+}
+
+// cosmosTraceArg returns the JSON rendering of a precompile argument: bytes in
+// hex and integers in decimal.
+func cosmosTraceArg(arg interface{}) interface{} {
+	switch arg := arg.(type) {
+	case []byte:
+		return hexutil.Bytes(arg)
+	case *big.Int:
+		return arg.String()
+	}
+	return arg
+}
+
+// cosmosTraceMsg returns the JSON rendering of a Cosmos message, with its type
+// URL under "@type" as in the JSON of an Any.
+func cosmosTraceMsg(msg sdk.Msg) json.RawMessage {
+	fields := map[string]json.RawMessage{}
+	if bz, err := codec.ProtoMarshalJSON(msg, nil); err == nil {
+		_ = json.Unmarshal(bz, &fields)
+	}
+	fields["@type"], _ = json.Marshal(sdk.MsgTypeURL(msg))
+	bz, _ := json.Marshal(fields)
+	return bz
+}
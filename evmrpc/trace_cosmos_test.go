@@ -0,0 +1,77 @@
+package evmrpc_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/kiichain/kiichain3/evmrpc"
+	pcommon "github.com/kiichain/kiichain3/precompiles/common"
+	"github.com/kiichain/kiichain3/x/evm/state"
+	"github.com/stretchr/testify/require"
+)
+
+const sendABI = `[{"name":"send","type":"function","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"success","type":"bool"}]}]`
+
+type sendExecutor struct{}
+
+func (sendExecutor) RequiredGas([]byte, *abi.Method) uint64 { return 0 }
+
+func (sendExecutor) Execute(ctx sdk.Context, method *abi.Method, caller common.Address, _ common.Address, args []interface{}, _ *big.Int, _ bool, _ *vm.EVM) ([]byte, error) {
+	from, to := sdk.AccAddress(caller[:]), sdk.AccAddress(args[0].(common.Address).Bytes())
+	coins := sdk.NewCoins(sdk.NewCoin("ukii", sdk.NewIntFromBigInt(args[1].(*big.Int))))
+	pcommon.TraceCosmosMsg(ctx, banktypes.NewMsgSend(from, to, coins))
+	ctx.EventManager().EmitEvent(sdk.NewEvent("transfer", sdk.NewAttribute("amount", coins.String())))
+	return method.Outputs.Pack(true)
+}
+
+func TestCosmosCallTracer(t *testing.T) {
+	parsed, err := abi.JSON(strings.NewReader(sendABI))
+	require.Nil(t, err)
+	addr := common.HexToAddress("0x0000000000000000000000000000000000001001")
+	precompile := pcommon.NewPrecompile(parsed, sendExecutor{}, addr, "send")
+	caller, to := common.HexToAddress("0x1234"), common.HexToAddress("0x5678")
+	input, err := parsed.Pack("send", to, big.NewInt(42))
+	require.Nil(t, err)
+
+	ctx, _ := Ctx.CacheContext()
+	stateDB := state.NewDBImpl(ctx, EVMKeeper, true)
+	tracer, err := tracers.DefaultDirectory.New(evmrpc.CosmosCallTracerName, &tracers.Context{}, nil)
+	require.Nil(t, err)
+	tracer.OnTxStart(&tracing.VMContext{StateDB: stateDB}, nil, caller)
+	tracer.OnEnter(0, byte(vm.CALL), caller, addr, input, 100000, big.NewInt(0))
+	output, err := precompile.Run(&vm.EVM{StateDB: stateDB}, caller, caller, input, big.NewInt(0), false, false)
+	require.Nil(t, err)
+	tracer.OnExit(0, output, 3000, nil, false)
+	tracer.OnTxEnd(nil, nil)
+	require.Nil(t, pcommon.GetPrecompileTracer(stateDB.Ctx()))
+
+	bz, err := tracer.GetResult()
+	require.Nil(t, err)
+	res := map[string]interface{}{}
+	require.Nil(t, json.Unmarshal(bz, &res))
+	require.Equal(t, "CALL", res["type"])
+	require.Equal(t, "0xbb8", res["gasUsed"])
+	run := res["precompile"].(map[string]interface{})
+	require.Equal(t, "send", run["name"])
+	require.Equal(t, "send", run["method"])
+	require.Equal(t, map[string]interface{}{"to": strings.ToLower(to.Hex()), "amount": "42"}, run["args"])
+	require.Equal(t, []interface{}{map[string]interface{}{
+		"@type":        "/cosmos.bank.v1beta1.MsgSend",
+		"from_address": sdk.AccAddress(caller[:]).String(),
+		"to_address":   sdk.AccAddress(to[:]).String(),
+		"amount":       []interface{}{map[string]interface{}{"denom": "ukii", "amount": "42"}},
+	}}, run["messages"])
+	require.Equal(t, []interface{}{map[string]interface{}{
+		"type":       "transfer",
+		"attributes": []interface{}{map[string]interface{}{"key": "amount", "value": "42ukii"}},
+	}}, run["events"])
+}
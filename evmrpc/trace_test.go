@@ -0,0 +1,123 @@
+package evmrpc_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/kiichain/kiichain3/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	debugTraceFrom = "0x5b4eba929f3811980f5ae0c5d04fa200f837df4e"
+	debugTraceTo   = "0x0000000000000000000000000000000000010203"
+)
+
+func requireDebugTraceCall(t *testing.T, trace map[string]interface{}) {
+	require.Equal(t, "call", trace["type"])
+	action := trace["action"].(map[string]interface{})
+	require.Equal(t, debugTraceFrom, action["from"])
+	require.Equal(t, debugTraceTo, action["to"])
+	require.Equal(t, "0x616263", action["input"])
+	require.Equal(t, "0x3e8", action["value"])
+	require.Equal(t, "call", action["callType"])
+	require.Equal(t, float64(DebugTraceMockHeight), trace["blockNumber"])
+}
+
+func TestTraceBlock(t *testing.T) {
+	resObj := sendRequestGoodWithNamespace(t, "trace", "block", "0x65")
+	result := resObj["result"].([]interface{})
+	require.Len(t, result, 1)
+	requireDebugTraceCall(t, result[0].(map[string]interface{}))
+}
+
+func TestTraceTransactionFlat(t *testing.T) {
+	resObj := sendRequestGoodWithNamespace(t, "trace", "transaction", DebugTraceHashHex)
+	result := resObj["result"].([]interface{})
+	require.Len(t, result, 1)
+	requireDebugTraceCall(t, result[0].(map[string]interface{}))
+	require.Equal(t, DebugTraceHashHex, result[0].(map[string]interface{})["transactionHash"])
+}
+
+func TestTraceReplayBlockTransactions(t *testing.T) {
+	resObj := sendRequestGoodWithNamespace(t, "trace", "replayBlockTransactions", "0x65", []interface{}{"trace", "stateDiff", "vmTrace"})
+	result := resObj["result"].([]interface{})
+	require.Len(t, result, 1)
+	replay := result[0].(map[string]interface{})
+	require.NotEmpty(t, replay["transactionHash"])
+	require.Contains(t, replay, "output")
+	requireDebugTraceCall(t, replay["trace"].([]interface{})[0].(map[string]interface{}))
+	stateDiff := replay["stateDiff"].(map[string]interface{})
+	sender := stateDiff[debugTraceFrom].(map[string]interface{})
+	require.Contains(t, sender["balance"], "*")
+	require.Contains(t, sender["nonce"], "*")
+	require.NotNil(t, replay["vmTrace"])
+	require.Contains(t, replay["vmTrace"], "ops")
+
+	// trace types that were not requested are null
+	resObj = sendRequestGoodWithNamespace(t, "trace", "replayBlockTransactions", "0x65", []interface{}{"trace"})
+	replay = resObj["result"].([]interface{})[0].(map[string]interface{})
+	require.Nil(t, replay["stateDiff"])
+	require.Nil(t, replay["vmTrace"])
+	require.NotNil(t, replay["trace"])
+
+	resObj = sendRequestGoodWithNamespace(t, "trace", "replayBlockTransactions", "0x65", []interface{}{"bad"})
+	require.Contains(t, resObj["error"].(map[string]interface{})["message"], "invalid trace type")
+}
+
+func TestTraceFilter(t *testing.T) {
+	resObj := sendRequestGoodWithNamespace(t, "trace", "filter", map[string]interface{}{
+		"fromBlock":   "0x65",
+		"toBlock":     "0x65",
+		"fromAddress": []common.Address{common.HexToAddress(debugTraceFrom)},
+	})
+	result := resObj["result"].([]interface{})
+	require.Len(t, result, 1)
+	requireDebugTraceCall(t, result[0].(map[string]interface{}))
+
+	resObj = sendRequestGoodWithNamespace(t, "trace", "filter", map[string]interface{}{
+		"fromBlock": "0x65",
+		"toBlock":   "0x65",
+		"toAddress": []common.Address{common.HexToAddress("0x1111111111111111111111111111111111111111")},
+	})
+	require.Empty(t, resObj["result"])
+
+	resObj = sendRequestGoodWithNamespace(t, "trace", "filter", map[string]interface{}{
+		"fromBlock": "0x65",
+		"toBlock":   "0x65",
+		"after":     1,
+	})
+	require.Empty(t, resObj["result"])
+	resObj = sendRequestGoodWithNamespace(t, "trace", "filter", map[string]interface{}{
+		"fromBlock": "0x1",
+		"toBlock":   "0x1000",
+	})
+	require.Equal(t, "block range of 4096 exceeds the maximum of 2000 blocks", resObj["error"].(map[string]interface{})["message"])
+}
+
+func TestTraceFilterAddressTxIndex(t *testing.T) {
+	EVMKeeper.AddressTxIndexEnabled = true
+	defer func() { EVMKeeper.AddressTxIndexEnabled = false }()
+	crit := map[string]interface{}{
+		"fromBlock":   "0x60",
+		"toBlock":     "0x65",
+		"fromAddress": []common.Address{common.HexToAddress(debugTraceFrom)},
+	}
+	// only the blocks of the txs of the address are traced
+	resObj := sendRequestGoodWithNamespace(t, "trace", "filter", crit)
+	require.Nil(t, resObj["error"])
+	require.Empty(t, resObj["result"])
+
+	ctx, _ := Ctx.CacheContext()
+	EVMKeeper.IndexAddressTxs(ctx, &types.Receipt{TxHashHex: DebugTraceHashHex, BlockNumber: DebugTraceMockHeight, From: debugTraceFrom, To: debugTraceTo})
+	require.Nil(t, EVMKeeper.FlushTransientReceipts(ctx))
+	require.Eventually(t, func() bool {
+		txs, err := EVMKeeper.GetAddressTxs(common.HexToAddress(debugTraceFrom), nil, nil, 10, false)
+		return err == nil && len(txs) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	resObj = sendRequestGoodWithNamespace(t, "trace", "filter", crit)
+	result := resObj["result"].([]interface{})
+	require.Len(t, result, 1)
+	requireDebugTraceCall(t, result[0].(map[string]interface{}))
+}
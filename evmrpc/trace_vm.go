@@ -0,0 +1,232 @@
+package evmrpc
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/holiman/uint256"
+)
+
+// ParityVMTracerName is the name under which the tracer producing the
+// OpenEthereum vmTrace format is registered.
+const ParityVMTracerName = "parityVmTracer"
+
+func init() {
+	tracers.DefaultDirectory.Register(ParityVMTracerName, newParityVMTracer, false)
+}
+
+type vmTrace struct {
+	Code hexutil.Bytes       `json:"code"`
+	Ops  []*vmTraceOperation `json:"ops"`
+}
+
+type vmTraceOperation struct {
+	Cost uint64     `json:"cost"`
+	Ex   *vmTraceEx `json:"ex"`
+	Pc   uint64     `json:"pc"`
+	Sub  *vmTrace   `json:"sub"`
+
+	gas     uint64        // gas available before the operation
+	mem     *vmTraceMem   // memory written by the operation, data is filled in afterward
+	store   *vmTraceStore // storage written by the operation
+	push    int           // number of stack items pushed by the operation
+	faulted bool          // faulted operations have no effects
+}
+
+type vmTraceEx struct {
+	Mem   *vmTraceMem   `json:"mem"`
+	Push  []string      `json:"push"`
+	Store *vmTraceStore `json:"store"`
+	Used  uint64        `json:"used"`
+}
+
+type vmTraceMem struct {
+	Data hexutil.Bytes `json:"data"`
+	Off  uint64        `json:"off"`
+	size uint64
+}
+
+type vmTraceStore struct {
+	Key string `json:"key"`
+	Val string `json:"val"`
+}
+
+type vmTraceFrame struct {
+	trace   *vmTrace
+	pending *vmTraceOperation // last operation, whose effects are known once the next one starts
+}
+
+// parityVMTracer records every executed operation along with its effects on
+// the stack, memory and storage, nesting the operations of sub calls.
+type parityVMTracer struct {
+	env    *tracing.VMContext
+	frames []*vmTraceFrame
+	root   *vmTrace
+	reason error
+}
+
+func newParityVMTracer(_ *tracers.Context, _ json.RawMessage) (*tracers.Tracer, error) {
+	t := &parityVMTracer{}
+	return &tracers.Tracer{
+		Hooks: &tracing.Hooks{
+			OnTxStart: t.OnTxStart,
+			OnEnter:   t.OnEnter,
+			OnExit:    t.OnExit,
+			OnOpcode:  t.OnOpcode,
+			OnFault:   t.OnFault,
+		},
+		GetResult: t.GetResult,
+		Stop:      t.Stop,
+	}, nil
+}
+
+func (t *parityVMTracer) OnTxStart(env *tracing.VMContext, _ *ethtypes.Transaction, _ common.Address) {
+	t.env = env
+}
+
+func (t *parityVMTracer) OnEnter(_ int, typ byte, _ common.Address, to common.Address, input []byte, _ uint64, _ *big.Int) {
+	trace := &vmTrace{Ops: []*vmTraceOperation{}}
+	switch vm.OpCode(typ) {
+	case vm.CREATE, vm.CREATE2:
+		trace.Code = input
+	default:
+		if t.env != nil && t.env.StateDB != nil {
+			trace.Code = t.env.StateDB.GetCode(to)
+		}
+	}
+	if len(t.frames) > 0 {
+		if parent := t.frames[len(t.frames)-1]; parent.pending != nil {
+			parent.pending.Sub = trace
+		}
+	} else {
+		t.root = trace
+	}
+	t.frames = append(t.frames, &vmTraceFrame{trace: trace})
+}
+
+func (t *parityVMTracer) OnExit(_ int, _ []byte, _ uint64, _ error, _ bool) {
+	if len(t.frames) == 0 {
+		return
+	}
+	frame := t.frames[len(t.frames)-1]
+	t.frames = t.frames[:len(t.frames)-1]
+	// the frame ended, so nothing observes the effects of its last operation
+	if op := frame.pending; op != nil && !op.faulted {
+		op.Ex = &vmTraceEx{Push: []string{}, Store: op.store, Used: op.gas - min(op.gas, op.Cost)}
+	}
+}
+
+func (t *parityVMTracer) OnOpcode(pc uint64, opcode byte, gas, cost uint64, scope tracing.OpContext, _ []byte, _ int, _ error) {
+	if len(t.frames) == 0 {
+		return
+	}
+	frame := t.frames[len(t.frames)-1]
+	stack := scope.StackData()
+	if op := frame.pending; op != nil && !op.faulted {
+		op.Ex = &vmTraceEx{Push: []string{}, Store: op.store, Used: gas}
+		for i := min(op.push, len(stack)); i > 0; i-- {
+			op.Ex.Push = append(op.Ex.Push, stack[len(stack)-i].Hex())
+		}
+		if op.mem != nil {
+			memory := scope.MemoryData()
+			if op.mem.size <= uint64(len(memory)) && op.mem.Off <= uint64(len(memory))-op.mem.size {
+				op.mem.Data = common.CopyBytes(memory[op.mem.Off : op.mem.Off+op.mem.size])
+				op.Ex.Mem = op.mem
+			}
+		}
+	}
+
+	op := vm.OpCode(opcode)
+	next := &vmTraceOperation{Cost: cost, Pc: pc, gas: gas, push: vmTracePushCount(op)}
+	back := func(i int) *uint256.Int { return &stack[len(stack)-1-i] }
+	if op == vm.SSTORE && len(stack) >= 2 {
+		next.store = &vmTraceStore{Key: back(0).Hex(), Val: back(1).Hex()}
+	}
+	if offIdx, sizeIdx, size, ok := vmTraceMemoryWrite(op); ok && len(stack) > max(offIdx, sizeIdx) {
+		if sizeIdx >= 0 {
+			if !back(sizeIdx).IsUint64() {
+				size = 0
+			} else {
+				size = back(sizeIdx).Uint64()
+			}
+		}
+		if back(offIdx).IsUint64() && size > 0 {
+			next.mem = &vmTraceMem{Off: back(offIdx).Uint64(), size: size}
+		}
+	}
+	frame.trace.Ops = append(frame.trace.Ops, next)
+	frame.pending = next
+}
+
+func (t *parityVMTracer) OnFault(_ uint64, _ byte, _, _ uint64, _ tracing.OpContext, _ int, _ error) {
+	if len(t.frames) == 0 {
+		return
+	}
+	// a faulted operation has no effects
+	if op := t.frames[len(t.frames)-1].pending; op != nil {
+		op.faulted = true
+		op.Ex = nil
+	}
+}
+
+func (t *parityVMTracer) GetResult() (json.RawMessage, error) {
+	if t.root == nil {
+		return json.RawMessage("null"), t.reason
+	}
+	res, err := json.Marshal(t.root)
+	if err != nil {
+		return nil, err
+	}
+	return res, t.reason
+}
+
+func (t *parityVMTracer) Stop(err error) {
+	t.reason = err
+}
+
+// vmTracePushCount returns the number of stack items reported as pushed by an
+// operation. As in OpenEthereum, DUPn and SWAPn report every item they touch.
+func vmTracePushCount(op vm.OpCode) int {
+	switch {
+	case op.IsPush():
+		return 1
+	case op >= vm.DUP1 && op <= vm.DUP16:
+		return int(op-vm.DUP1) + 2
+	case op >= vm.SWAP1 && op <= vm.SWAP16:
+		return int(op-vm.SWAP1) + 2
+	}
+	switch op {
+	case vm.STOP, vm.POP, vm.MSTORE, vm.MSTORE8, vm.SSTORE, vm.TSTORE, vm.JUMP, vm.JUMPI, vm.JUMPDEST,
+		vm.LOG0, vm.LOG1, vm.LOG2, vm.LOG3, vm.LOG4, vm.RETURN, vm.REVERT, vm.SELFDESTRUCT, vm.INVALID,
+		vm.CALLDATACOPY, vm.CODECOPY, vm.EXTCODECOPY, vm.RETURNDATACOPY, vm.MCOPY:
+		return 0
+	}
+	return 1
+}
+
+// vmTraceMemoryWrite returns the stack positions (from the top) of the offset
+// and size of the memory written by an operation. Operations writing a fixed
+// size return a negative size position and the size itself.
+func vmTraceMemoryWrite(op vm.OpCode) (offIdx int, sizeIdx int, size uint64, ok bool) {
+	switch op {
+	case vm.MSTORE:
+		return 0, -1, 32, true
+	case vm.MSTORE8:
+		return 0, -1, 1, true
+	case vm.CALLDATACOPY, vm.CODECOPY, vm.RETURNDATACOPY, vm.MCOPY:
+		return 0, 2, 0, true
+	case vm.EXTCODECOPY:
+		return 1, 3, 0, true
+	case vm.CALL, vm.CALLCODE:
+		return 5, 6, 0, true
+	case vm.DELEGATECALL, vm.STATICCALL:
+		return 4, 5, 0, true
+	}
+	return 0, 0, 0, false
+}
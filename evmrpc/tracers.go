@@ -6,6 +6,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/eth/tracers"
 	_ "github.com/ethereum/go-ethereum/eth/tracers/native" // run init()s to register native tracers
 	"github.com/ethereum/go-ethereum/lib/ethapi"
@@ -16,22 +17,31 @@ import (
 
 type DebugAPI struct {
 	tracersAPI     *tracers.API
+	backend        *Backend
 	tmClient       rpcclient.Client
 	keeper         *keeper.Keeper
 	ctxProvider    func(int64) sdk.Context
 	txDecoder      sdk.TxDecoder
+	archive        *ArchiveFallback
 	connectionType ConnectionType
 }
 
-func NewDebugAPI(tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, txDecoder sdk.TxDecoder, config *SimulateConfig, connectionType ConnectionType) *DebugAPI {
+func NewDebugAPI(tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, txDecoder sdk.TxDecoder, config *SimulateConfig, archive *ArchiveFallback, connectionType ConnectionType) *DebugAPI {
 	backend := NewBackend(ctxProvider, k, txDecoder, tmClient, config)
 	tracersAPI := tracers.NewAPI(backend)
-	return &DebugAPI{tracersAPI: tracersAPI, tmClient: tmClient, keeper: k, ctxProvider: ctxProvider, txDecoder: txDecoder, connectionType: connectionType}
+	return &DebugAPI{tracersAPI: tracersAPI, backend: backend, tmClient: tmClient, keeper: k, ctxProvider: ctxProvider, txDecoder: txDecoder, archive: archive, connectionType: connectionType}
 }
 
 func (api *DebugAPI) TraceTransaction(ctx context.Context, hash common.Hash, config *tracers.TraceConfig) (result interface{}, returnErr error) {
 	startTime := time.Now()
 	defer recordMetrics("debug_traceTransaction", api.connectionType, startTime, returnErr == nil)
+	if api.archive != nil {
+		if receipt, err := api.keeper.GetReceipt(api.ctxProvider(LatestCtxHeight), hash); err == nil {
+			if err := api.checkTraceState(int64(receipt.BlockNumber)); err != nil {
+				return api.archive.forwardRaw(ctx, err, "debug_traceTransaction", hash, config)
+			}
+		}
+	}
 	result, returnErr = api.tracersAPI.TraceTransaction(ctx, hash, config)
 	return
 }
@@ -39,6 +49,11 @@ func (api *DebugAPI) TraceTransaction(ctx context.Context, hash common.Hash, con
 func (api *DebugAPI) TraceBlockByNumber(ctx context.Context, number rpc.BlockNumber, config *tracers.TraceConfig) (result interface{}, returnErr error) {
 	startTime := time.Now()
 	defer recordMetrics("debug_traceBlockByNumber", api.connectionType, startTime, returnErr == nil)
+	if number >= 0 {
+		if err := api.checkTraceState(number.Int64()); err != nil {
+			return api.archive.forwardRaw(ctx, err, "debug_traceBlockByNumber", number, config)
+		}
+	}
 	result, returnErr = api.tracersAPI.TraceBlockByNumber(ctx, number, config)
 	return
 }
@@ -46,13 +61,46 @@ func (api *DebugAPI) TraceBlockByNumber(ctx context.Context, number rpc.BlockNum
 func (api *DebugAPI) TraceBlockByHash(ctx context.Context, hash common.Hash, config *tracers.TraceConfig) (result interface{}, returnErr error) {
 	startTime := time.Now()
 	defer recordMetrics("debug_traceBlockByHash", api.connectionType, startTime, returnErr == nil)
+	if api.archive != nil {
+		if block, err := blockByHash(ctx, api.tmClient, hash[:]); err == nil {
+			if err := api.checkTraceState(block.Block.Height); err != nil {
+				return api.archive.forwardRaw(ctx, err, "debug_traceBlockByHash", hash, config)
+			}
+		}
+	}
 	result, returnErr = api.tracersAPI.TraceBlockByHash(ctx, hash, config)
 	return
 }
 
+// TraceBlock traces a block given in its RLP encoding, as returned by
+// debug_getRawBlock.
+func (api *DebugAPI) TraceBlock(ctx context.Context, blob hexutil.Bytes, config *tracers.TraceConfig) (result interface{}, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("debug_traceBlock", api.connectionType, startTime, returnErr == nil)
+	result, returnErr = api.tracersAPI.TraceBlock(ctx, blob, config)
+	return
+}
+
 func (api *DebugAPI) TraceCall(ctx context.Context, args ethapi.TransactionArgs, blockNrOrHash rpc.BlockNumberOrHash, config *tracers.TraceCallConfig) (result interface{}, returnErr error) {
 	startTime := time.Now()
 	defer recordMetrics("debug_traceCall", api.connectionType, startTime, returnErr == nil)
+	if api.archive != nil {
+		if height, err := GetBlockNumberByNrOrHash(ctx, api.tmClient, blockNrOrHash); err == nil && height != nil {
+			if err := api.checkTraceState(*height); err != nil {
+				return api.archive.forwardRaw(ctx, err, "debug_traceCall", args, blockNrOrHash, config)
+			}
+		}
+	}
 	result, returnErr = api.tracersAPI.TraceCall(ctx, args, blockNrOrHash, config)
 	return
 }
+
+// checkTraceState returns an error if the state on top of which the block at a
+// height is traced, that of its parent, isn't available on this node. It is
+// only checked if there is an archive node to forward the trace to.
+func (api *DebugAPI) checkTraceState(height int64) error {
+	if api.archive == nil || height <= 0 {
+		return nil
+	}
+	return CheckVersion(api.ctxProvider(height-1), api.keeper)
+}
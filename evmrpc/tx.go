@@ -33,16 +33,21 @@ type TransactionAPI struct {
 	ctxProvider    func(int64) sdk.Context
 	txConfig       client.TxConfig
 	homeDir        string
+	cache          *ResponseCache
+	archive        *ArchiveFallback
 	connectionType ConnectionType
 }
 
-func NewTransactionAPI(tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, txConfig client.TxConfig, homeDir string, connectionType ConnectionType) *TransactionAPI {
-	return &TransactionAPI{tmClient: tmClient, keeper: k, ctxProvider: ctxProvider, txConfig: txConfig, homeDir: homeDir, connectionType: connectionType}
+func NewTransactionAPI(tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider func(int64) sdk.Context, txConfig client.TxConfig, homeDir string, cache *ResponseCache, archive *ArchiveFallback, connectionType ConnectionType) *TransactionAPI {
+	return &TransactionAPI{tmClient: tmClient, keeper: k, ctxProvider: ctxProvider, txConfig: txConfig, homeDir: homeDir, cache: cache, archive: archive, connectionType: connectionType}
 }
 
 func (t *TransactionAPI) GetTransactionReceipt(ctx context.Context, hash common.Hash) (result map[string]interface{}, returnErr error) {
 	startTime := time.Now()
 	defer recordMetrics("eth_getTransactionReceipt", t.connectionType, startTime, returnErr == nil)
+	if result, ok := t.cache.receipt(hash); ok {
+		return result, nil
+	}
 	sdkctx := t.ctxProvider(LatestCtxHeight)
 	receipt, err := t.keeper.GetReceipt(sdkctx, hash)
 	if err != nil {
@@ -54,14 +59,19 @@ func (t *TransactionAPI) GetTransactionReceipt(ctx context.Context, hash common.
 		return nil, err
 	}
 	height := int64(receipt.BlockNumber)
-	block, err := blockByNumberWithRetry(ctx, t.tmClient, &height, 1)
+	block, err := t.cache.blockByNumber(ctx, t.tmClient, &height, sdkctx.BlockHeight())
 	if err != nil {
 		return nil, err
 	}
-	return encodeReceipt(receipt, t.txConfig.TxDecoder(), block, func(h common.Hash) bool {
+	result, err = encodeReceipt(receipt, t.txConfig.TxDecoder(), block, func(h common.Hash) bool {
 		_, err := t.keeper.GetReceipt(sdkctx, h)
 		return err == nil
 	})
+	if err != nil {
+		return nil, err
+	}
+	t.cache.addReceipt(hash, height, result, sdkctx.BlockHeight())
+	return result, nil
 }
 
 func (t *TransactionAPI) GetVMError(hash common.Hash) (result string, returnErr error) {
@@ -81,7 +91,7 @@ func (t *TransactionAPI) GetTransactionByBlockNumberAndIndex(ctx context.Context
 	if err != nil {
 		return nil, err
 	}
-	block, err := blockByNumberWithRetry(ctx, t.tmClient, blockNumber, 1)
+	block, err := t.cache.blockByNumber(ctx, t.tmClient, blockNumber, t.ctxProvider(LatestCtxHeight).BlockHeight())
 	if err != nil {
 		return nil, err
 	}
@@ -101,6 +111,9 @@ func (t *TransactionAPI) GetTransactionByBlockHashAndIndex(ctx context.Context,
 func (t *TransactionAPI) GetTransactionByHash(ctx context.Context, hash common.Hash) (result *ethapi.RPCTransaction, returnErr error) {
 	startTime := time.Now()
 	defer recordMetrics("eth_getTransactionByHash", t.connectionType, startTime, returnErr == nil)
+	if result, ok := t.cache.tx(hash); ok {
+		return result, nil
+	}
 	sdkCtx := t.ctxProvider(LatestCtxHeight)
 	// first try get from mempool
 	for page := 1; page <= UnconfirmedTxQueryMaxPage; page++ {
@@ -145,7 +158,12 @@ func (t *TransactionAPI) GetTransactionByHash(ctx context.Context, hash common.H
 		}
 		return nil, err
 	}
-	return t.GetTransactionByBlockNumberAndIndex(ctx, rpc.BlockNumber(receipt.BlockNumber), hexutil.Uint(receipt.TransactionIndex))
+	result, err = t.GetTransactionByBlockNumberAndIndex(ctx, rpc.BlockNumber(receipt.BlockNumber), hexutil.Uint(receipt.TransactionIndex))
+	if err != nil {
+		return nil, err
+	}
+	t.cache.addTx(hash, int64(receipt.BlockNumber), result, sdkCtx.BlockHeight())
+	return result, nil
 }
 
 func (t *TransactionAPI) GetTransactionErrorByHash(_ context.Context, hash common.Hash) (result string, returnErr error) {
@@ -179,7 +197,8 @@ func (t *TransactionAPI) GetTransactionCount(ctx context.Context, address common
 	if blkNr != nil {
 		sdkCtx = t.ctxProvider(*blkNr)
 		if err := CheckVersion(sdkCtx, t.keeper); err != nil {
-			return nil, err
+			returnErr = t.archive.forward(ctx, err, &result, "eth_getTransactionCount", address, blockNrOrHash)
+			return
 		}
 	}
 
@@ -2,16 +2,21 @@ package evmrpc
 
 import (
 	"context"
+	"fmt"
 	"math/big"
 	"strconv"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/lib/ethapi"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/kiichain/kiichain3/x/evm/keeper"
 	"github.com/kiichain/kiichain3/x/evm/types"
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	tmtypes "github.com/tendermint/tendermint/types"
 )
 
 type TxPoolAPI struct {
@@ -31,30 +36,145 @@ func NewTxPoolAPI(tmClient rpcclient.Client, k *keeper.Keeper, ctxProvider func(
 	return &TxPoolAPI{tmClient: tmClient, keeper: k, ctxProvider: ctxProvider, txDecoder: txDecoder, txPoolConfig: txPoolConfig, connectionType: connectionType}
 }
 
-// For now, we put all unconfirmed txs in pending and none in queued
+// Content returns the transactions of the mempool by status, sender and nonce.
+// A transaction is pending if its nonce follows the nonce of its sender
+// without a gap, and queued otherwise.
 func (t *TxPoolAPI) Content(ctx context.Context) (result map[string]map[string]map[string]*ethapi.RPCTransaction, returnErr error) {
 	startTime := time.Now()
 	defer recordMetrics("kii_content", t.connectionType, startTime, returnErr == nil)
+	pool, err := t.pool(ctx)
+	if err != nil {
+		return nil, err
+	}
 	content := map[string]map[string]map[string]*ethapi.RPCTransaction{
 		"pending": make(map[string]map[string]*ethapi.RPCTransaction),
 		"queued":  make(map[string]map[string]*ethapi.RPCTransaction),
 	}
+	for addr, txs := range pool.pending {
+		if rpcTxs := pool.rpcTxs(txs); len(rpcTxs) > 0 {
+			content["pending"][addr.String()] = rpcTxs
+		}
+	}
+	for addr, txs := range pool.queued {
+		if rpcTxs := pool.rpcTxs(txs); len(rpcTxs) > 0 {
+			content["queued"][addr.String()] = rpcTxs
+		}
+	}
+	return content, nil
+}
 
-	total := t.txPoolConfig.maxNumTxs
-	resUnconfirmedTxs, err := t.tmClient.UnconfirmedTxs(ctx, nil, &total)
+// ContentFrom returns the pending and queued transactions of an address by
+// nonce.
+func (t *TxPoolAPI) ContentFrom(ctx context.Context, addr common.Address) (result map[string]map[string]*ethapi.RPCTransaction, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("txpool_contentFrom", t.connectionType, startTime, returnErr == nil)
+	pool, err := t.pool(ctx)
 	if err != nil {
 		return nil, err
 	}
+	return map[string]map[string]*ethapi.RPCTransaction{
+		"pending": pool.rpcTxs(pool.pending[addr]),
+		"queued":  pool.rpcTxs(pool.queued[addr]),
+	}, nil
+}
 
-	sdkCtx := t.ctxProvider(LatestCtxHeight)
-	signer := ethtypes.MakeSigner(
-		types.DefaultChainConfig().EthereumConfig(t.keeper.ChainID(sdkCtx)),
-		big.NewInt(sdkCtx.BlockHeight()),
-		uint64(sdkCtx.BlockTime().Unix()),
-	)
+// Status returns the number of pending and queued transactions. Unlike the
+// other methods, it also counts the transactions that are known only by their
+// nonce because they are beyond the number of transactions fetched from the
+// mempool.
+func (t *TxPoolAPI) Status(ctx context.Context) (result map[string]hexutil.Uint, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("txpool_status", t.connectionType, startTime, returnErr == nil)
+	pool, err := t.pool(ctx)
+	if err != nil {
+		return nil, err
+	}
+	count := func(txs map[common.Address]map[uint64]*ethtypes.Transaction) (n hexutil.Uint) {
+		for _, byNonce := range txs {
+			n += hexutil.Uint(len(byNonce))
+		}
+		return n
+	}
+	return map[string]hexutil.Uint{
+		"pending": count(pool.pending),
+		"queued":  count(pool.queued),
+	}, nil
+}
 
+// Inspect returns a textual summary of the transactions of the mempool by
+// status, sender and nonce.
+func (t *TxPoolAPI) Inspect(ctx context.Context) (result map[string]map[string]map[string]string, returnErr error) {
+	startTime := time.Now()
+	defer recordMetrics("txpool_inspect", t.connectionType, startTime, returnErr == nil)
+	pool, err := t.pool(ctx)
+	if err != nil {
+		return nil, err
+	}
+	format := func(txs map[common.Address]map[uint64]*ethtypes.Transaction) map[string]map[string]string {
+		res := make(map[string]map[string]string)
+		for addr, byNonce := range txs {
+			summaries := make(map[string]string)
+			for nonce, tx := range byNonce {
+				if tx == nil {
+					continue
+				}
+				to := "contract creation"
+				if tx.To() != nil {
+					to = tx.To().Hex()
+				}
+				summaries[strconv.FormatUint(nonce, 10)] = fmt.Sprintf("%s: %v wei + %v gas × %v wei", to, tx.Value(), tx.Gas(), tx.GasPrice())
+			}
+			if len(summaries) > 0 {
+				res[addr.String()] = summaries
+			}
+		}
+		return res
+	}
+	return map[string]map[string]map[string]string{
+		"pending": format(pool.pending),
+		"queued":  format(pool.queued),
+	}, nil
+}
+
+// txPool is a snapshot of the EVM transactions of the mempool by sender and
+// nonce. A transaction is nil if it is only known from the pending nonces of
+// the keeper.
+type txPool struct {
+	pending     map[common.Address]map[uint64]*ethtypes.Transaction
+	queued      map[common.Address]map[uint64]*ethtypes.Transaction
+	chainConfig *params.ChainConfig
+}
+
+func (t *TxPoolAPI) pool(ctx context.Context) (*txPool, error) {
+	return snapshotTxPool(ctx, t.tmClient, t.keeper, t.ctxProvider(LatestCtxHeight), t.txDecoder, t.txPoolConfig.maxNumTxs)
+}
+
+// snapshotTxPool takes a snapshot of the mempool. The nonces of the
+// transactions come from the pending nonces that the keeper records for every
+// transaction that enters the mempool, and the transactions themselves from up
+// to maxNumTxs unconfirmed transactions.
+func snapshotTxPool(ctx context.Context, tmClient rpcclient.Client, k *keeper.Keeper, sdkCtx sdk.Context, txDecoder sdk.TxDecoder, maxNumTxs int) (*txPool, error) {
+	total := maxNumTxs
+	resUnconfirmedTxs, err := tmClient.UnconfirmedTxs(ctx, nil, &total)
+	if err != nil {
+		return nil, err
+	}
+
+	chainConfig := types.DefaultChainConfig().EthereumConfig(k.ChainID(sdkCtx))
+	signer := ethtypes.MakeSigner(chainConfig, big.NewInt(sdkCtx.BlockHeight()), uint64(sdkCtx.BlockTime().Unix()))
+
+	known := make(map[common.Address]map[uint64]*ethtypes.Transaction)
+	add := func(addr common.Address, nonce uint64, tx *ethtypes.Transaction) {
+		if known[addr] == nil {
+			known[addr] = make(map[uint64]*ethtypes.Transaction)
+		}
+		if tx != nil || known[addr][nonce] == nil {
+			known[addr][nonce] = tx
+		}
+	}
+	byKey := make(map[tmtypes.TxKey]*ethtypes.Transaction, len(resUnconfirmedTxs.Txs))
 	for _, tx := range resUnconfirmedTxs.Txs {
-		ethTx := getEthTxForTxBz(tx, t.txDecoder)
+		ethTx := getEthTxForTxBz(tx, txDecoder)
 		if ethTx == nil { // not an evm tx
 			continue
 		}
@@ -62,18 +182,56 @@ func (t *TxPoolAPI) Content(ctx context.Context) (result map[string]map[string]m
 		if err != nil {
 			return nil, err
 		}
+		byKey[tx.Key()] = ethTx
+		add(fromAddr, ethTx.Nonce(), ethTx)
+	}
+	for addr, pendingTxs := range k.GetPendingTxsSnapshot() {
+		for _, pendingTx := range pendingTxs {
+			add(addr, pendingTx.Nonce, byKey[pendingTx.Key])
+		}
+	}
 
-		nonce := ethTx.Nonce()
-		chainConfig := types.DefaultChainConfig().EthereumConfig(t.keeper.ChainID(sdkCtx))
-		res := ethapi.NewRPCPendingTransaction(ethTx, nil, chainConfig)
-		nonceStr := strconv.FormatUint(nonce, 10)
-		if content["pending"][fromAddr.String()] == nil {
-			content["pending"][fromAddr.String()] = map[string]*ethapi.RPCTransaction{
-				nonceStr: res,
+	pool := &txPool{
+		pending:     make(map[common.Address]map[uint64]*ethtypes.Transaction),
+		queued:      make(map[common.Address]map[uint64]*ethtypes.Transaction),
+		chainConfig: chainConfig,
+	}
+	for addr, byNonce := range known {
+		minedNonce := k.GetNonce(sdkCtx, addr)
+		// the first gap in the nonces of the keeper may be filled by a tx that
+		// the keeper hasn't recorded yet
+		nextNonce := k.CalculateNextNonce(sdkCtx, addr, true)
+		for {
+			if _, ok := byNonce[nextNonce]; !ok {
+				break
+			}
+			nextNonce++
+		}
+		for nonce, tx := range byNonce {
+			status := pool.queued
+			switch {
+			case nonce < minedNonce: // mined since
+				continue
+			case nonce < nextNonce:
+				status = pool.pending
+			}
+			if status[addr] == nil {
+				status[addr] = make(map[uint64]*ethtypes.Transaction)
 			}
-		} else {
-			content["pending"][fromAddr.String()][nonceStr] = res
+			status[addr][nonce] = tx
 		}
 	}
-	return content, nil
+	return pool, nil
+}
+
+// rpcTxs converts the known transactions of a sender by nonce.
+func (p *txPool) rpcTxs(txs map[uint64]*ethtypes.Transaction) map[string]*ethapi.RPCTransaction {
+	res := make(map[string]*ethapi.RPCTransaction, len(txs))
+	for nonce, tx := range txs {
+		if tx == nil {
+			continue
+		}
+		res[strconv.FormatUint(nonce, 10)] = ethapi.NewRPCPendingTransaction(tx, nil, p.chainConfig)
+	}
+	return res
 }
@@ -8,10 +8,37 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/kiichain/kiichain3/x/evm/types"
 	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/types"
 )
 
+// unconfirmedTxSender returns the sender of the unconfirmed tx of the mock
+// mempool, which has nonce 2 while its sender has nonce 0.
+func unconfirmedTxSender(t *testing.T) common.Address {
+	msg := UnconfirmedTx.GetMsgs()[0].(*types.MsgEVMTransaction)
+	ethTx, _ := msg.AsTransaction()
+	sender, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(ethTx.ChainId()), ethTx)
+	require.Nil(t, err)
+	return sender
+}
+
+// fillNonceGap records the nonces before the one of the unconfirmed tx as
+// pending in the keeper, as if the mempool had their txs.
+func fillNonceGap(t *testing.T) func() {
+	sender := unconfirmedTxSender(t)
+	EVMKeeper.AddPendingNonce(tmtypes.TxKey{1}, sender, 0, 0)
+	EVMKeeper.AddPendingNonce(tmtypes.TxKey{2}, sender, 1, 0)
+	return func() {
+		EVMKeeper.RemovePendingNonce(tmtypes.TxKey{1})
+		EVMKeeper.RemovePendingNonce(tmtypes.TxKey{2})
+	}
+}
+
 func TestTxPoolContent(t *testing.T) {
+	defer fillNonceGap(t)()
 	body := "{\"jsonrpc\": \"2.0\",\"method\": \"txpool_content\",\"params\":[],\"id\":\"test\"}"
 	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("http://%s:%d", TestAddr, TestPort), strings.NewReader(body))
 	require.Nil(t, err)
@@ -31,7 +58,7 @@ func TestTxPoolContent(t *testing.T) {
 	// check that txn
 	for fromAddr, txns := range pendingMap {
 		for nonce, txn := range txns.(map[string]interface{}) {
-			require.NotZero(t, nonce)
+			require.Equal(t, "2", nonce)
 			tx := txn.(map[string]interface{})
 			require.Nil(t, tx["blockNumber"])
 			require.Nil(t, tx["blockHash"])
@@ -65,6 +92,40 @@ func TestTxPoolContent(t *testing.T) {
 	require.Equal(t, 0, len(queuedMap))
 }
 
+func TestTxPoolQueued(t *testing.T) {
+	sender := unconfirmedTxSender(t)
+	resObj := sendRequestGoodWithNamespace(t, "txpool", "content")
+	result := resObj["result"].(map[string]interface{})
+	require.Empty(t, result["pending"])
+	queued := result["queued"].(map[string]interface{})
+	require.Len(t, queued, 1)
+	require.Contains(t, queued[sender.Hex()], "2")
+
+	resObj = sendRequestGoodWithNamespace(t, "txpool", "contentFrom", sender)
+	result = resObj["result"].(map[string]interface{})
+	require.Empty(t, result["pending"])
+	require.Contains(t, result["queued"], "2")
+
+	resObj = sendRequestGoodWithNamespace(t, "txpool", "status")
+	require.Equal(t, map[string]interface{}{"pending": "0x0", "queued": "0x1"}, resObj["result"])
+
+	resObj = sendRequestGoodWithNamespace(t, "txpool", "inspect")
+	result = resObj["result"].(map[string]interface{})
+	summary := result["queued"].(map[string]interface{})[sender.Hex()].(map[string]interface{})["2"]
+	require.Equal(t, "0x0000000000000000000000000000000000010203: 2000 wei + 1000 gas × 10 wei", summary)
+
+	// once the gap is filled, the tx and the txs only known by their nonce
+	// are pending
+	defer fillNonceGap(t)()
+	resObj = sendRequestGoodWithNamespace(t, "txpool", "status")
+	require.Equal(t, map[string]interface{}{"pending": "0x3", "queued": "0x0"}, resObj["result"])
+	resObj = sendRequestGoodWithNamespace(t, "txpool", "contentFrom", sender)
+	result = resObj["result"].(map[string]interface{})
+	require.Len(t, result["pending"], 1)
+	require.Contains(t, result["pending"], "2")
+	require.Empty(t, result["queued"])
+}
+
 func requireNotZeroHex(t *testing.T, hexStr string) {
 	if strings.HasPrefix(hexStr, "0x") {
 		hexStr = hexStr[2:]
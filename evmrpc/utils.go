@@ -69,6 +69,20 @@ func getHeightFromBigIntBlockNumber(latest int64, blockNumber *big.Int) int64 {
 	}
 }
 
+// getBlockBaseFee returns the base fee a block was executed with. Blocks
+// outside of the base fee history read it from the state of the previous
+// block, since the EndBlock of a block already stores the base fee of the next.
+func getBlockBaseFee(k *keeper.Keeper, ctxProvider func(int64) sdk.Context, height int64) *big.Int {
+	if baseFee, found := k.GetBaseFeePerGasAtHeight(ctxProvider(LatestCtxHeight), height); found {
+		return baseFee.TruncateInt().BigInt()
+	}
+	if height <= 1 {
+		// no base fee is stored before the first block
+		return k.GetMinimumFeePerGas(ctxProvider(height)).TruncateInt().BigInt()
+	}
+	return k.GetDynamicBaseFeePerGas(ctxProvider(height - 1)).TruncateInt().BigInt()
+}
+
 func getTestKeyring(homeDir string) (keyring.Keyring, error) {
 	clientCtx := client.Context{}.WithViper("").WithHomeDir(homeDir)
 	clientCtx, err := config.ReadFromClientConfig(clientCtx)
@@ -179,12 +193,23 @@ func recordMetricsWithError(apiMethod string, connectionType ConnectionType, sta
 	recordMetrics(apiMethod, connectionType, startTime, err == nil)
 }
 
+// heightUnavailableError is returned by CheckVersion when the state of a height
+// isn't available on this node, typically because it has been pruned.
+type heightUnavailableError struct {
+	module string
+	height int64
+}
+
+func (e *heightUnavailableError) Error() string {
+	return fmt.Sprintf("%s module does not exist on height %d", e.module, e.height)
+}
+
 func CheckVersion(ctx sdk.Context, k *keeper.Keeper) error {
 	if !evmExists(ctx, k) {
-		return fmt.Errorf("evm module does not exist on height %d", ctx.BlockHeight())
+		return &heightUnavailableError{module: "evm", height: ctx.BlockHeight()}
 	}
 	if !bankExists(ctx, k) {
-		return fmt.Errorf("bank module does not exist on height %d", ctx.BlockHeight())
+		return &heightUnavailableError{module: "bank", height: ctx.BlockHeight()}
 	}
 	return nil
 }
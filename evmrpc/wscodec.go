@@ -0,0 +1,457 @@
+// Copyright 2015 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package evmrpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	wsReadBuffer       = 1024
+	wsWriteBuffer      = 1024
+	wsPingInterval     = 30 * time.Second
+	wsPingWriteTimeout = 5 * time.Second
+	wsPongTimeout      = 30 * time.Second
+	wsWriteTimeout     = 10 * time.Second
+	wsDefaultReadLimit = 32 * 1024 * 1024
+)
+
+var wsBufferPool = new(sync.Pool)
+
+// wsRequestFilter is consulted for every JSON-RPC request received over a
+// websocket connection. A non-nil error rejects the whole message, which is
+// answered with the returned error instead of being handed to the server.
+type wsRequestFilter func(r *http.Request, method string) error
+
+// methodNotFoundError mirrors the error go-ethereum returns for unknown or
+// deny-listed methods so that HTTP and websocket clients see the same response.
+type methodNotFoundError struct{ method string }
+
+func (e *methodNotFoundError) ErrorCode() int { return -32601 }
+
+func (e *methodNotFoundError) Error() string {
+	return fmt.Sprintf("the method %s does not exist/is not available", e.method)
+}
+
+// invalidMessageError rejects messages with calls that cannot be parsed, which
+// would otherwise get past the checks that need to see every call.
+type invalidMessageError struct{}
+
+func (e *invalidMessageError) ErrorCode() int { return -32600 }
+
+func (e *invalidMessageError) Error() string { return "invalid request" }
+
+// duplicateIDError rejects calls reusing the id of a pending heavy call.
+type duplicateIDError struct{}
+
+func (e *duplicateIDError) ErrorCode() int { return -32600 }
+
+func (e *duplicateIDError) Error() string { return "id of a pending request reused" }
+
+// denyListFilter rejects any method present in the given deny list.
+func denyListFilter(denyList []string) wsRequestFilter {
+	denied := make(map[string]struct{}, len(denyList))
+	for _, method := range denyList {
+		denied[method] = struct{}{}
+	}
+	return func(_ *http.Request, method string) error {
+		if _, found := denied[method]; found {
+			return &methodNotFoundError{method: method}
+		}
+		return nil
+	}
+}
+
+// newWSHandler returns a handler that serves JSON-RPC to websocket connections,
+// equivalent to rpc.Server.WebsocketHandler except that every incoming message
+// is run through the given filters and the limiter, if any, before it reaches
+// the server.
+func newWSHandler(srv *rpc.Server, allowedOrigins []string, limiter *RPCLimiter, filters ...wsRequestFilter) http.Handler {
+	upgrader := websocket.Upgrader{
+		ReadBufferSize:  wsReadBuffer,
+		WriteBufferSize: wsWriteBuffer,
+		WriteBufferPool: wsBufferPool,
+		CheckOrigin:     wsHandshakeValidator(allowedOrigins),
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		c := newWSConn(conn, r, limiter, filters)
+		defer c.stop()
+		srv.ServeCodec(rpc.NewFuncCodec(c, c.encode, c.decode), 0)
+	})
+}
+
+// wsConn adapts a websocket connection to rpc.NewFuncCodec. Writes are
+// serialized so that filter rejections can be answered from the read path.
+type wsConn struct {
+	conn    *websocket.Conn
+	req     *http.Request
+	filters []wsRequestFilter
+	limiter *RPCLimiter
+
+	pendingMu sync.Mutex
+	pending   map[string]func() // heavy call slots by request id
+
+	writeMu  sync.Mutex
+	closed   chan struct{}
+	stopOnce sync.Once
+}
+
+func newWSConn(conn *websocket.Conn, r *http.Request, limiter *RPCLimiter, filters []wsRequestFilter) *wsConn {
+	conn.SetReadLimit(wsDefaultReadLimit)
+	c := &wsConn{conn: conn, req: r, filters: filters, limiter: limiter, pending: map[string]func(){}, closed: make(chan struct{})}
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Time{})
+	})
+	go c.pingLoop()
+	return c
+}
+
+func (c *wsConn) Close() error {
+	c.stop()
+	return c.conn.Close()
+}
+
+func (c *wsConn) SetWriteDeadline(t time.Time) error {
+	return c.conn.SetWriteDeadline(t)
+}
+
+func (c *wsConn) RemoteAddr() string {
+	return c.conn.RemoteAddr().String()
+}
+
+func (c *wsConn) stop() {
+	c.stopOnce.Do(func() {
+		close(c.closed)
+		c.pendingMu.Lock()
+		defer c.pendingMu.Unlock()
+		for id, release := range c.pending {
+			delete(c.pending, id)
+			release()
+		}
+	})
+}
+
+func (c *wsConn) encode(v interface{}, _ bool) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	if c.limiter == nil {
+		return c.conn.WriteJSON(v)
+	}
+	bz, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	c.releaseHeavyCalls(bz)
+	if err := c.limiter.checkResponseSize(bz); err != nil {
+		reqs, isBatch, _ := parseRequestHeaders(bz)
+		if bz, err = json.Marshal(newRPCErrorResponse(reqs, isBatch, err)); err != nil {
+			return err
+		}
+	}
+	// same framing as WriteJSON
+	return c.conn.WriteMessage(websocket.TextMessage, append(bz, '\n'))
+}
+
+// decode reads the next message that passes all filters into v. Rejected
+// messages are answered directly and never reach the server.
+func (c *wsConn) decode(v interface{}) error {
+	for {
+		var raw json.RawMessage
+		if err := c.conn.ReadJSON(&raw); err != nil {
+			return err
+		}
+		if resp := c.filter(raw); resp != nil {
+			c.writeMu.Lock()
+			_ = c.conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+			err := c.conn.WriteJSON(resp)
+			c.writeMu.Unlock()
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		return json.Unmarshal(raw, v)
+	}
+}
+
+type rpcRequestHeader struct {
+	ID     json.RawMessage `json:"id,omitempty"`
+	Method string          `json:"method"`
+}
+
+type rpcErrorResponse struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Error   rpcErrorObject  `json:"error"`
+}
+
+type rpcErrorObject struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// parseRequestHeaders extracts the id and method of every call in a single or
+// batch JSON-RPC message. Like go-ethereum, only the first JSON value of raw is
+// read and the calls of a batch are decoded one by one. ok is false if any call
+// cannot be decoded. The server would still serve the calls it can decode, so
+// such messages must be rejected by whoever needs to check every call.
+func parseRequestHeaders(raw []byte) (reqs []rpcRequestHeader, isBatch bool, ok bool) {
+	trimmed := bytes.TrimLeft(raw, " \t\r\n")
+	isBatch = len(trimmed) > 0 && trimmed[0] == '['
+	dec := json.NewDecoder(bytes.NewReader(trimmed))
+	if !isBatch {
+		var req rpcRequestHeader
+		if err := dec.Decode(&req); err != nil {
+			return nil, isBatch, false
+		}
+		return []rpcRequestHeader{req}, isBatch, true
+	}
+	if _, err := dec.Token(); err != nil { // '['
+		return nil, isBatch, false
+	}
+	for dec.More() {
+		var req rpcRequestHeader
+		if err := dec.Decode(&req); err != nil {
+			return nil, isBatch, false
+		}
+		reqs = append(reqs, req)
+	}
+	if _, err := dec.Token(); err != nil { // ']'
+		return nil, isBatch, false
+	}
+	return reqs, isBatch, true
+}
+
+// newRPCErrorResponse answers a whole message with err. Batches are answered
+// with a single error, as go-ethereum does for invalid batches.
+func newRPCErrorResponse(reqs []rpcRequestHeader, isBatch bool, err error) *rpcErrorResponse {
+	resp := &rpcErrorResponse{Version: "2.0", ID: json.RawMessage("null"), Error: rpcErrorObject{Code: -32603, Message: err.Error()}}
+	if rpcErr, ok := err.(rpc.Error); ok {
+		resp.Error.Code = rpcErr.ErrorCode()
+	}
+	if !isBatch && len(reqs) == 1 && len(reqs[0].ID) > 0 {
+		resp.ID = reqs[0].ID
+	}
+	return resp
+}
+
+// filter returns the error response for a message rejected by any filter or
+// limit, or nil if the message should be served. Like the HTTP deny list, a
+// single rejected call rejects the whole batch.
+func (c *wsConn) filter(raw json.RawMessage) *rpcErrorResponse {
+	if len(c.filters) == 0 && c.limiter == nil {
+		return nil
+	}
+	reqs, isBatch, ok := parseRequestHeaders(raw)
+	if !ok {
+		// the server would still serve the calls it can parse
+		return newRPCErrorResponse(nil, isBatch, &invalidMessageError{})
+	}
+	for _, req := range reqs {
+		for _, f := range c.filters {
+			if err := f(c.req, req.Method); err != nil {
+				return newRPCErrorResponse(reqs, isBatch, err)
+			}
+		}
+	}
+	if c.limiter != nil {
+		if c.hasPendingCalls(reqs) {
+			return newRPCErrorResponse(reqs, isBatch, &duplicateIDError{})
+		}
+		release, err := c.limiter.checkRequests(c.req, reqs, isBatch)
+		if err != nil {
+			return newRPCErrorResponse(reqs, isBatch, err)
+		}
+		c.trackHeavyCalls(reqs, release)
+	}
+	return nil
+}
+
+// trackHeavyCalls holds the heavy call slots of a message until its response
+// has been written. Calls without an id never get a response, so their slots
+// are released right away.
+func (c *wsConn) trackHeavyCalls(reqs []rpcRequestHeader, release func()) {
+	if release == nil {
+		return
+	}
+	once := &sync.Once{}
+	releaseOnce := func() { once.Do(release) }
+	c.pendingMu.Lock()
+	defer c.pendingMu.Unlock()
+	tracked := false
+	for _, req := range reqs {
+		if len(req.ID) > 0 && string(req.ID) != "null" {
+			c.pending[string(req.ID)] = releaseOnce
+			tracked = true
+		}
+	}
+	if !tracked {
+		releaseOnce()
+	}
+}
+
+// hasPendingCalls reports whether a call reuses the id of a heavy call that has
+// not been answered yet. Its response could not be told apart from the pending
+// one, so the slot of one of them would never be released.
+func (c *wsConn) hasPendingCalls(reqs []rpcRequestHeader) bool {
+	c.pendingMu.Lock()
+	defer c.pendingMu.Unlock()
+	for _, req := range reqs {
+		if _, found := c.pending[string(req.ID)]; found {
+			return true
+		}
+	}
+	return false
+}
+
+// releaseHeavyCalls releases the slots held by the calls answered in resp.
+func (c *wsConn) releaseHeavyCalls(resp []byte) {
+	c.pendingMu.Lock()
+	defer c.pendingMu.Unlock()
+	if len(c.pending) == 0 {
+		return
+	}
+	ids, _, ok := parseRequestHeaders(resp)
+	if !ok {
+		return
+	}
+	for _, id := range ids {
+		if release, found := c.pending[string(id.ID)]; found {
+			delete(c.pending, string(id.ID))
+			release()
+		}
+	}
+}
+
+// pingLoop sends periodic ping frames and expects a pong before the read deadline.
+func (c *wsConn) pingLoop() {
+	ticker := time.NewTicker(wsPingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.closed:
+			return
+		case <-ticker.C:
+			if err := c.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(wsPingWriteTimeout)); err != nil {
+				return
+			}
+			_ = c.conn.SetReadDeadline(time.Now().Add(wsPongTimeout))
+		}
+	}
+}
+
+// wsHandshakeValidator returns a handler that verifies the origin during the
+// websocket upgrade process. When a '*' is specified as an allowed origins all
+// connections are accepted.
+func wsHandshakeValidator(allowedOrigins []string) func(*http.Request) bool {
+	origins := make(map[string]struct{})
+	allowAllOrigins := false
+
+	for _, origin := range allowedOrigins {
+		if origin == "*" {
+			allowAllOrigins = true
+		}
+		if origin != "" {
+			origins[origin] = struct{}{}
+		}
+	}
+	// allow localhost if no allowedOrigins are specified.
+	if len(origins) == 0 {
+		origins["http://localhost"] = struct{}{}
+		if hostname, err := os.Hostname(); err == nil {
+			origins["http://"+hostname] = struct{}{}
+		}
+	}
+
+	return func(req *http.Request) bool {
+		// Skip origin verification if no Origin header is present. The origin check
+		// is supposed to protect against browser based attacks. Browsers always set
+		// Origin. Non-browser software can put anything in origin and checking it doesn't
+		// provide additional security.
+		if _, ok := req.Header["Origin"]; !ok {
+			return true
+		}
+		// Verify origin against allow list.
+		origin := strings.ToLower(req.Header.Get("Origin"))
+		if allowAllOrigins {
+			return true
+		}
+		for allowed := range origins {
+			if ruleAllowsOrigin(allowed, origin) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func ruleAllowsOrigin(allowedOrigin string, browserOrigin string) bool {
+	allowedScheme, allowedHostname, allowedPort, err := parseOriginURL(allowedOrigin)
+	if err != nil {
+		return false
+	}
+	browserScheme, browserHostname, browserPort, err := parseOriginURL(browserOrigin)
+	if err != nil {
+		return false
+	}
+	if allowedScheme != "" && allowedScheme != browserScheme {
+		return false
+	}
+	if allowedHostname != "" && allowedHostname != browserHostname {
+		return false
+	}
+	if allowedPort != "" && allowedPort != browserPort {
+		return false
+	}
+	return true
+}
+
+func parseOriginURL(origin string) (string, string, string, error) {
+	parsedURL, err := url.Parse(strings.ToLower(origin))
+	if err != nil {
+		return "", "", "", err
+	}
+	var scheme, hostname, port string
+	if strings.Contains(origin, "://") {
+		scheme = parsedURL.Scheme
+		hostname = parsedURL.Hostname()
+		port = parsedURL.Port()
+	} else {
+		scheme = ""
+		hostname = parsedURL.Scheme
+		port = parsedURL.Opaque
+		if hostname == "" {
+			hostname = origin
+		}
+	}
+	return scheme, hostname, port, nil
+}
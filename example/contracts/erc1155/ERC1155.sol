@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: MIT
+pragma solidity ^0.8.20;
+
+interface IERC165 {
+    function supportsInterface(bytes4 interfaceId) external view returns (bool);
+}
+
+interface IERC1155Receiver {
+    function onERC1155Received(
+        address operator,
+        address from,
+        uint256 id,
+        uint256 value,
+        bytes calldata data
+    ) external returns (bytes4);
+
+    function onERC1155BatchReceived(
+        address operator,
+        address from,
+        uint256[] calldata ids,
+        uint256[] calldata values,
+        bytes calldata data
+    ) external returns (bytes4);
+}
+
+interface IERC1155 is IERC165 {
+    event TransferSingle(address indexed operator, address indexed from, address indexed to, uint256 id, uint256 value);
+    event TransferBatch(address indexed operator, address indexed from, address indexed to, uint256[] ids, uint256[] values);
+    event ApprovalForAll(address indexed account, address indexed operator, bool approved);
+
+    function balanceOf(address account, uint256 id) external view returns (uint256);
+    function balanceOfBatch(address[] calldata accounts, uint256[] calldata ids) external view returns (uint256[] memory);
+    function setApprovalForAll(address operator, bool approved) external;
+    function isApprovedForAll(address account, address operator) external view returns (bool);
+    function safeTransferFrom(address from, address to, uint256 id, uint256 value, bytes calldata data) external;
+    function safeBatchTransferFrom(address from, address to, uint256[] calldata ids, uint256[] calldata values, bytes calldata data) external;
+}
+
+// DummyERC1155 mints 100 of token 1 and 50 of token 2 to the deployer.
+contract DummyERC1155 is IERC1155 {
+    mapping(uint256 => mapping(address => uint256)) private _balances;
+    mapping(address => mapping(address => bool)) private _operatorApprovals;
+    mapping(uint256 => uint256) private _totalSupply;
+
+    constructor() {
+        _mint(msg.sender, 1, 100);
+        _mint(msg.sender, 2, 50);
+    }
+
+    function name() external pure returns (string memory) {
+        return "DummyERC1155";
+    }
+
+    function symbol() external pure returns (string memory) {
+        return "DUMMY";
+    }
+
+    function uri(uint256) external pure returns (string memory) {
+        return "https://example.com/{id}";
+    }
+
+    function supportsInterface(bytes4 interfaceId) external pure returns (bool) {
+        return interfaceId == 0xd9b67a26 || interfaceId == 0x0e89341c || interfaceId == 0x01ffc9a7;
+    }
+
+    function totalSupply(uint256 id) public view returns (uint256) {
+        return _totalSupply[id];
+    }
+
+    function balanceOf(address account, uint256 id) public view override returns (uint256) {
+        return _balances[id][account];
+    }
+
+    function balanceOfBatch(address[] calldata accounts, uint256[] calldata ids) external view override returns (uint256[] memory) {
+        require(accounts.length == ids.length, "ERC1155: accounts and ids length mismatch");
+        uint256[] memory balances = new uint256[](accounts.length);
+        for (uint256 i = 0; i < accounts.length; i++) {
+            balances[i] = balanceOf(accounts[i], ids[i]);
+        }
+        return balances;
+    }
+
+    function setApprovalForAll(address operator, bool approved) external override {
+        _operatorApprovals[msg.sender][operator] = approved;
+        emit ApprovalForAll(msg.sender, operator, approved);
+    }
+
+    function isApprovedForAll(address account, address operator) public view override returns (bool) {
+        return _operatorApprovals[account][operator];
+    }
+
+    function safeTransferFrom(address from, address to, uint256 id, uint256 value, bytes calldata data) external override {
+        require(from == msg.sender || isApprovedForAll(from, msg.sender), "ERC1155: caller is not owner nor approved");
+        _transfer(from, to, id, value);
+        emit TransferSingle(msg.sender, from, to, id, value);
+        if (to.code.length > 0) {
+            require(
+                IERC1155Receiver(to).onERC1155Received(msg.sender, from, id, value, data) == IERC1155Receiver.onERC1155Received.selector,
+                "ERC1155: transfer to non ERC1155Receiver implementer"
+            );
+        }
+    }
+
+    function safeBatchTransferFrom(address from, address to, uint256[] calldata ids, uint256[] calldata values, bytes calldata data) external override {
+        require(from == msg.sender || isApprovedForAll(from, msg.sender), "ERC1155: caller is not owner nor approved");
+        require(ids.length == values.length, "ERC1155: ids and values length mismatch");
+        for (uint256 i = 0; i < ids.length; i++) {
+            _transfer(from, to, ids[i], values[i]);
+        }
+        emit TransferBatch(msg.sender, from, to, ids, values);
+        if (to.code.length > 0) {
+            require(
+                IERC1155Receiver(to).onERC1155BatchReceived(msg.sender, from, ids, values, data) == IERC1155Receiver.onERC1155BatchReceived.selector,
+                "ERC1155: transfer to non ERC1155Receiver implementer"
+            );
+        }
+    }
+
+    function _transfer(address from, address to, uint256 id, uint256 value) internal {
+        require(to != address(0), "ERC1155: transfer to the zero address");
+        require(_balances[id][from] >= value, "ERC1155: insufficient balance for transfer");
+        _balances[id][from] -= value;
+        _balances[id][to] += value;
+    }
+
+    function _mint(address to, uint256 id, uint256 value) internal {
+        _balances[id][to] += value;
+        _totalSupply[id] += value;
+        emit TransferSingle(msg.sender, address(0), to, id, value);
+    }
+}
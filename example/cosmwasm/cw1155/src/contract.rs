@@ -0,0 +1,245 @@
+#[cfg(not(feature = "library"))]
+use cosmwasm_std::entry_point;
+use cosmwasm_std::{
+    DepsMut, Deps, Env, MessageInfo, Response, Binary, StdResult, StdError, to_json_binary, Uint128, WasmMsg,
+};
+use cw1155::{Cw1155BatchReceiveMsg, Cw1155ReceiveMsg};
+use crate::msg::{
+    EvmQueryWrapper, EvmMsg, InstantiateMsg, ExecuteMsg, QueryMsg, MigrateMsg, TokenAmount, OwnerToken,
+    BalanceResponse, Balance, BalancesResponse, IsApprovedForAllResponse, TokenInfoResponse, NumTokensResponse,
+    ContractInfoResponse,
+};
+use crate::querier::EvmQuerier;
+use crate::error::ContractError;
+use crate::state::ERC1155_ADDRESS;
+
+#[cfg_attr(not(feature = "library"), entry_point)]
+pub fn instantiate(
+    deps: DepsMut,
+    _env: Env,
+    _info: MessageInfo,
+    msg: InstantiateMsg,
+) -> Result<Response, ContractError> {
+    ERC1155_ADDRESS.save(deps.storage, &msg.erc1155_address)?;
+    Ok(Response::default())
+}
+
+#[cfg_attr(not(feature = "library"), entry_point)]
+pub fn migrate(
+    _deps: DepsMut,
+    _env: Env,
+    _msg: MigrateMsg,
+) -> Result<Response, ContractError> {
+    Ok(Response::default())
+}
+
+#[cfg_attr(not(feature = "library"), entry_point)]
+pub fn execute(
+    deps: DepsMut<EvmQueryWrapper>,
+    _env: Env,
+    info: MessageInfo,
+    msg: ExecuteMsg,
+) -> Result<Response<EvmMsg>, ContractError> {
+    match msg {
+        ExecuteMsg::Send { from, to, token_id, amount, msg } => {
+            execute_send(deps, info, from, to, token_id, amount, msg)
+        },
+        ExecuteMsg::SendBatch { from, to, batch, msg } => {
+            execute_send_batch(deps, info, from, to, batch, msg)
+        },
+        ExecuteMsg::ApproveAll { operator, expires: _ } => {
+            execute_approve_all(deps, info, operator, true)
+        },
+        ExecuteMsg::RevokeAll { operator } => {
+            execute_approve_all(deps, info, operator, false)
+        },
+    }
+}
+
+pub fn execute_send(
+    deps: DepsMut<EvmQueryWrapper>,
+    info: MessageInfo,
+    from: Option<String>,
+    to: String,
+    token_id: String,
+    amount: Uint128,
+    msg: Option<Binary>,
+) -> Result<Response<EvmMsg>, ContractError> {
+    let from = from.unwrap_or_else(|| info.sender.to_string());
+    deps.api.addr_validate(&from)?;
+    deps.api.addr_validate(&to)?;
+    validate_token_id(&token_id)?;
+
+    // the EVM call is made on behalf of the sender, so the ERC1155 contract
+    // enforces the ownership and the operator approvals
+    let erc_addr = ERC1155_ADDRESS.load(deps.storage)?;
+    let querier = EvmQuerier::new(&deps.querier);
+    let payload = querier.erc1155_transfer_payload(from.clone(), to.clone(), token_id.clone(), amount)?;
+    let mut res = Response::new()
+        .add_message(EvmMsg::DelegateCallEvm { to: erc_addr, data: payload.encoded_payload })
+        .add_attribute("action", "transfer_single")
+        .add_attribute("sender", info.sender.clone())
+        .add_attribute("owner", from.clone())
+        .add_attribute("recipient", to.clone())
+        .add_attribute("token_id", token_id.clone())
+        .add_attribute("amount", amount);
+    if let Some(msg) = msg {
+        let receive = Cw1155ReceiveMsg {
+            operator: info.sender.to_string(),
+            from: Some(from),
+            token_id,
+            amount,
+            msg,
+        };
+        res = res.add_message(WasmMsg::Execute { contract_addr: to, msg: receive.into_binary()?, funds: vec![] });
+    }
+    Ok(res)
+}
+
+pub fn execute_send_batch(
+    deps: DepsMut<EvmQueryWrapper>,
+    info: MessageInfo,
+    from: Option<String>,
+    to: String,
+    batch: Vec<TokenAmount>,
+    msg: Option<Binary>,
+) -> Result<Response<EvmMsg>, ContractError> {
+    let from = from.unwrap_or_else(|| info.sender.to_string());
+    deps.api.addr_validate(&from)?;
+    deps.api.addr_validate(&to)?;
+    if batch.is_empty() {
+        return Err(ContractError::EmptyBatch {});
+    }
+    for item in batch.iter() {
+        validate_token_id(&item.token_id)?;
+    }
+    let token_ids: Vec<String> = batch.iter().map(|item| item.token_id.clone()).collect();
+    let amounts: Vec<Uint128> = batch.iter().map(|item| item.amount).collect();
+
+    let erc_addr = ERC1155_ADDRESS.load(deps.storage)?;
+    let querier = EvmQuerier::new(&deps.querier);
+    let payload = querier.erc1155_batch_transfer_payload(from.clone(), to.clone(), token_ids.clone(), amounts.clone())?;
+    let mut res = Response::new()
+        .add_message(EvmMsg::DelegateCallEvm { to: erc_addr, data: payload.encoded_payload })
+        .add_attribute("action", "transfer_batch")
+        .add_attribute("sender", info.sender.clone())
+        .add_attribute("owner", from.clone())
+        .add_attribute("recipient", to.clone())
+        .add_attribute("token_ids", token_ids.join(","))
+        .add_attribute("amounts", amounts.iter().map(|a| a.to_string()).collect::<Vec<_>>().join(","));
+    if let Some(msg) = msg {
+        let receive = Cw1155BatchReceiveMsg {
+            operator: info.sender.to_string(),
+            from: Some(from),
+            batch: token_ids.into_iter().zip(amounts).collect(),
+            msg,
+        };
+        res = res.add_message(WasmMsg::Execute { contract_addr: to, msg: receive.into_binary()?, funds: vec![] });
+    }
+    Ok(res)
+}
+
+pub fn execute_approve_all(
+    deps: DepsMut<EvmQueryWrapper>,
+    info: MessageInfo,
+    operator: String,
+    approved: bool,
+) -> Result<Response<EvmMsg>, ContractError> {
+    deps.api.addr_validate(&operator)?;
+    let erc_addr = ERC1155_ADDRESS.load(deps.storage)?;
+
+    let querier = EvmQuerier::new(&deps.querier);
+    let payload = querier.erc1155_set_approval_all_payload(operator.clone(), approved)?;
+    let msg = EvmMsg::DelegateCallEvm { to: erc_addr, data: payload.encoded_payload };
+    let mut action = "approve_all";
+    if !approved {
+        action = "revoke_all";
+    }
+    let res = Response::new()
+        .add_attribute("action", action)
+        .add_attribute("sender", info.sender)
+        .add_attribute("operator", operator)
+        .add_message(msg);
+
+    Ok(res)
+}
+
+/// Token IDs are uint256 on the ERC1155 side. The EVM module checks their
+/// range, so only the format is checked here.
+fn validate_token_id(token_id: &str) -> StdResult<()> {
+    if token_id.is_empty() || !token_id.bytes().all(|b| b.is_ascii_digit()) {
+        return Err(StdError::generic_err(format!("invalid token ID {}", token_id)));
+    }
+    Ok(())
+}
+
+#[cfg_attr(not(feature = "library"), entry_point)]
+pub fn query(deps: Deps<EvmQueryWrapper>, env: Env, msg: QueryMsg) -> Result<Binary, ContractError> {
+    match msg {
+        QueryMsg::BalanceOf(OwnerToken { owner, token_id }) => Ok(to_json_binary(&query_balance_of(deps, env, owner, token_id)?)?),
+        QueryMsg::BalanceOfBatch(requests) => Ok(to_json_binary(&query_balance_of_batch(deps, env, requests)?)?),
+        QueryMsg::IsApprovedForAll { owner, operator } => Ok(to_json_binary(&query_is_approved_for_all(deps, env, owner, operator)?)?),
+        QueryMsg::TokenInfo { token_id } => Ok(to_json_binary(&query_token_info(deps, env, token_id)?)?),
+        QueryMsg::NumTokens { token_id } => Ok(to_json_binary(&query_num_tokens(deps, env, token_id)?)?),
+        QueryMsg::ContractInfo {} => Ok(to_json_binary(&query_contract_info(deps, env)?)?),
+        QueryMsg::EvmAddress {} => Ok(to_json_binary(&ERC1155_ADDRESS.load(deps.storage)?)?),
+    }
+}
+
+pub fn query_balance_of(deps: Deps<EvmQueryWrapper>, env: Env, owner: String, token_id: String) -> StdResult<BalanceResponse> {
+    validate_token_id(&token_id)?;
+    let erc_addr = ERC1155_ADDRESS.load(deps.storage)?;
+    let querier = EvmQuerier::new(&deps.querier);
+    let res = querier.erc1155_balance_of(env.contract.address.into_string(), erc_addr, owner, token_id)?;
+    Ok(BalanceResponse { balance: res.amount })
+}
+
+pub fn query_balance_of_batch(deps: Deps<EvmQueryWrapper>, env: Env, requests: Vec<OwnerToken>) -> StdResult<BalancesResponse> {
+    for request in requests.iter() {
+        validate_token_id(&request.token_id)?;
+    }
+    let owners: Vec<String> = requests.iter().map(|r| r.owner.clone()).collect();
+    let token_ids: Vec<String> = requests.iter().map(|r| r.token_id.clone()).collect();
+    let erc_addr = ERC1155_ADDRESS.load(deps.storage)?;
+    let querier = EvmQuerier::new(&deps.querier);
+    let res = querier.erc1155_balance_of_batch(env.contract.address.into_string(), erc_addr, owners, token_ids)?;
+    if res.amounts.len() != requests.len() {
+        return Err(StdError::generic_err("unexpected number of balances"));
+    }
+    let balances = requests
+        .into_iter()
+        .zip(res.amounts)
+        .map(|(request, amount)| Balance { token_id: request.token_id, owner: request.owner, amount })
+        .collect();
+    Ok(BalancesResponse { balances })
+}
+
+pub fn query_is_approved_for_all(deps: Deps<EvmQueryWrapper>, env: Env, owner: String, operator: String) -> StdResult<IsApprovedForAllResponse> {
+    let erc_addr = ERC1155_ADDRESS.load(deps.storage)?;
+    let querier = EvmQuerier::new(&deps.querier);
+    let res = querier.erc1155_is_approved_for_all(env.contract.address.into_string(), erc_addr, owner, operator)?;
+    Ok(IsApprovedForAllResponse { approved: res.is_approved })
+}
+
+pub fn query_token_info(deps: Deps<EvmQueryWrapper>, env: Env, token_id: String) -> StdResult<TokenInfoResponse> {
+    validate_token_id(&token_id)?;
+    let erc_addr = ERC1155_ADDRESS.load(deps.storage)?;
+    let querier = EvmQuerier::new(&deps.querier);
+    let res = querier.erc1155_uri(env.contract.address.into_string(), erc_addr, token_id)?;
+    Ok(TokenInfoResponse { token_uri: Some(res.uri), extension: None })
+}
+
+pub fn query_num_tokens(deps: Deps<EvmQueryWrapper>, env: Env, token_id: String) -> StdResult<NumTokensResponse> {
+    validate_token_id(&token_id)?;
+    let erc_addr = ERC1155_ADDRESS.load(deps.storage)?;
+    let querier = EvmQuerier::new(&deps.querier);
+    let res = querier.erc1155_total_supply(env.contract.address.into_string(), erc_addr, token_id)?;
+    Ok(NumTokensResponse { count: res.supply })
+}
+
+pub fn query_contract_info(deps: Deps<EvmQueryWrapper>, env: Env) -> StdResult<ContractInfoResponse> {
+    let erc_addr = ERC1155_ADDRESS.load(deps.storage)?;
+    let querier = EvmQuerier::new(&deps.querier);
+    let res = querier.erc1155_name_symbol(env.contract.address.into_string(), erc_addr)?;
+    Ok(ContractInfoResponse { name: res.name, symbol: res.symbol })
+}
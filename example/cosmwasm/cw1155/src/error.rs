@@ -0,0 +1,14 @@
+use cosmwasm_std::StdError;
+use thiserror::Error;
+
+#[derive(Error, Debug, PartialEq)]
+pub enum ContractError {
+    #[error("{0}")]
+    Std(#[from] StdError),
+
+    #[error("ERC1155 does not have the requested functionality in specification")]
+    NotSupported {},
+
+    #[error("batch cannot be empty")]
+    EmptyBatch {},
+}
@@ -0,0 +1,5 @@
+pub mod contract;
+pub mod error;
+pub mod msg;
+pub mod querier;
+pub mod state;
@@ -0,0 +1,236 @@
+use cosmwasm_std::{Binary, CosmosMsg, CustomMsg, CustomQuery, Empty, Uint128};
+use schemars::JsonSchema;
+use cosmwasm_schema::{cw_serde, QueryResponses};
+use serde::{Deserialize, Serialize};
+
+pub use cw1155::{BalanceResponse, Expiration, IsApprovedForAllResponse, TokenId};
+
+#[cw_serde]
+pub struct InstantiateMsg {
+    pub erc1155_address: String,
+}
+
+#[cw_serde]
+pub struct MigrateMsg {}
+
+#[cw_serde]
+pub struct TokenAmount {
+    pub token_id: TokenId,
+    pub amount: Uint128,
+}
+
+#[cw_serde]
+pub struct OwnerToken {
+    pub owner: String,
+    pub token_id: TokenId,
+}
+
+#[cw_serde]
+pub enum ExecuteMsg {
+    /// Send is a base message to transfer a token to an address or a contract.
+    /// If msg is set, the recipient is a contract and receives a Cw1155ReceiveMsg.
+    Send {
+        /// If from is not set, the tokens of the sender are moved.
+        from: Option<String>,
+        to: String,
+        token_id: TokenId,
+        amount: Uint128,
+        msg: Option<Binary>,
+    },
+    /// SendBatch is the batch version of Send. If msg is set, the recipient
+    /// receives a Cw1155BatchReceiveMsg.
+    SendBatch {
+        from: Option<String>,
+        to: String,
+        batch: Vec<TokenAmount>,
+        msg: Option<Binary>,
+    },
+    /// Allows operator to transfer / send any token from the owner's account.
+    ApproveAll {
+        operator: String,
+        expires: Option<Expiration>,
+    },
+    /// Remove previously granted ApproveAll permission
+    RevokeAll { operator: String },
+}
+
+#[cw_serde]
+#[derive(QueryResponses)]
+pub enum QueryMsg {
+    #[returns(BalanceResponse)]
+    BalanceOf(OwnerToken),
+
+    #[returns(BalancesResponse)]
+    BalanceOfBatch(Vec<OwnerToken>),
+
+    #[returns(IsApprovedForAllResponse)]
+    IsApprovedForAll { owner: String, operator: String },
+
+    #[returns(TokenInfoResponse)]
+    TokenInfo { token_id: TokenId },
+
+    #[returns(NumTokensResponse)]
+    NumTokens { token_id: TokenId },
+
+    #[returns(ContractInfoResponse)]
+    ContractInfo {},
+
+    #[returns(String)]
+    EvmAddress {},
+}
+
+#[cw_serde]
+pub struct Balance {
+    pub token_id: TokenId,
+    pub owner: String,
+    pub amount: Uint128,
+}
+
+#[cw_serde]
+pub struct BalancesResponse {
+    pub balances: Vec<Balance>,
+}
+
+#[cw_serde]
+pub struct TokenInfoResponse {
+    pub token_uri: Option<String>,
+    pub extension: Option<Empty>,
+}
+
+#[cw_serde]
+pub struct NumTokensResponse {
+    pub count: Uint128,
+}
+
+#[cw_serde]
+pub struct ContractInfoResponse {
+    pub name: String,
+    pub symbol: String,
+}
+
+/// KiiRoute is enum type to represent kii query route path
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+#[serde(rename_all = "snake_case")]
+pub enum Route {
+    Evm,
+}
+
+/// EvmQueryWrapper is an override of QueryRequest::Custom to access EVM
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+#[serde(rename_all = "snake_case")]
+pub struct EvmQueryWrapper {
+    pub route: Route,
+    pub query_data: EvmQuery,
+}
+
+// implement custom query
+impl CustomQuery for EvmQueryWrapper {}
+
+/// EvmQuery is defines available query datas
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+#[serde(rename_all = "snake_case")]
+pub enum EvmQuery {
+    Erc1155TransferPayload {
+        from: String,
+        recipient: String,
+        token_id: String,
+        amount: Uint128,
+    },
+    Erc1155BatchTransferPayload {
+        from: String,
+        recipient: String,
+        token_ids: Vec<String>,
+        amounts: Vec<Uint128>,
+    },
+    Erc1155SetApprovalAllPayload {
+        to: String,
+        approved: bool,
+    },
+    Erc1155BalanceOf {
+        caller: String,
+        contract_address: String,
+        account: String,
+        token_id: String,
+    },
+    Erc1155BalanceOfBatch {
+        caller: String,
+        contract_address: String,
+        accounts: Vec<String>,
+        token_ids: Vec<String>,
+    },
+    Erc1155IsApprovedForAll {
+        caller: String,
+        contract_address: String,
+        owner: String,
+        operator: String,
+    },
+    Erc1155Uri {
+        caller: String,
+        contract_address: String,
+        token_id: String,
+    },
+    Erc1155TotalSupply {
+        caller: String,
+        contract_address: String,
+        token_id: String,
+    },
+    Erc1155NameSymbol {
+        caller: String,
+        contract_address: String,
+    },
+}
+
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+pub struct ErcPayloadResponse {
+    pub encoded_payload: String,
+}
+
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+pub struct Erc1155BalanceOfResponse {
+    pub amount: Uint128,
+}
+
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+pub struct Erc1155BalanceOfBatchResponse {
+    pub amounts: Vec<Uint128>,
+}
+
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+pub struct Erc1155IsApprovedForAllResponse {
+    pub is_approved: bool,
+}
+
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+pub struct Erc1155UriResponse {
+    pub uri: String,
+}
+
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+pub struct Erc1155TotalSupplyResponse {
+    pub supply: Uint128,
+}
+
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+pub struct Erc1155NameSymbolResponse {
+    pub name: String,
+    pub symbol: String,
+}
+
+// implement custom query
+impl CustomMsg for EvmMsg {}
+
+// this is a helper to be able to return these as CosmosMsg easier
+impl From<EvmMsg> for CosmosMsg<EvmMsg> {
+    fn from(original: EvmMsg) -> Self {
+        CosmosMsg::Custom(original)
+    }
+}
+
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+#[serde(rename_all = "snake_case")]
+pub enum EvmMsg {
+    DelegateCallEvm {
+        to: String,
+        data: String, // base64 encoded
+    },
+}
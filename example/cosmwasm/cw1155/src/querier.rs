@@ -0,0 +1,106 @@
+use cosmwasm_std::{QuerierWrapper, StdResult, Uint128};
+
+use crate::msg::{Route, EvmQuery, EvmQueryWrapper, Erc1155BalanceOfBatchResponse, Erc1155BalanceOfResponse, Erc1155IsApprovedForAllResponse, Erc1155NameSymbolResponse, Erc1155TotalSupplyResponse, Erc1155UriResponse, ErcPayloadResponse};
+
+pub struct EvmQuerier<'a> {
+    querier: &'a QuerierWrapper<'a, EvmQueryWrapper>,
+}
+
+impl<'a> EvmQuerier<'a> {
+    pub fn new(querier: &'a QuerierWrapper<EvmQueryWrapper>) -> Self {
+        EvmQuerier { querier }
+    }
+
+    // returns base64-encoded bytes
+    pub fn erc1155_transfer_payload(&self, from: String, recipient: String, token_id: String, amount: Uint128) -> StdResult<ErcPayloadResponse> {
+        let request = EvmQueryWrapper {
+            route: Route::Evm,
+            query_data: EvmQuery::Erc1155TransferPayload { from, recipient, token_id, amount },
+        }
+        .into();
+
+        self.querier.query(&request)
+    }
+
+    // returns base64-encoded bytes
+    pub fn erc1155_batch_transfer_payload(&self, from: String, recipient: String, token_ids: Vec<String>, amounts: Vec<Uint128>) -> StdResult<ErcPayloadResponse> {
+        let request = EvmQueryWrapper {
+            route: Route::Evm,
+            query_data: EvmQuery::Erc1155BatchTransferPayload { from, recipient, token_ids, amounts },
+        }
+        .into();
+
+        self.querier.query(&request)
+    }
+
+    // returns base64-encoded bytes
+    pub fn erc1155_set_approval_all_payload(&self, to: String, approved: bool) -> StdResult<ErcPayloadResponse> {
+        let request = EvmQueryWrapper {
+            route: Route::Evm,
+            query_data: EvmQuery::Erc1155SetApprovalAllPayload { to, approved },
+        }
+        .into();
+
+        self.querier.query(&request)
+    }
+
+    pub fn erc1155_balance_of(&self, caller: String, contract_address: String, account: String, token_id: String) -> StdResult<Erc1155BalanceOfResponse> {
+        let request = EvmQueryWrapper {
+            route: Route::Evm,
+            query_data: EvmQuery::Erc1155BalanceOf { caller, contract_address, account, token_id },
+        }
+        .into();
+
+        self.querier.query(&request)
+    }
+
+    pub fn erc1155_balance_of_batch(&self, caller: String, contract_address: String, accounts: Vec<String>, token_ids: Vec<String>) -> StdResult<Erc1155BalanceOfBatchResponse> {
+        let request = EvmQueryWrapper {
+            route: Route::Evm,
+            query_data: EvmQuery::Erc1155BalanceOfBatch { caller, contract_address, accounts, token_ids },
+        }
+        .into();
+
+        self.querier.query(&request)
+    }
+
+    pub fn erc1155_is_approved_for_all(&self, caller: String, contract_address: String, owner: String, operator: String) -> StdResult<Erc1155IsApprovedForAllResponse> {
+        let request = EvmQueryWrapper {
+            route: Route::Evm,
+            query_data: EvmQuery::Erc1155IsApprovedForAll { caller, contract_address, owner, operator },
+        }
+        .into();
+
+        self.querier.query(&request)
+    }
+
+    pub fn erc1155_uri(&self, caller: String, contract_address: String, token_id: String) -> StdResult<Erc1155UriResponse> {
+        let request = EvmQueryWrapper {
+            route: Route::Evm,
+            query_data: EvmQuery::Erc1155Uri { caller, contract_address, token_id },
+        }
+        .into();
+
+        self.querier.query(&request)
+    }
+
+    pub fn erc1155_total_supply(&self, caller: String, contract_address: String, token_id: String) -> StdResult<Erc1155TotalSupplyResponse> {
+        let request = EvmQueryWrapper {
+            route: Route::Evm,
+            query_data: EvmQuery::Erc1155TotalSupply { caller, contract_address, token_id },
+        }
+        .into();
+
+        self.querier.query(&request)
+    }
+
+    pub fn erc1155_name_symbol(&self, caller: String, contract_address: String) -> StdResult<Erc1155NameSymbolResponse> {
+        let request = EvmQueryWrapper {
+            route: Route::Evm,
+            query_data: EvmQuery::Erc1155NameSymbol { caller, contract_address },
+        }
+        .into();
+
+        self.querier.query(&request)
+    }
+}
@@ -0,0 +1,3 @@
+use cw_storage_plus::Item;
+
+pub const ERC1155_ADDRESS: Item<String> = Item::new("erc1155_address");
\ No newline at end of file
@@ -0,0 +1,292 @@
+#[cfg(not(feature = "library"))]
+use cosmwasm_std::entry_point;
+use cosmwasm_std::{
+    coin, to_json_binary, Binary, Deps, DepsMut, Env, MessageInfo, Response, StdResult, Uint128,
+};
+use cw20::{AllowanceResponse, BalanceResponse, Cw20ReceiveMsg, TokenInfoResponse};
+use cw_utils::Expiration;
+use crate::error::ContractError;
+use crate::msg::{cw20receive_into_cosmos_msg, EvmQueryWrapper, ExecuteMsg, InstantiateMsg, KiiMsg, MigrateMsg, QueryMsg};
+use crate::querier::EvmQuerier;
+use crate::state::{TokenInfo, ALLOWANCES, TOKEN_INFO};
+
+#[cfg_attr(not(feature = "library"), entry_point)]
+pub fn instantiate(
+    deps: DepsMut,
+    _env: Env,
+    _info: MessageInfo,
+    msg: InstantiateMsg,
+) -> Result<Response, ContractError> {
+    TOKEN_INFO.save(deps.storage, &TokenInfo {
+        denom: msg.denom,
+        name: msg.name,
+        symbol: msg.symbol,
+        decimals: msg.decimals,
+    })?;
+    Ok(Response::default())
+}
+
+#[cfg_attr(not(feature = "library"), entry_point)]
+pub fn migrate(
+    _deps: DepsMut,
+    _env: Env,
+    _msg: MigrateMsg,
+) -> Result<Response, ContractError> {
+    Ok(Response::default())
+}
+
+#[cfg_attr(not(feature = "library"), entry_point)]
+pub fn execute(
+    deps: DepsMut,
+    env: Env,
+    info: MessageInfo,
+    msg: ExecuteMsg,
+) -> Result<Response<KiiMsg>, ContractError> {
+    match msg {
+        ExecuteMsg::Transfer { recipient, amount } => {
+            execute_transfer(deps, env, info, recipient, amount)
+        },
+        ExecuteMsg::Send { contract, amount, msg } => {
+            execute_send(deps, env, info, contract, amount, msg)
+        },
+        ExecuteMsg::TransferFrom { owner, recipient, amount } => {
+            execute_transfer_from(deps, env, info, owner, recipient, amount)
+        },
+        ExecuteMsg::SendFrom { owner, contract, amount, msg } => {
+            execute_send_from(deps, env, info, owner, contract, amount, msg)
+        },
+        ExecuteMsg::IncreaseAllowance { spender, amount, expires: _ } => {
+            execute_increase_allowance(deps, env, info, spender, amount)
+        },
+        ExecuteMsg::DecreaseAllowance { spender, amount, expires: _ } => {
+            execute_decrease_allowance(deps, env, info, spender, amount)
+        },
+        _ => Err(ContractError::NotSupported {}),
+    }
+}
+
+#[cfg_attr(not(feature = "library"), entry_point)]
+pub fn query(deps: Deps<EvmQueryWrapper>, _env: Env, msg: QueryMsg) -> Result<Binary, ContractError> {
+    match msg {
+        QueryMsg::Balance { address } => Ok(query_balance(deps, address)?),
+        QueryMsg::TokenInfo {} => Ok(query_token_info(deps)?),
+        QueryMsg::Allowance { owner, spender } => {
+            Ok(query_allowance(deps, owner, spender)?)
+        },
+        _ => Err(ContractError::NotSupported {}),
+    }
+}
+
+pub fn execute_transfer(
+    deps: DepsMut,
+    _env: Env,
+    info: MessageInfo,
+    recipient: String,
+    amount: Uint128,
+) -> Result<Response<KiiMsg>, ContractError> {
+    let mut res = transfer(deps, info.sender.to_string(), recipient, amount)?;
+    res = res.add_attribute("action", "transfer");
+    Ok(res)
+}
+
+pub fn execute_send(
+    deps: DepsMut,
+    _env: Env,
+    info: MessageInfo,
+    contract: String,
+    amount: Uint128,
+    msg: Binary,
+) -> Result<Response<KiiMsg>, ContractError> {
+    let mut res = transfer(deps, info.sender.to_string(), contract.clone(), amount)?;
+    let send = Cw20ReceiveMsg {
+        sender: info.sender.to_string(),
+        amount,
+        msg,
+    };
+
+    res = res
+        .add_message(cw20receive_into_cosmos_msg(contract, send)?)
+        .add_attribute("action", "send");
+    Ok(res)
+}
+
+// Increase the allowance of spender by amount.
+// Expiration is not supported, allowances never expire.
+pub fn execute_increase_allowance(
+    deps: DepsMut,
+    _env: Env,
+    info: MessageInfo,
+    spender: String,
+    amount: Uint128,
+) -> Result<Response<KiiMsg>, ContractError> {
+    let spender_addr = deps.api.addr_validate(&spender)?;
+
+    let new_allowance = ALLOWANCES.update(
+        deps.storage,
+        (&info.sender, &spender_addr),
+        |allowance| -> StdResult<_> { Ok(allowance.unwrap_or_default().checked_add(amount)?) },
+    )?;
+
+    let res = Response::new()
+        .add_attribute("action", "increase_allowance")
+        .add_attribute("spender", spender)
+        .add_attribute("amount", amount)
+        .add_attribute("new_allowance", new_allowance)
+        .add_attribute("by", info.sender);
+
+    Ok(res)
+}
+
+// Decrease the allowance of spender by amount.
+// Expiration is not supported, allowances never expire.
+pub fn execute_decrease_allowance(
+    deps: DepsMut,
+    _env: Env,
+    info: MessageInfo,
+    spender: String,
+    amount: Uint128,
+) -> Result<Response<KiiMsg>, ContractError> {
+    let spender_addr = deps.api.addr_validate(&spender)?;
+
+    let key = (&info.sender, &spender_addr);
+    let current_allowance = ALLOWANCES.may_load(deps.storage, key)?.unwrap_or_default();
+
+    // If the new allowance after deduction is negative, set allowance to 0.
+    let new_allowance = current_allowance.checked_sub(amount).unwrap_or(Uint128::MIN);
+    if new_allowance.is_zero() {
+        ALLOWANCES.remove(deps.storage, key);
+    } else {
+        ALLOWANCES.save(deps.storage, key, &new_allowance)?;
+    }
+
+    let res = Response::new()
+        .add_attribute("action", "decrease_allowance")
+        .add_attribute("spender", spender)
+        .add_attribute("amount", amount)
+        .add_attribute("new_allowance", new_allowance)
+        .add_attribute("by", info.sender);
+
+    Ok(res)
+}
+
+pub fn execute_transfer_from(
+    deps: DepsMut,
+    _env: Env,
+    info: MessageInfo,
+    owner: String,
+    recipient: String,
+    amount: Uint128,
+) -> Result<Response<KiiMsg>, ContractError> {
+    let mut res = transfer_from(deps, info, owner, recipient, amount)?;
+    res = res.add_attribute("action", "transfer_from");
+
+    Ok(res)
+}
+
+pub fn execute_send_from(
+    deps: DepsMut,
+    _env: Env,
+    info: MessageInfo,
+    owner: String,
+    contract: String,
+    amount: Uint128,
+    msg: Binary,
+) -> Result<Response<KiiMsg>, ContractError> {
+    let mut res = transfer_from(deps, info.clone(), owner, contract.clone(), amount)?;
+    let send = Cw20ReceiveMsg {
+        sender: info.sender.to_string(),
+        amount,
+        msg,
+    };
+
+    res = res
+        .add_message(cw20receive_into_cosmos_msg(contract, send)?)
+        .add_attribute("action", "send_from");
+    Ok(res)
+}
+
+fn transfer(
+    deps: DepsMut,
+    owner: String,
+    recipient: String,
+    amount: Uint128,
+) -> Result<Response<KiiMsg>, ContractError> {
+    if amount.is_zero() {
+        return Err(ContractError::InvalidZeroAmount {});
+    }
+    deps.api.addr_validate(&recipient)?;
+
+    let token_info = TOKEN_INFO.load(deps.storage)?;
+
+    // the balances live in the bank module, so the chain moves the funds on
+    // behalf of this contract
+    let msg = KiiMsg::SendNative {
+        from: owner.clone(),
+        to: recipient.clone(),
+        amount: coin(amount.u128(), token_info.denom),
+    };
+    let res = Response::new()
+        .add_attribute("from", owner)
+        .add_attribute("to", recipient)
+        .add_attribute("amount", amount)
+        .add_message(msg);
+
+    Ok(res)
+}
+
+fn transfer_from(
+    deps: DepsMut,
+    info: MessageInfo,
+    owner: String,
+    recipient: String,
+    amount: Uint128,
+) -> Result<Response<KiiMsg>, ContractError> {
+    let owner_addr = deps.api.addr_validate(&owner)?;
+
+    // deduct the allowance of the spender first
+    let key = (&owner_addr, &info.sender);
+    let current_allowance = ALLOWANCES.may_load(deps.storage, key)?.unwrap_or_default();
+    let new_allowance = current_allowance
+        .checked_sub(amount)
+        .map_err(|_| ContractError::NoAllowance {})?;
+    if new_allowance.is_zero() {
+        ALLOWANCES.remove(deps.storage, key);
+    } else {
+        ALLOWANCES.save(deps.storage, key, &new_allowance)?;
+    }
+
+    let res = transfer(deps, owner, recipient, amount)?;
+    Ok(res.add_attribute("by", info.sender))
+}
+
+pub fn query_allowance(deps: Deps<EvmQueryWrapper>, owner: String, spender: String) -> StdResult<Binary> {
+    let owner_addr = deps.api.addr_validate(&owner)?;
+    let spender_addr = deps.api.addr_validate(&spender)?;
+
+    let allowance = ALLOWANCES
+        .may_load(deps.storage, (&owner_addr, &spender_addr))?
+        .unwrap_or_default();
+    to_json_binary(&AllowanceResponse { allowance, expires: Expiration::Never {} })
+}
+
+pub fn query_token_info(deps: Deps<EvmQueryWrapper>) -> StdResult<Binary> {
+    let token_info = TOKEN_INFO.load(deps.storage)?;
+
+    let querier = EvmQuerier::new(&deps.querier);
+    let supply = querier.native_supply(token_info.denom)?.amount;
+    to_json_binary(&TokenInfoResponse {
+        name: token_info.name,
+        symbol: token_info.symbol,
+        decimals: token_info.decimals,
+        total_supply: supply.amount,
+    })
+}
+
+pub fn query_balance(deps: Deps<EvmQueryWrapper>, address: String) -> StdResult<Binary> {
+    deps.api.addr_validate(&address)?;
+
+    let token_info = TOKEN_INFO.load(deps.storage)?;
+
+    let balance = deps.querier.query_balance(address, token_info.denom)?;
+    to_json_binary(&BalanceResponse { balance: balance.amount })
+}
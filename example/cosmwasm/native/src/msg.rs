@@ -0,0 +1,89 @@
+use cosmwasm_schema::cw_serde;
+use cosmwasm_std::{Coin, CosmosMsg, CustomMsg, CustomQuery, StdResult, WasmMsg};
+use cw20::Cw20ReceiveMsg;
+use schemars::JsonSchema;
+use serde::{Deserialize, Serialize};
+
+pub use cw20::{Cw20ExecuteMsg as ExecuteMsg, Cw20QueryMsg as QueryMsg};
+
+#[cw_serde]
+pub struct InstantiateMsg {
+    pub denom: String,
+    pub name: String,
+    pub symbol: String,
+    pub decimals: u8,
+}
+
+#[cw_serde]
+pub struct MigrateMsg {}
+
+/// KiiRoute is enum type to represent kii query route path
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+#[serde(rename_all = "snake_case")]
+pub enum Route {
+    Evm,
+}
+
+/// EvmQueryWrapper is an override of QueryRequest::Custom to access EVM
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+#[serde(rename_all = "snake_case")]
+pub struct EvmQueryWrapper {
+    pub route: Route,
+    pub query_data: EvmQuery,
+}
+
+// implement custom query
+impl CustomQuery for EvmQueryWrapper {}
+
+/// EvmQuery is defines available query datas
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+#[serde(rename_all = "snake_case")]
+pub enum EvmQuery {
+    /// The bank queries of wasmd do not serve the supply of a denom, so the
+    /// EVM module answers it.
+    NativeSupply {
+        denom: String,
+    },
+}
+
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+pub struct NativeSupplyResponse {
+    pub amount: Coin,
+}
+
+// implement custom msg
+impl CustomMsg for KiiMsg {}
+
+// this is a helper to be able to return these as CosmosMsg easier
+impl From<KiiMsg> for CosmosMsg<KiiMsg> {
+    fn from(original: KiiMsg) -> Self {
+        CosmosMsg::Custom(original)
+    }
+}
+
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+#[serde(rename_all = "snake_case")]
+pub enum KiiMsg {
+    /// Moves native funds between any two accounts. Only the registered CW20
+    /// pointer of the denom is allowed to send it.
+    SendNative {
+        from: String,
+        to: String,
+        amount: Coin,
+    },
+}
+
+/// Helper to convert a Cw20ReceiveMsg into a CosmosMsg
+pub fn cw20receive_into_cosmos_msg<T: Into<String>, C>(contract_addr: T, message: Cw20ReceiveMsg) -> StdResult<CosmosMsg<C>>
+where
+    C: Clone + std::fmt::Debug + PartialEq + JsonSchema,
+{
+    let msg = message.into_binary()?;
+    let execute = WasmMsg::Execute {
+        contract_addr: contract_addr.into(),
+        msg,
+        funds: vec![],
+    };
+
+    Ok(execute.into())
+}
@@ -0,0 +1,23 @@
+use cosmwasm_std::{QuerierWrapper, StdResult};
+
+use crate::msg::{Route, EvmQuery, EvmQueryWrapper, NativeSupplyResponse};
+
+pub struct EvmQuerier<'a> {
+    querier: &'a QuerierWrapper<'a, EvmQueryWrapper>,
+}
+
+impl<'a> EvmQuerier<'a> {
+    pub fn new(querier: &'a QuerierWrapper<EvmQueryWrapper>) -> Self {
+        EvmQuerier { querier }
+    }
+
+    pub fn native_supply(&self, denom: String) -> StdResult<NativeSupplyResponse> {
+        let request = EvmQueryWrapper {
+            route: Route::Evm,
+            query_data: EvmQuery::NativeSupply { denom },
+        }
+        .into();
+
+        self.querier.query(&request)
+    }
+}
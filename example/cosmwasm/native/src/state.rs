@@ -0,0 +1,16 @@
+use cosmwasm_schema::cw_serde;
+use cosmwasm_std::{Addr, Uint128};
+use cw_storage_plus::{Item, Map};
+
+#[cw_serde]
+pub struct TokenInfo {
+    pub denom: String,
+    pub name: String,
+    pub symbol: String,
+    pub decimals: u8,
+}
+
+pub const TOKEN_INFO: Item<TokenInfo> = Item::new("token_info");
+
+// (owner, spender) -> allowance
+pub const ALLOWANCES: Map<(&Addr, &Addr), Uint128> = Map::new("allowances");
@@ -8,6 +8,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
@@ -150,7 +151,9 @@ func (p PrecompileExecutor) send(ctx sdk.Context, caller common.Address, method
 		return nil, err
 	}
 
-	if err := p.bankKeeper.SendCoins(ctx, senderKiiAddr, receiverKiiAddr, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewIntFromBigInt(amount)))); err != nil {
+	coins := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewIntFromBigInt(amount)))
+	pcommon.TraceCosmosMsg(ctx, banktypes.NewMsgSend(senderKiiAddr, receiverKiiAddr, coins))
+	if err := p.bankKeeper.SendCoins(ctx, senderKiiAddr, receiverKiiAddr, coins); err != nil {
 		return nil, err
 	}
 
@@ -191,6 +194,8 @@ func (p PrecompileExecutor) sendNative(ctx sdk.Context, method *abi.Method, args
 		return nil, err
 	}
 
+	// the wei remainder has no message equivalent
+	pcommon.TraceCosmosMsg(ctx, banktypes.NewMsgSend(senderKiiAddr, receiverKiiAddr, sdk.NewCoins(sdk.NewCoin(sdk.MustGetBaseDenom(), ukii))))
 	if err := p.bankKeeper.SendCoinsAndWei(ctx, senderKiiAddr, receiverKiiAddr, ukii, wei); err != nil {
 		return nil, err
 	}
@@ -45,6 +45,8 @@ type EVMKeeper interface {
 	GetERC20CW20Pointer(ctx sdk.Context, cw20Address string) (addr common.Address, version uint16, exists bool)
 	SetERC721CW721Pointer(ctx sdk.Context, cw721Address string, addr common.Address) error
 	GetERC721CW721Pointer(ctx sdk.Context, cw721Address string) (addr common.Address, version uint16, exists bool)
+	SetERC1155CW1155Pointer(ctx sdk.Context, cw1155Address string, addr common.Address) error
+	GetERC1155CW1155Pointer(ctx sdk.Context, cw1155Address string) (addr common.Address, version uint16, exists bool)
 	SetCode(ctx sdk.Context, addr common.Address, code []byte)
 	UpsertERCNativePointer(
 		ctx sdk.Context, evm *vm.EVM, token string, metadata utils.ERCMetadata,
@@ -55,6 +57,9 @@ type EVMKeeper interface {
 	UpsertERCCW721Pointer(
 		ctx sdk.Context, evm *vm.EVM, cw721Addr string, metadata utils.ERCMetadata,
 	) (contractAddr common.Address, err error)
+	UpsertERCCW1155Pointer(
+		ctx sdk.Context, evm *vm.EVM, cw1155Addr string, metadata utils.ERCMetadata,
+	) (contractAddr common.Address, err error)
 	GetEVMGasLimitFromCtx(ctx sdk.Context) uint64
 	GetCosmosGasLimitFromEVMGas(ctx sdk.Context, evmGas uint64) uint64
 }
@@ -74,6 +74,7 @@ func (p Precompile) Run(evm *vm.EVM, caller common.Address, callingContract comm
 	em := ctx.EventManager()
 	ctx = ctx.WithEventManager(sdk.NewEventManager())
 	ctx = ctx.WithEVMPrecompileCalledFromDelegateCall(isFromDelegateCall)
+	defer traceCall(ctx, p.address, p.name, method, args, &err)()
 	bz, err = p.executor.Execute(ctx, method, caller, callingContract, args, value, readOnly, evm)
 	if err != nil {
 		return bz, err
@@ -166,6 +167,7 @@ func (d DynamicGasPrecompile) RunAndCalculateGas(evm *vm.EVM, caller common.Addr
 	em := ctx.EventManager()
 	ctx = ctx.WithEventManager(sdk.NewEventManager())
 	ctx = ctx.WithEVMPrecompileCalledFromDelegateCall(isFromDelegateCall)
+	defer traceCall(ctx, d.address, d.name, method, args, &err)()
 	ret, remainingGas, err = d.executor.Execute(ctx, method, caller, callingContract, args, value, readOnly, evm, suppliedGas)
 	if err != nil {
 		return ret, remainingGas, err
@@ -130,3 +130,47 @@ func TestDynamicGasPrecompileRun(t *testing.T) {
 	// should not emit any event
 	require.Empty(t, stateDB.Ctx().EventManager().Events())
 }
+
+type recordingTracer struct {
+	msgs  []sdk.Msg
+	calls []*common.PrecompileCall
+}
+
+func (r *recordingTracer) OnCosmosMsg(msg sdk.Msg) { r.msgs = append(r.msgs, msg) }
+
+func (r *recordingTracer) OnPrecompileCall(call *common.PrecompileCall) {
+	r.calls = append(r.calls, call)
+}
+
+func TestPrecompileTracer(t *testing.T) {
+	k := &testkeeper.EVMTestApp.EvmKeeper
+	ctx := testkeeper.EVMTestApp.GetContextForDeliverTx(nil)
+	abiBz, err := os.ReadFile("erc20_abi.json")
+	require.Nil(t, err)
+	newAbi, err := abi.JSON(bytes.NewReader(abiBz))
+	require.Nil(t, err)
+	input, err := newAbi.Pack("decimals")
+	require.Nil(t, err)
+	tracer := &recordingTracer{}
+	stateDB := state.NewDBImpl(common.WithPrecompileTracer(ctx, tracer), k, false)
+
+	precompile := common.NewPrecompile(newAbi, &MockPrecompileExecutor{throw: false}, ethcommon.Address{1}, "test")
+	_, err = precompile.Run(&vm.EVM{StateDB: stateDB}, ethcommon.Address{}, ethcommon.Address{}, input, big.NewInt(0), false, false)
+	require.Nil(t, err)
+	require.Len(t, tracer.calls, 1)
+	require.Equal(t, ethcommon.Address{1}, tracer.calls[0].Address)
+	require.Equal(t, "decimals", tracer.calls[0].Method.Name)
+	require.Len(t, tracer.calls[0].Events, 1)
+
+	// failed calls have no events
+	precompile = common.NewPrecompile(newAbi, &MockPrecompileExecutor{throw: true}, ethcommon.Address{1}, "test")
+	_, err = precompile.Run(&vm.EVM{StateDB: stateDB}, ethcommon.Address{}, ethcommon.Address{}, input, big.NewInt(0), false, false)
+	require.NotNil(t, err)
+	require.Len(t, tracer.calls, 2)
+	require.Empty(t, tracer.calls[1].Events)
+
+	common.TraceCosmosMsg(stateDB.Ctx(), &types.MsgAssociate{})
+	require.Len(t, tracer.msgs, 1)
+	common.TraceCosmosMsg(ctx, &types.MsgAssociate{})
+	require.Len(t, tracer.msgs, 1)
+}
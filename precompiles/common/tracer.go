@@ -0,0 +1,85 @@
+package common
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// PrecompileTracer is implemented by EVM tracers that record the Cosmos side
+// of precompile calls. Precompiles report to the tracer attached to the context
+// of the StateDB with WithPrecompileTracer.
+type PrecompileTracer interface {
+	// OnCosmosMsg is called for each Cosmos message dispatched by a precompile.
+	OnCosmosMsg(msg sdk.Msg)
+	// OnPrecompileCall is called when a precompile call returns, before the
+	// EVM exits the call frame.
+	OnPrecompileCall(call *PrecompileCall)
+}
+
+// PrecompileCall is a precompile call as seen from the Cosmos side.
+type PrecompileCall struct {
+	Address common.Address
+	Name    string
+	Method  *abi.Method
+	Args    []interface{}
+	// Events emitted by the call, empty if it failed
+	Events        sdk.Events
+	CosmosGasUsed uint64
+}
+
+type precompileTracerKey struct{}
+
+// WithPrecompileTracer returns a context whose precompile calls are reported to
+// the given tracer, or to no tracer if it is nil.
+func WithPrecompileTracer(ctx sdk.Context, tracer PrecompileTracer) sdk.Context {
+	goCtx := ctx.Context()
+	if goCtx == nil {
+		goCtx = context.Background()
+	}
+	return ctx.WithContext(context.WithValue(goCtx, precompileTracerKey{}, tracer))
+}
+
+// GetPrecompileTracer returns the tracer attached to the context, if any.
+func GetPrecompileTracer(ctx sdk.Context) PrecompileTracer {
+	if ctx.Context() == nil {
+		return nil
+	}
+	tracer, _ := ctx.Context().Value(precompileTracerKey{}).(PrecompileTracer)
+	return tracer
+}
+
+// TraceCosmosMsg reports a Cosmos message dispatched by a precompile to the
+// tracer attached to the context. Precompiles that call keepers directly
+// report the message equivalent to the call.
+func TraceCosmosMsg(ctx sdk.Context, msg sdk.Msg) {
+	if tracer := GetPrecompileTracer(ctx); tracer != nil {
+		tracer.OnCosmosMsg(msg)
+	}
+}
+
+// traceCall reports a precompile call to the tracer attached to the context
+// once the call returns. It must be deferred right before the call executes.
+func traceCall(ctx sdk.Context, address common.Address, name string, method *abi.Method, args []interface{}, err *error) func() {
+	tracer := GetPrecompileTracer(ctx)
+	if tracer == nil {
+		return func() {}
+	}
+	gasBefore := ctx.GasMeter().GasConsumed()
+	return func() {
+		call := &PrecompileCall{
+			Address:       address,
+			Name:          name,
+			Method:        method,
+			Args:          args,
+			Events:        sdk.Events{},
+			CosmosGasUsed: ctx.GasMeter().GasConsumed() - gasBefore,
+		}
+		if *err == nil {
+			call.Events = ctx.EventManager().Events()
+		}
+		tracer.OnPrecompileCall(call)
+	}
+}
@@ -127,6 +127,7 @@ func (p PrecompileExecutor) setWithdrawAddress(ctx sdk.Context, method *abi.Meth
 		rerr = err
 		return
 	}
+	pcommon.TraceCosmosMsg(ctx, distrtypes.NewMsgSetWithdrawAddress(delegator, withdrawAddr))
 	err = p.distrKeeper.SetWithdrawAddr(ctx, delegator, withdrawAddr)
 	if err != nil {
 		rerr = err
@@ -184,6 +185,7 @@ func (p PrecompileExecutor) withdraw(ctx sdk.Context, delegator sdk.AccAddress,
 	if err != nil {
 		return nil, err
 	}
+	pcommon.TraceCosmosMsg(ctx, distrtypes.NewMsgWithdrawDelegatorReward(delegator, validator))
 	return p.distrKeeper.WithdrawDelegationRewards(ctx, delegator, validator)
 }
 
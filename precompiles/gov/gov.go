@@ -104,6 +104,7 @@ func (p PrecompileExecutor) vote(ctx sdk.Context, method *abi.Method, caller com
 	}
 	proposalID := args[0].(uint64)
 	voteOption := args[1].(int32)
+	pcommon.TraceCosmosMsg(ctx, govtypes.NewMsgVote(voter, proposalID, govtypes.VoteOption(voteOption)))
 	err := p.govKeeper.AddVote(ctx, proposalID, voter, govtypes.NewNonSplitVoteOption(govtypes.VoteOption(voteOption)))
 	if err != nil {
 		return nil, err
@@ -127,6 +128,7 @@ func (p PrecompileExecutor) deposit(ctx sdk.Context, method *abi.Method, caller
 	if err != nil {
 		return nil, err
 	}
+	pcommon.TraceCosmosMsg(ctx, govtypes.NewMsgDeposit(depositor, proposalID, sdk.NewCoins(coin)))
 	res, err := p.govKeeper.AddDeposit(ctx, proposalID, depositor, sdk.NewCoins(coin))
 	if err != nil {
 		return nil, err
@@ -166,6 +166,7 @@ func (p PrecompileExecutor) transfer(ctx sdk.Context, method *abi.Method, args [
 		return
 	}
 
+	pcommon.TraceCosmosMsg(ctx, &msg)
 	_, err = p.transferKeeper.Transfer(sdk.WrapSDKContext(ctx), &msg)
 
 	if err != nil {
@@ -252,6 +253,7 @@ func (p PrecompileExecutor) transferWithDefaultTimeout(ctx sdk.Context, method *
 		return
 	}
 
+	pcommon.TraceCosmosMsg(ctx, &msg)
 	_, err = p.transferKeeper.Transfer(sdk.WrapSDKContext(ctx), &msg)
 
 	if err != nil {
@@ -17,4 +17,8 @@ interface IPointer {
     function addCW721Pointer(
         string memory cwAddr
     ) external returns (address ret);
+
+    function addCW1155Pointer(
+        string memory cwAddr
+    ) external returns (address ret);
 }
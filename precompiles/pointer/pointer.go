@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"math"
 	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -21,6 +20,7 @@ const (
 	AddNativePointer = "addNativePointer"
 	AddCW20Pointer   = "addCW20Pointer"
 	AddCW721Pointer  = "addCW721Pointer"
+	AddCW1155Pointer = "addCW1155Pointer"
 )
 
 const PointerAddress = "0x000000000000000000000000000000000000100b"
@@ -38,6 +38,7 @@ type PrecompileExecutor struct {
 	AddNativePointerID []byte
 	AddCW20PointerID   []byte
 	AddCW721PointerID  []byte
+	AddCW1155PointerID []byte
 }
 
 func NewPrecompile(evmKeeper pcommon.EVMKeeper, bankKeeper pcommon.BankKeeper, wasmdKeeper pcommon.WasmdViewKeeper) (*pcommon.DynamicGasPrecompile, error) {
@@ -57,6 +58,8 @@ func NewPrecompile(evmKeeper pcommon.EVMKeeper, bankKeeper pcommon.BankKeeper, w
 			p.AddCW20PointerID = m.ID
 		case AddCW721Pointer:
 			p.AddCW721PointerID = m.ID
+		case AddCW1155Pointer:
+			p.AddCW1155PointerID = m.ID
 		}
 	}
 
@@ -78,6 +81,8 @@ func (p PrecompileExecutor) Execute(ctx sdk.Context, method *ethabi.Method, call
 		return p.AddCW20(ctx, method, caller, args, value, evm)
 	case AddCW721Pointer:
 		return p.AddCW721(ctx, method, caller, args, value, evm)
+	case AddCW1155Pointer:
+		return p.AddCW1155(ctx, method, caller, args, value, evm)
 	default:
 		err = fmt.Errorf("unknown method %s", method.Name)
 	}
@@ -100,20 +105,7 @@ func (p PrecompileExecutor) AddNative(ctx sdk.Context, method *ethabi.Method, ca
 	if !metadataExists {
 		return nil, 0, fmt.Errorf("denom %s does not have metadata stored and thus can only have its pointer set through gov proposal", token)
 	}
-	name := metadata.Name
-	symbol := metadata.Symbol
-	var decimals uint8
-	for _, denomUnit := range metadata.DenomUnits {
-		if denomUnit.Exponent > uint32(decimals) && denomUnit.Exponent <= math.MaxUint8 {
-			decimals = uint8(denomUnit.Exponent)
-			name = denomUnit.Denom
-			symbol = denomUnit.Denom
-			if len(denomUnit.Aliases) > 0 {
-				name = denomUnit.Aliases[0]
-			}
-		}
-	}
-	contractAddr, err := p.evmKeeper.UpsertERCNativePointer(ctx, evm, token, utils.ERCMetadata{Name: name, Symbol: symbol, Decimals: decimals})
+	contractAddr, err := p.evmKeeper.UpsertERCNativePointer(ctx, evm, token, utils.ERCMetadataFromDenomMetadata(metadata))
 	if err != nil {
 		return nil, 0, err
 	}
@@ -183,3 +175,40 @@ func (p PrecompileExecutor) AddCW721(ctx sdk.Context, method *ethabi.Method, cal
 	remainingGas = pcommon.GetRemainingGas(ctx, p.evmKeeper)
 	return
 }
+
+func (p PrecompileExecutor) AddCW1155(ctx sdk.Context, method *ethabi.Method, caller common.Address, args []interface{}, value *big.Int, evm *vm.EVM) (ret []byte, remainingGas uint64, err error) {
+	if err := pcommon.ValidateNonPayable(value); err != nil {
+		return nil, 0, err
+	}
+	if err := pcommon.ValidateArgsLength(args, 1); err != nil {
+		return nil, 0, err
+	}
+	cwAddr := args[0].(string)
+	cwAddress, err := sdk.AccAddressFromBech32(cwAddr)
+	if err != nil {
+		return nil, 0, err
+	}
+	res, err := p.wasmdKeeper.QuerySmart(ctx, cwAddress, []byte("{\"contract_info\":{}}"))
+	if err != nil {
+		return nil, 0, err
+	}
+	formattedRes := map[string]interface{}{}
+	if err := json.Unmarshal(res, &formattedRes); err != nil {
+		return nil, 0, err
+	}
+	name, ok := formattedRes["name"].(string)
+	if !ok {
+		return nil, 0, fmt.Errorf("contract_info of %s does not have a name", cwAddr)
+	}
+	symbol, ok := formattedRes["symbol"].(string)
+	if !ok {
+		return nil, 0, fmt.Errorf("contract_info of %s does not have a symbol", cwAddr)
+	}
+	contractAddr, err := p.evmKeeper.UpsertERCCW1155Pointer(ctx, evm, cwAddr, utils.ERCMetadata{Name: name, Symbol: symbol})
+	if err != nil {
+		return nil, 0, err
+	}
+	ret, err = method.Outputs.Pack(contractAddr)
+	remainingGas = pcommon.GetRemainingGas(ctx, p.evmKeeper)
+	return
+}
@@ -57,7 +57,7 @@ func TestAddNative(t *testing.T) {
 	evm = vm.NewEVM(*blockCtx, vm.TxContext{}, statedb, cfg, vm.Config{})
 	ret, g, err := p.RunAndCalculateGas(evm, caller, caller, append(p.GetExecutor().(*pointer.PrecompileExecutor).AddNativePointerID, args...), suppliedGas, nil, nil, false, false)
 	require.Nil(t, err)
-	require.Equal(t, uint64(0x879ae8), g)
+	require.Equal(t, uint64(0x876fbe), g)
 	outputs, err := m.Outputs.Unpack(ret)
 	require.Nil(t, err)
 	addr := outputs[0].(common.Address)
@@ -92,3 +92,44 @@ func TestAddNative(t *testing.T) {
 	require.Equal(t, addr, pointerAddr)
 	require.Equal(t, newAddr, pointerAddr) // address should stay the same as before
 }
+
+type contractInfoWasmdKeeper struct {
+	res string
+}
+
+func (k contractInfoWasmdKeeper) QuerySmart(sdk.Context, sdk.AccAddress, []byte) ([]byte, error) {
+	return []byte(k.res), nil
+}
+
+func TestAddCW1155MissingMetadata(t *testing.T) {
+	testApp := testkeeper.EVMTestApp
+	ctx := testApp.GetContextForDeliverTx([]byte{}).WithBlockTime(time.Now())
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeterWithMultiplier(ctx))
+	cwAddr, caller := testkeeper.MockAddressPair()
+	suppliedGas := uint64(10000000)
+	cfg := types.DefaultChainConfig().EthereumConfig(testApp.EvmKeeper.ChainID(ctx))
+	blockCtx, _ := testApp.EvmKeeper.GetVMBlockContext(ctx, core.GasPool(suppliedGas))
+
+	for _, tc := range []struct {
+		res string
+		err string
+	}{
+		{`{"symbol":"FOO"}`, "does not have a name"},
+		{`{"name":"foo"}`, "does not have a symbol"},
+	} {
+		p, err := pointer.NewPrecompile(&testApp.EvmKeeper, testApp.BankKeeper, contractInfoWasmdKeeper{res: tc.res})
+		require.Nil(t, err)
+		executor := p.GetExecutor().(*pointer.PrecompileExecutor)
+		m, err := p.ABI.MethodById(executor.AddCW1155PointerID)
+		require.Nil(t, err)
+		args, err := m.Inputs.Pack(cwAddr.String())
+		require.Nil(t, err)
+		statedb := state.NewDBImpl(ctx, &testApp.EvmKeeper, true)
+		evm := vm.NewEVM(*blockCtx, vm.TxContext{}, statedb, cfg, vm.Config{})
+		_, _, err = p.RunAndCalculateGas(evm, caller, caller, append(executor.AddCW1155PointerID, args...), suppliedGas, nil, nil, false, false)
+		require.NotNil(t, err)
+		require.ErrorContains(t, statedb.GetPrecompileError(), tc.err)
+		_, _, exists := testApp.EvmKeeper.GetERC1155CW1155Pointer(statedb.Ctx(), cwAddr.String())
+		require.False(t, exists)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/kiichain/kiichain3/precompiles/pointerview"
 	testkeeper "github.com/kiichain/kiichain3/testutil/keeper"
+	"github.com/kiichain/kiichain3/x/evm/artifacts/cw1155"
 	"github.com/kiichain/kiichain3/x/evm/artifacts/cw20"
 	"github.com/kiichain/kiichain3/x/evm/artifacts/cw721"
 	"github.com/kiichain/kiichain3/x/evm/artifacts/native"
@@ -22,6 +23,7 @@ func TestPointerView(t *testing.T) {
 	k.SetERC20NativePointer(ctx, "test", pointer)
 	k.SetERC20CW20Pointer(ctx, "test", pointer)
 	k.SetERC721CW721Pointer(ctx, "test", pointer)
+	k.SetERC1155CW1155Pointer(ctx, "test", pointer)
 	m, err := p.ABI.MethodById(p.GetExecutor().(*pointerview.PrecompileExecutor).GetNativePointerID)
 	require.Nil(t, err)
 	ret, err := p.GetExecutor().(*pointerview.PrecompileExecutor).GetNative(ctx, m, []interface{}{"test"})
@@ -44,7 +46,7 @@ func TestPointerView(t *testing.T) {
 	outputs, err = m.Outputs.Unpack(ret)
 	require.Nil(t, err)
 	require.Equal(t, pointer, outputs[0].(common.Address))
-	require.Equal(t, cw20.CurrentVersion(ctx), outputs[1].(uint16))
+	require.Equal(t, cw20.CurrentVersion(k.IsLiveChain(ctx)), outputs[1].(uint16))
 	require.True(t, outputs[2].(bool))
 	ret, err = p.GetExecutor().(*pointerview.PrecompileExecutor).GetCW20(ctx, m, []interface{}{"test2"})
 	require.Nil(t, err)
@@ -66,4 +68,19 @@ func TestPointerView(t *testing.T) {
 	outputs, err = m.Outputs.Unpack(ret)
 	require.Nil(t, err)
 	require.False(t, outputs[2].(bool))
+
+	m, err = p.ABI.MethodById(p.GetExecutor().(*pointerview.PrecompileExecutor).GetCW1155PointerID)
+	require.Nil(t, err)
+	ret, err = p.GetExecutor().(*pointerview.PrecompileExecutor).GetCW1155(ctx, m, []interface{}{"test"})
+	require.Nil(t, err)
+	outputs, err = m.Outputs.Unpack(ret)
+	require.Nil(t, err)
+	require.Equal(t, pointer, outputs[0].(common.Address))
+	require.Equal(t, cw1155.CurrentVersion, outputs[1].(uint16))
+	require.True(t, outputs[2].(bool))
+	ret, err = p.GetExecutor().(*pointerview.PrecompileExecutor).GetCW1155(ctx, m, []interface{}{"test2"})
+	require.Nil(t, err)
+	outputs, err = m.Outputs.Unpack(ret)
+	require.Nil(t, err)
+	require.False(t, outputs[2].(bool))
 }
@@ -130,11 +130,13 @@ func (p PrecompileExecutor) delegate(ctx sdk.Context, method *abi.Method, caller
 	if err != nil {
 		return nil, err
 	}
-	_, err = p.stakingKeeper.Delegate(sdk.WrapSDKContext(ctx), &stakingtypes.MsgDelegate{
+	msg := &stakingtypes.MsgDelegate{
 		DelegatorAddress: delegator.String(),
 		ValidatorAddress: validatorBech32,
 		Amount:           coin,
-	})
+	}
+	pcommon.TraceCosmosMsg(ctx, msg)
+	_, err = p.stakingKeeper.Delegate(sdk.WrapSDKContext(ctx), msg)
 	if err != nil {
 		return nil, err
 	}
@@ -156,12 +158,14 @@ func (p PrecompileExecutor) redelegate(ctx sdk.Context, method *abi.Method, call
 	srcValidatorBech32 := args[0].(string)
 	dstValidatorBech32 := args[1].(string)
 	amount := args[2].(*big.Int)
-	_, err := p.stakingKeeper.BeginRedelegate(sdk.WrapSDKContext(ctx), &stakingtypes.MsgBeginRedelegate{
+	msg := &stakingtypes.MsgBeginRedelegate{
 		DelegatorAddress:    delegator.String(),
 		ValidatorSrcAddress: srcValidatorBech32,
 		ValidatorDstAddress: dstValidatorBech32,
 		Amount:              sdk.NewCoin(sdk.MustGetBaseDenom(), sdk.NewIntFromBigInt(amount)),
-	})
+	}
+	pcommon.TraceCosmosMsg(ctx, msg)
+	_, err := p.stakingKeeper.BeginRedelegate(sdk.WrapSDKContext(ctx), msg)
 	if err != nil {
 		return nil, err
 	}
@@ -182,11 +186,13 @@ func (p PrecompileExecutor) undelegate(ctx sdk.Context, method *abi.Method, call
 	}
 	validatorBech32 := args[0].(string)
 	amount := args[1].(*big.Int)
-	_, err := p.stakingKeeper.Undelegate(sdk.WrapSDKContext(ctx), &stakingtypes.MsgUndelegate{
+	msg := &stakingtypes.MsgUndelegate{
 		DelegatorAddress: delegator.String(),
 		ValidatorAddress: validatorBech32,
 		Amount:           sdk.NewCoin(p.evmKeeper.GetBaseDenom(ctx), sdk.NewIntFromBigInt(amount)),
-	})
+	}
+	pcommon.TraceCosmosMsg(ctx, msg)
+	_, err := p.stakingKeeper.Undelegate(sdk.WrapSDKContext(ctx), msg)
 	if err != nil {
 		return nil, err
 	}
@@ -51,7 +51,17 @@ func MockEVMKeeperWithPrecompiles() (*evmkeeper.Keeper, sdk.Context) {
 }
 
 func MockEVMKeeper() (*evmkeeper.Keeper, sdk.Context) {
-	testApp := app.Setup(false, false)
+	return mockEVMKeeper(app.Setup(false, false))
+}
+
+// MockEVMKeeperWithVersionedReceiptStore is MockEVMKeeper with a receipt store
+// that reads versions like the pebble store, for tests of the indexes built in
+// the receipt store over many blocks.
+func MockEVMKeeperWithVersionedReceiptStore() (*evmkeeper.Keeper, sdk.Context) {
+	return mockEVMKeeper(app.SetupWithReceiptStore(false, false, app.NewVersionedInMemoryStateStore()))
+}
+
+func mockEVMKeeper(testApp *app.App) (*evmkeeper.Keeper, sdk.Context) {
 	ctx := testApp.GetContextForDeliverTx([]byte{}).WithBlockHeight(8).WithBlockTime(time.Now())
 	k := testApp.EvmKeeper
 	k.InitGenesis(ctx, *evmtypes.DefaultGenesis())
@@ -1,7 +1,32 @@
 package utils
 
+import (
+	"math"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
 type ERCMetadata struct {
 	Name     string
 	Symbol   string
 	Decimals uint8
 }
+
+// ERCMetadataFromDenomMetadata derives the token metadata of a pointer from the
+// bank metadata of a denom, using its denom unit with the largest exponent.
+func ERCMetadataFromDenomMetadata(metadata banktypes.Metadata) ERCMetadata {
+	name := metadata.Name
+	symbol := metadata.Symbol
+	var decimals uint8
+	for _, denomUnit := range metadata.DenomUnits {
+		if denomUnit.Exponent > uint32(decimals) && denomUnit.Exponent <= math.MaxUint8 {
+			decimals = uint8(denomUnit.Exponent)
+			name = denomUnit.Denom
+			symbol = denomUnit.Denom
+			if len(denomUnit.Aliases) > 0 {
+				name = denomUnit.Aliases[0]
+			}
+		}
+	}
+	return ERCMetadata{Name: name, Symbol: symbol, Decimals: decimals}
+}
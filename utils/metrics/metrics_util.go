@@ -279,6 +279,85 @@ func IncrementRpcRequestCounter(endpoint string, connectionType string, success
 	)
 }
 
+// Measures the number of RPC requests rejected by a rate, size or concurrency limit
+// Metric Name:
+//
+//	kii_rpc_limit_exceeded_counter
+func IncrementRpcLimitExceededCounter(endpoint string, connectionType string, limit string) {
+	telemetry.IncrCounterWithLabels(
+		[]string{"kii", "rpc", "limit", "exceeded", "counter"},
+		float32(1),
+		[]metrics.Label{
+			telemetry.NewLabel("endpoint", endpoint),
+			telemetry.NewLabel("connection", connectionType),
+			telemetry.NewLabel("limit", limit),
+		},
+	)
+}
+
+// Measures the hits and misses of the RPC response cache
+// Metric Name:
+//
+//	kii_rpc_cache_counter
+func IncrementRpcCacheCounter(cache string, hit bool) {
+	telemetry.IncrCounterWithLabels(
+		[]string{"kii", "rpc", "cache", "counter"},
+		float32(1),
+		[]metrics.Label{
+			telemetry.NewLabel("cache", cache),
+			telemetry.NewLabel("hit", strconv.FormatBool(hit)),
+		},
+	)
+}
+
+// Measures the RPC calls checked against the access policy by client and result
+// Metric Name:
+//
+//	kii_rpc_access_counter
+func IncrementRpcAccessCounter(client string, endpoint string, connectionType string, result string) {
+	telemetry.IncrCounterWithLabels(
+		[]string{"kii", "rpc", "access", "counter"},
+		float32(1),
+		[]metrics.Label{
+			telemetry.NewLabel("client", client),
+			telemetry.NewLabel("endpoint", endpoint),
+			telemetry.NewLabel("connection", connectionType),
+			telemetry.NewLabel("result", result),
+		},
+	)
+}
+
+// Measures the RPC requests forwarded to the archive node
+// Metric Name:
+//
+//	kii_rpc_archive_fallback_counter
+func IncrementRpcArchiveFallbackCounter(endpoint string, connectionType string, success bool) {
+	telemetry.IncrCounterWithLabels(
+		[]string{"kii", "rpc", "archive", "fallback", "counter"},
+		float32(1),
+		[]metrics.Label{
+			telemetry.NewLabel("endpoint", endpoint),
+			telemetry.NewLabel("connection", connectionType),
+			telemetry.NewLabel("success", strconv.FormatBool(success)),
+		},
+	)
+}
+
+// Measures the latency in milliseconds of the RPC requests forwarded to the archive node
+// Metric Name:
+//
+//	kii_rpc_archive_fallback_latency_ms
+func MeasureRpcArchiveFallbackLatency(endpoint string, connectionType string, startTime time.Time) {
+	metrics.MeasureSinceWithLabels(
+		[]string{"kii", "rpc", "archive", "fallback", "latency_ms"},
+		startTime.UTC(),
+		[]metrics.Label{
+			telemetry.NewLabel("endpoint", endpoint),
+			telemetry.NewLabel("connection", connectionType),
+		},
+	)
+}
+
 func IncrementErrorMetrics(scenario string, err error) {
 	if err == nil {
 		return
@@ -44,3 +44,11 @@ type DelegateCallEVM struct {
 	To   string `json:"to"`
 	Data string `json:"data"` // base64
 }
+
+// / SendNative moves Amount from From to To. Only the CW20 pointer of
+// / Amount.Denom may send it.
+type SendNative struct {
+	From   string   `json:"from"`
+	To     string   `json:"to"`
+	Amount sdk.Coin `json:"amount"`
+}
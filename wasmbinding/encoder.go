@@ -19,6 +19,7 @@ type KiiWasmMessage struct {
 	SetMetadata     json.RawMessage `json:"set_metadata,omitempty"`
 	CallEVM         json.RawMessage `json:"call_evm,omitempty"`
 	DelegateCallEVM json.RawMessage `json:"delegate_call_evm,omitempty"`
+	SendNative      json.RawMessage `json:"send_native,omitempty"`
 }
 
 func CustomEncoder(sender sdk.AccAddress, msg json.RawMessage, info wasmvmtypes.MessageInfo, codeInfo wasmtypes.CodeInfo) ([]sdk.Msg, error) {
@@ -41,6 +42,8 @@ func CustomEncoder(sender sdk.AccAddress, msg json.RawMessage, info wasmvmtypes.
 		return evmwasm.EncodeCallEVM(parsedMessage.CallEVM, sender, info)
 	case parsedMessage.DelegateCallEVM != nil:
 		return evmwasm.EncodeDelegateCallEVM(parsedMessage.DelegateCallEVM, sender, info, codeInfo)
+	case parsedMessage.SendNative != nil:
+		return evmwasm.EncodeSendNative(parsedMessage.SendNative, sender)
 	default:
 		return []sdk.Msg{}, wasmvmtypes.UnsupportedRequest{Kind: "Unknown Kii Wasm Message"}
 	}
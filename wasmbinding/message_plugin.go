@@ -29,6 +29,10 @@ func (r *CustomRouter) Handler(msg sdk.Msg) baseapp.MsgServiceHandler {
 		return func(ctx sdk.Context, _ sdk.Msg) (*sdk.Result, error) {
 			return r.evmKeeper.HandleInternalEVMDelegateCall(ctx, m)
 		}
+	case *evmtypes.MsgInternalSendNative:
+		return func(ctx sdk.Context, _ sdk.Msg) (*sdk.Result, error) {
+			return r.evmKeeper.HandleInternalSendNative(ctx, m)
+		}
 	default:
 		return r.MessageRouter.Handler(msg)
 	}
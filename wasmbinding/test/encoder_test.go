@@ -8,6 +8,8 @@ import (
 	"github.com/kiichain/kiichain3/wasmbinding/bindings"
 	"github.com/stretchr/testify/require"
 
+	evmwasm "github.com/kiichain/kiichain3/x/evm/client/wasm"
+	evmtypes "github.com/kiichain/kiichain3/x/evm/types"
 	tokenfactorywasm "github.com/kiichain/kiichain3/x/tokenfactory/client/wasm"
 	tokenfactorytypes "github.com/kiichain/kiichain3/x/tokenfactory/types"
 
@@ -101,3 +103,28 @@ func TestEncodeChangeAdmin(t *testing.T) {
 	}
 	require.Equal(t, expectedMsg, *typedDecodedMsg)
 }
+
+func TestEncodeSendNative(t *testing.T) {
+	contractAddr, err := sdk.AccAddressFromBech32(TEST_TARGET_CONTRACT)
+	require.NoError(t, err)
+	msg := bindings.SendNative{
+		From:   TEST_CREATOR,
+		To:     "kii1hjfwcza3e3uzeznf3qthhakdr9juetl7uajv0t",
+		Amount: sdk.Coin{Amount: sdk.NewInt(100), Denom: "ufoo"},
+	}
+	serializedMsg, _ := json.Marshal(msg)
+
+	decodedMsgs, err := evmwasm.EncodeSendNative(serializedMsg, contractAddr)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(decodedMsgs))
+	typedDecodedMsg, ok := decodedMsgs[0].(*evmtypes.MsgInternalSendNative)
+	require.True(t, ok)
+	expectedMsg := evmtypes.MsgInternalSendNative{
+		FromContract: TEST_TARGET_CONTRACT,
+		FromAddress:  TEST_CREATOR,
+		ToAddress:    "kii1hjfwcza3e3uzeznf3qthhakdr9juetl7uajv0t",
+		Amount:       sdk.Coin{Amount: sdk.NewInt(100), Denom: "ufoo"},
+	}
+	require.Equal(t, expectedMsg, *typedDecodedMsg)
+	require.Equal(t, []sdk.AccAddress{contractAddr}, typedDecodedMsg.GetSigners())
+}
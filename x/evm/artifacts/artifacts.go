@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/kiichain/kiichain3/x/evm/artifacts/cw1155"
 	"github.com/kiichain/kiichain3/x/evm/artifacts/cw20"
 	"github.com/kiichain/kiichain3/x/evm/artifacts/cw721"
 	"github.com/kiichain/kiichain3/x/evm/artifacts/native"
@@ -17,6 +18,8 @@ func GetParsedABI(typ string) *abi.ABI {
 		return cw20.GetParsedABI()
 	case "cw721":
 		return cw721.GetParsedABI()
+	case "cw1155":
+		return cw1155.GetParsedABI()
 	default:
 		panic(fmt.Sprintf("unknown artifact type %s", typ))
 	}
@@ -30,6 +33,8 @@ func GetBin(typ string) []byte {
 		return cw20.GetBin()
 	case "cw721":
 		return cw721.GetBin()
+	case "cw1155":
+		return cw1155.GetBin()
 	default:
 		panic(fmt.Sprintf("unknown artifact type %s", typ))
 	}
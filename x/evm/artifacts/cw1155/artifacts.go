@@ -0,0 +1,54 @@
+package cw1155
+
+import (
+	"embed"
+	"encoding/hex"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+const CurrentVersion uint16 = 1
+
+//go:embed CW1155ERC1155Pointer.abi
+//go:embed CW1155ERC1155Pointer.bin
+var f embed.FS
+
+var cachedBin []byte
+var cachedABI *abi.ABI
+
+func GetABI() []byte {
+	bz, err := f.ReadFile("CW1155ERC1155Pointer.abi")
+	if err != nil {
+		panic("failed to read CW1155ERC1155Pointer contract ABI")
+	}
+	return bz
+}
+
+func GetParsedABI() *abi.ABI {
+	if cachedABI != nil {
+		return cachedABI
+	}
+	parsedABI, err := abi.JSON(strings.NewReader(string(GetABI())))
+	if err != nil {
+		panic(err)
+	}
+	cachedABI = &parsedABI
+	return cachedABI
+}
+
+func GetBin() []byte {
+	if cachedBin != nil {
+		return cachedBin
+	}
+	code, err := f.ReadFile("CW1155ERC1155Pointer.bin")
+	if err != nil {
+		panic("failed to read CW1155ERC1155Pointer contract binary")
+	}
+	bz, err := hex.DecodeString(string(code))
+	if err != nil {
+		panic("failed to decode CW1155ERC1155Pointer contract binary")
+	}
+	cachedBin = bz
+	return bz
+}
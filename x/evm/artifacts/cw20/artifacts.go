@@ -7,7 +7,6 @@ import (
 	"fmt"
 	"strings"
 
-	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 
 	"github.com/kiichain/kiichain3/x/evm/config"
@@ -23,8 +22,10 @@ func SetVersionWithOffset(offset int16) {
 	versionOverride = uint16(int16(currentVersion) + offset)
 }
 
-func CurrentVersion(ctx sdk.Context) uint16 {
-	return config.GetVersionWthDefault(ctx, versionOverride, currentVersion)
+// CurrentVersion returns the version of the pointer contract, which can only be
+// overridden on chains that aren't live.
+func CurrentVersion(liveChain bool) uint16 {
+	return config.GetVersionWthDefault(liveChain, versionOverride, currentVersion)
 }
 
 //go:embed CW20ERC20Pointer.abi
@@ -0,0 +1,6 @@
+package cwnative
+
+// CurrentVersion is the version of the CW20 contract that wraps native denoms
+// (source under example/cosmwasm/native). Its code ID is stored under
+// PointerCWCodePrefix/PointerCW20NativePrefix when the code is uploaded.
+const CurrentVersion uint16 = 1
@@ -0,0 +1,3 @@
+package erc1155
+
+const CurrentVersion uint16 = 1
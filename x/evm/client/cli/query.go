@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"math/big"
 	"os"
+	"strconv"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
@@ -16,10 +18,10 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	paramsproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 	"github.com/kiichain/kiichain3/x/evm/artifacts/cw20"
 	"github.com/kiichain/kiichain3/x/evm/artifacts/cw721"
 	"github.com/kiichain/kiichain3/x/evm/artifacts/native"
-	"github.com/kiichain/kiichain3/x/evm/config"
 	"github.com/kiichain/kiichain3/x/evm/types"
 )
 
@@ -47,6 +49,10 @@ func GetQueryCmd(_ string) *cobra.Command {
 	cmd.AddCommand(CmdQueryPointerVersion())
 	cmd.AddCommand(CmdQueryPointee())
 	cmd.AddCommand(CmdQueryChainID())
+	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(CmdQueryBaseFee())
+	cmd.AddCommand(CmdQueryFeeHistory())
+	cmd.AddCommand(CmdQueryBurnedBaseFee())
 
 	return cmd
 }
@@ -297,7 +303,7 @@ func CmdQueryERC721Payload() *cobra.Command {
 func CmdQueryPointer() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "pointer [type] [pointee]",
-		Short: "get pointer address of the specified type (one of [NATIVE, CW20, CW721, ERC20, ERC721]) and pointee",
+		Short: "get pointer address of the specified type (one of [NATIVE, CW20, CW721, CW1155, ERC20, ERC721, NATIVE_CW20, ERC1155]) and pointee",
 		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -355,7 +361,7 @@ func CmdQueryPointerVersion() *cobra.Command {
 func CmdQueryPointee() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "pointee [type] [pointer]",
-		Short: "Get pointee address of the specified type (one of [NATIVE, CW20, CW721, ERC20, ERC721]) and pointer",
+		Short: "Get pointee address of the specified type (one of [NATIVE, CW20, CW721, CW1155, ERC20, ERC721, NATIVE_CW20, ERC1155]) and pointer",
 		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -384,7 +390,7 @@ func CmdQueryPointee() *cobra.Command {
 func CmdQueryChainID() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "chain-id",
-		Short: "Query the EVM Chain ID based on the current Cosmos Chain ID",
+		Short: "Query the EVM Chain ID set in the evm params",
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -392,8 +398,16 @@ func CmdQueryChainID() *cobra.Command {
 				return err
 			}
 
-			cosmosChainID := clientCtx.ChainID
-			evmChainID := config.GetEVMChainID(cosmosChainID)
+			queryClient := paramsproposal.NewQueryClient(clientCtx)
+			res, err := queryClient.Params(context.Background(), &paramsproposal.QueryParamsRequest{Subspace: types.ModuleName, Key: string(types.KeyChainID)})
+			if err != nil {
+				return err
+			}
+			// uint64 params are stored as quoted decimal strings
+			evmChainID, err := strconv.ParseUint(strings.Trim(res.Param.Value, `"`), 10, 64)
+			if err != nil {
+				return err
+			}
 
 			return clientCtx.PrintString(fmt.Sprintf("EVM Chain ID: %d\n", evmChainID))
 		},
@@ -403,3 +417,123 @@ func CmdQueryChainID() *cobra.Command {
 
 	return cmd
 }
+
+func CmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the evm params",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryBaseFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "base-fee [height]",
+		Short: "Query the base fee per gas of a block, or of the next block if no height is given",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var height int64
+			if len(args) > 0 {
+				if height, err = strconv.ParseInt(args[0], 10, 64); err != nil {
+					return err
+				}
+			}
+			res, err := queryClient.BaseFee(cmd.Context(), &types.QueryBaseFeeRequest{Height: height})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryFeeHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-history [block-count] [last-block]",
+		Short: "Query the base fees per gas of the blocks ending at the last block, or at the latest block if none is given",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			blockCount, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			var lastBlock int64
+			if len(args) > 1 {
+				if lastBlock, err = strconv.ParseInt(args[1], 10, 64); err != nil {
+					return err
+				}
+			}
+			res, err := queryClient.FeeHistory(cmd.Context(), &types.QueryFeeHistoryRequest{BlockCount: blockCount, LastBlock: lastBlock})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryBurnedBaseFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burned-base-fee",
+		Short: "Query the total base fee of EVM transactions burned so far",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BurnedBaseFee(cmd.Context(), &types.QueryBurnedBaseFeeRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
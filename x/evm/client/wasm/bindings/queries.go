@@ -28,6 +28,18 @@ const (
 	GetEvmAddressType          EVMQueryType = "evm_query_get_evm_address"
 	GetKiiAddressType          EVMQueryType = "evm_query_get_kii_address"
 	SupportsInterfaceType      EVMQueryType = "evm_query_supports_interface"
+
+	ERC1155TransferType         EVMQueryType = "evm_query_erc1155_transfer"
+	ERC1155BatchTransferType    EVMQueryType = "evm_query_erc1155_batch_transfer"
+	ERC1155SetApprovalAllType   EVMQueryType = "evm_query_erc1155_set_approval_all"
+	ERC1155BalanceOfType        EVMQueryType = "evm_query_erc1155_balance_of"
+	ERC1155BalanceOfBatchType   EVMQueryType = "evm_query_erc1155_balance_of_batch"
+	ERC1155IsApprovedForAllType EVMQueryType = "evm_query_erc1155_is_approved_for_all"
+	ERC1155UriType              EVMQueryType = "evm_query_erc1155_uri"
+	ERC1155TotalSupplyType      EVMQueryType = "evm_query_erc1155_total_supply"
+	ERC1155NameSymbolType       EVMQueryType = "evm_query_erc1155_name_symbol"
+
+	NativeSupplyType EVMQueryType = "evm_query_native_supply"
 )
 
 func (q *KiiEVMQuery) GetQueryType() EVMQueryType {
@@ -82,6 +94,36 @@ func (q *KiiEVMQuery) GetQueryType() EVMQueryType {
 	if q.ERC721RoyaltyInfo != nil {
 		return ERC721RoyaltyInfoType
 	}
+	if q.ERC1155TransferPayload != nil {
+		return ERC1155TransferType
+	}
+	if q.ERC1155BatchTransferPayload != nil {
+		return ERC1155BatchTransferType
+	}
+	if q.ERC1155SetApprovalAllPayload != nil {
+		return ERC1155SetApprovalAllType
+	}
+	if q.ERC1155BalanceOf != nil {
+		return ERC1155BalanceOfType
+	}
+	if q.ERC1155BalanceOfBatch != nil {
+		return ERC1155BalanceOfBatchType
+	}
+	if q.ERC1155IsApprovedForAll != nil {
+		return ERC1155IsApprovedForAllType
+	}
+	if q.ERC1155Uri != nil {
+		return ERC1155UriType
+	}
+	if q.ERC1155TotalSupply != nil {
+		return ERC1155TotalSupplyType
+	}
+	if q.ERC1155NameSymbol != nil {
+		return ERC1155NameSymbolType
+	}
+	if q.NativeSupply != nil {
+		return NativeSupplyType
+	}
 	if q.GetEvmAddress != nil {
 		return GetEvmAddressType
 	}
@@ -115,6 +157,18 @@ type KiiEVMQuery struct {
 	GetEvmAddress               *GetEvmAddressRequest               `json:"get_evm_address,omitempty"`
 	GetKiiAddress               *GetKiiAddressRequest               `json:"get_kii_address,omitempty"`
 	SupportsInterface           *SupportsInterfaceRequest           `json:"supports_interface,omitempty"`
+
+	ERC1155TransferPayload       *ERC1155TransferPayloadRequest       `json:"erc1155_transfer_payload,omitempty"`
+	ERC1155BatchTransferPayload  *ERC1155BatchTransferPayloadRequest  `json:"erc1155_batch_transfer_payload,omitempty"`
+	ERC1155SetApprovalAllPayload *ERC1155SetApprovalAllPayloadRequest `json:"erc1155_set_approval_all_payload,omitempty"`
+	ERC1155BalanceOf             *ERC1155BalanceOfRequest             `json:"erc1155_balance_of,omitempty"`
+	ERC1155BalanceOfBatch        *ERC1155BalanceOfBatchRequest        `json:"erc1155_balance_of_batch,omitempty"`
+	ERC1155IsApprovedForAll      *ERC1155IsApprovedForAllRequest      `json:"erc1155_is_approved_for_all,omitempty"`
+	ERC1155Uri                   *ERC1155UriRequest                   `json:"erc1155_uri,omitempty"`
+	ERC1155TotalSupply           *ERC1155TotalSupplyRequest           `json:"erc1155_total_supply,omitempty"`
+	ERC1155NameSymbol            *ERC1155NameSymbolRequest            `json:"erc1155_name_symbol,omitempty"`
+
+	NativeSupply *NativeSupplyRequest `json:"native_supply,omitempty"`
 }
 
 type StaticCallRequest struct {
@@ -213,6 +267,69 @@ type ERC721RoyaltyInfoRequest struct {
 	SalePrice       *sdk.Int `json:"sale_price"`
 }
 
+type ERC1155TransferPayloadRequest struct {
+	From      string   `json:"from"`
+	Recipient string   `json:"recipient"`
+	TokenID   string   `json:"token_id"`
+	Amount    *sdk.Int `json:"amount"`
+}
+
+type ERC1155BatchTransferPayloadRequest struct {
+	From      string    `json:"from"`
+	Recipient string    `json:"recipient"`
+	TokenIDs  []string  `json:"token_ids"`
+	Amounts   []sdk.Int `json:"amounts"`
+}
+
+type ERC1155SetApprovalAllPayloadRequest struct {
+	To       string `json:"to"`
+	Approved bool   `json:"approved"`
+}
+
+type ERC1155BalanceOfRequest struct {
+	Caller          string `json:"caller"`
+	ContractAddress string `json:"contract_address"`
+	Account         string `json:"account"`
+	TokenID         string `json:"token_id"`
+}
+
+type ERC1155BalanceOfBatchRequest struct {
+	Caller          string   `json:"caller"`
+	ContractAddress string   `json:"contract_address"`
+	Accounts        []string `json:"accounts"`
+	TokenIDs        []string `json:"token_ids"`
+}
+
+type ERC1155IsApprovedForAllRequest struct {
+	Caller          string `json:"caller"`
+	ContractAddress string `json:"contract_address"`
+	Owner           string `json:"owner"`
+	Operator        string `json:"operator"`
+}
+
+type ERC1155UriRequest struct {
+	Caller          string `json:"caller"`
+	ContractAddress string `json:"contract_address"`
+	TokenID         string `json:"token_id"`
+}
+
+type ERC1155TotalSupplyRequest struct {
+	Caller          string `json:"caller"`
+	ContractAddress string `json:"contract_address"`
+	TokenID         string `json:"token_id"`
+}
+
+type ERC1155NameSymbolRequest struct {
+	Caller          string `json:"caller"`
+	ContractAddress string `json:"contract_address"`
+}
+
+// NativeSupplyRequest asks for the total supply of a native denom, which the
+// bank queries of wasmd do not expose.
+type NativeSupplyRequest struct {
+	Denom string `json:"denom"`
+}
+
 type GetEvmAddressRequest struct {
 	KiiAddress string `json:"kii_address"`
 }
@@ -280,6 +397,35 @@ type ERC721RoyaltyInfoResponse struct {
 	RoyaltyAmount *sdk.Int `json:"royalty_amount"`
 }
 
+type ERC1155BalanceOfResponse struct {
+	Amount *sdk.Int `json:"amount"`
+}
+
+type ERC1155BalanceOfBatchResponse struct {
+	Amounts []sdk.Int `json:"amounts"`
+}
+
+type ERC1155IsApprovedForAllResponse struct {
+	IsApproved bool `json:"is_approved"`
+}
+
+type ERC1155UriResponse struct {
+	Uri string `json:"uri"`
+}
+
+type ERC1155TotalSupplyResponse struct {
+	Supply *sdk.Int `json:"supply"`
+}
+
+type ERC1155NameSymbolResponse struct {
+	Name   string `json:"name"`
+	Symbol string `json:"symbol"`
+}
+
+type NativeSupplyResponse struct {
+	Amount sdk.Coin `json:"amount"`
+}
+
 type GetEvmAddressResponse struct {
 	EvmAddress string `json:"evm_address"`
 	Associated bool   `json:"associated"`
@@ -51,3 +51,17 @@ func EncodeDelegateCallEVM(rawMsg json.RawMessage, sender sdk.AccAddress, info w
 	}
 	return []sdk.Msg{&internalCallEVMMsg}, nil
 }
+
+func EncodeSendNative(rawMsg json.RawMessage, sender sdk.AccAddress) ([]sdk.Msg, error) {
+	encodedSendNative := bindings.SendNative{}
+	if err := json.Unmarshal(rawMsg, &encodedSendNative); err != nil {
+		return []sdk.Msg{}, err
+	}
+	internalSendNativeMsg := types.MsgInternalSendNative{
+		FromContract: sender.String(),
+		FromAddress:  encodedSendNative.From,
+		ToAddress:    encodedSendNative.To,
+		Amount:       encodedSendNative.Amount,
+	}
+	return []sdk.Msg{&internalSendNativeMsg}, nil
+}
@@ -10,6 +10,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/kiichain/kiichain3/x/evm/artifacts/cw1155"
 	"github.com/kiichain/kiichain3/x/evm/artifacts/cw721"
 	"github.com/kiichain/kiichain3/x/evm/artifacts/native"
 	"github.com/kiichain/kiichain3/x/evm/client/wasm/bindings"
@@ -516,6 +517,14 @@ func (h *EVMQueryHandler) HandleERC721Uri(ctx sdk.Context, caller string, contra
 	return json.Marshal(response)
 }
 
+func (h *EVMQueryHandler) HandleNativeSupply(ctx sdk.Context, denom string) ([]byte, error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return nil, err
+	}
+	response := bindings.NativeSupplyResponse{Amount: h.k.BankKeeper().GetSupply(ctx, denom)}
+	return json.Marshal(response)
+}
+
 func (h *EVMQueryHandler) HandleGetEvmAddress(ctx sdk.Context, kiiAddr string) ([]byte, error) {
 	addr, err := sdk.AccAddressFromBech32(kiiAddr)
 	if err != nil {
@@ -600,3 +609,306 @@ func (h *EVMQueryHandler) HandleSupportsInterface(ctx sdk.Context, caller string
 	}
 	return json.Marshal(bindings.SupportsInterfaceResponse{Supported: typed[0].(bool)})
 }
+
+func (h *EVMQueryHandler) HandleERC1155TransferPayload(ctx sdk.Context, from string, recipient string, tokenId string, amount *sdk.Int) ([]byte, error) {
+	abi, err := cw1155.Cw1155MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	fromEvmAddr, found := h.k.GetEVMAddress(ctx, sdk.MustAccAddressFromBech32(from))
+	if !found {
+		return nil, types.NewAssociationMissingErr(from)
+	}
+	toEvmAddr, found := h.k.GetEVMAddress(ctx, sdk.MustAccAddressFromBech32(recipient))
+	if !found {
+		return nil, types.NewAssociationMissingErr(recipient)
+	}
+	t, ok := sdk.NewIntFromString(tokenId)
+	if !ok {
+		return nil, errors.New("invalid token ID for ERC1155, must be a big Int")
+	}
+	if amount == nil {
+		return nil, errors.New("amount is required for ERC1155 transfers")
+	}
+	bz, err := abi.Pack("safeTransferFrom", fromEvmAddr, toEvmAddr, t.BigInt(), amount.BigInt(), []byte{})
+	if err != nil {
+		return nil, err
+	}
+	res := bindings.ERCPayloadResponse{EncodedPayload: base64.StdEncoding.EncodeToString(bz)}
+	return json.Marshal(res)
+}
+
+func (h *EVMQueryHandler) HandleERC1155BatchTransferPayload(ctx sdk.Context, from string, recipient string, tokenIds []string, amounts []sdk.Int) ([]byte, error) {
+	abi, err := cw1155.Cw1155MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	fromEvmAddr, found := h.k.GetEVMAddress(ctx, sdk.MustAccAddressFromBech32(from))
+	if !found {
+		return nil, types.NewAssociationMissingErr(from)
+	}
+	toEvmAddr, found := h.k.GetEVMAddress(ctx, sdk.MustAccAddressFromBech32(recipient))
+	if !found {
+		return nil, types.NewAssociationMissingErr(recipient)
+	}
+	if len(tokenIds) != len(amounts) {
+		return nil, errors.New("token IDs and amounts must have the same length")
+	}
+	ids := make([]*big.Int, len(tokenIds))
+	values := make([]*big.Int, len(amounts))
+	for i, tokenId := range tokenIds {
+		t, ok := sdk.NewIntFromString(tokenId)
+		if !ok {
+			return nil, errors.New("invalid token ID for ERC1155, must be a big Int")
+		}
+		ids[i] = t.BigInt()
+		values[i] = amounts[i].BigInt()
+	}
+	bz, err := abi.Pack("safeBatchTransferFrom", fromEvmAddr, toEvmAddr, ids, values, []byte{})
+	if err != nil {
+		return nil, err
+	}
+	res := bindings.ERCPayloadResponse{EncodedPayload: base64.StdEncoding.EncodeToString(bz)}
+	return json.Marshal(res)
+}
+
+func (h *EVMQueryHandler) HandleERC1155SetApprovalAllPayload(ctx sdk.Context, to string, approved bool) ([]byte, error) {
+	evmAddr, found := h.k.GetEVMAddress(ctx, sdk.MustAccAddressFromBech32(to))
+	if !found {
+		return nil, types.NewAssociationMissingErr(to)
+	}
+	abi, err := cw1155.Cw1155MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	bz, err := abi.Pack("setApprovalForAll", evmAddr, approved)
+	if err != nil {
+		return nil, err
+	}
+	res := bindings.ERCPayloadResponse{EncodedPayload: base64.StdEncoding.EncodeToString(bz)}
+	return json.Marshal(res)
+}
+
+func (h *EVMQueryHandler) HandleERC1155BalanceOf(ctx sdk.Context, caller string, contractAddress string, account string, tokenId string) ([]byte, error) {
+	callerAddr, err := sdk.AccAddressFromBech32(caller)
+	if err != nil {
+		return nil, err
+	}
+	addr, err := sdk.AccAddressFromBech32(account)
+	if err != nil {
+		return nil, err
+	}
+	evmAddr, found := h.k.GetEVMAddress(ctx, addr)
+	if !found {
+		return nil, types.NewAssociationMissingErr(addr.String())
+	}
+	t, ok := sdk.NewIntFromString(tokenId)
+	if !ok {
+		return nil, errors.New("invalid token ID for ERC1155, must be a big Int")
+	}
+	contract := common.HexToAddress(contractAddress)
+	abi, err := cw1155.Cw1155MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	bz, err := abi.Pack("balanceOf", evmAddr, t.BigInt())
+	if err != nil {
+		return nil, err
+	}
+	res, err := h.k.StaticCallEVM(ctx, callerAddr, &contract, bz)
+	if err != nil {
+		return nil, err
+	}
+	typed, err := abi.Unpack("balanceOf", res)
+	if err != nil {
+		return nil, err
+	}
+	balance := sdk.NewIntFromBigInt(typed[0].(*big.Int))
+	return json.Marshal(bindings.ERC1155BalanceOfResponse{Amount: &balance})
+}
+
+func (h *EVMQueryHandler) HandleERC1155BalanceOfBatch(ctx sdk.Context, caller string, contractAddress string, accounts []string, tokenIds []string) ([]byte, error) {
+	callerAddr, err := sdk.AccAddressFromBech32(caller)
+	if err != nil {
+		return nil, err
+	}
+	if len(accounts) != len(tokenIds) {
+		return nil, errors.New("accounts and token IDs must have the same length")
+	}
+	evmAddrs := make([]common.Address, len(accounts))
+	ids := make([]*big.Int, len(tokenIds))
+	for i, account := range accounts {
+		addr, err := sdk.AccAddressFromBech32(account)
+		if err != nil {
+			return nil, err
+		}
+		evmAddr, found := h.k.GetEVMAddress(ctx, addr)
+		if !found {
+			return nil, types.NewAssociationMissingErr(addr.String())
+		}
+		evmAddrs[i] = evmAddr
+		t, ok := sdk.NewIntFromString(tokenIds[i])
+		if !ok {
+			return nil, errors.New("invalid token ID for ERC1155, must be a big Int")
+		}
+		ids[i] = t.BigInt()
+	}
+	contract := common.HexToAddress(contractAddress)
+	abi, err := cw1155.Cw1155MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	bz, err := abi.Pack("balanceOfBatch", evmAddrs, ids)
+	if err != nil {
+		return nil, err
+	}
+	res, err := h.k.StaticCallEVM(ctx, callerAddr, &contract, bz)
+	if err != nil {
+		return nil, err
+	}
+	typed, err := abi.Unpack("balanceOfBatch", res)
+	if err != nil {
+		return nil, err
+	}
+	balances := typed[0].([]*big.Int)
+	amounts := make([]sdk.Int, len(balances))
+	for i, balance := range balances {
+		amounts[i] = sdk.NewIntFromBigInt(balance)
+	}
+	return json.Marshal(bindings.ERC1155BalanceOfBatchResponse{Amounts: amounts})
+}
+
+func (h *EVMQueryHandler) HandleERC1155IsApprovedForAll(ctx sdk.Context, caller string, contractAddress string, owner string, operator string) ([]byte, error) {
+	callerAddr, err := sdk.AccAddressFromBech32(caller)
+	if err != nil {
+		return nil, err
+	}
+	ownerEvmAddr, found := h.k.GetEVMAddress(ctx, sdk.MustAccAddressFromBech32(owner))
+	if !found {
+		return nil, types.NewAssociationMissingErr(owner)
+	}
+	operatorEvmAddr, found := h.k.GetEVMAddress(ctx, sdk.MustAccAddressFromBech32(operator))
+	if !found {
+		return nil, types.NewAssociationMissingErr(operator)
+	}
+	contract := common.HexToAddress(contractAddress)
+	abi, err := cw1155.Cw1155MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	bz, err := abi.Pack("isApprovedForAll", ownerEvmAddr, operatorEvmAddr)
+	if err != nil {
+		return nil, err
+	}
+	res, err := h.k.StaticCallEVM(ctx, callerAddr, &contract, bz)
+	if err != nil {
+		return nil, err
+	}
+	typed, err := abi.Unpack("isApprovedForAll", res)
+	if err != nil {
+		return nil, err
+	}
+	response := bindings.ERC1155IsApprovedForAllResponse{IsApproved: typed[0].(bool)}
+	return json.Marshal(response)
+}
+
+func (h *EVMQueryHandler) HandleERC1155Uri(ctx sdk.Context, caller string, contractAddress string, tokenId string) ([]byte, error) {
+	callerAddr, err := sdk.AccAddressFromBech32(caller)
+	if err != nil {
+		return nil, err
+	}
+	t, ok := sdk.NewIntFromString(tokenId)
+	if !ok {
+		return nil, errors.New("invalid token ID for ERC1155, must be a big Int")
+	}
+	contract := common.HexToAddress(contractAddress)
+	abi, err := cw1155.Cw1155MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	bz, err := abi.Pack("uri", t.BigInt())
+	if err != nil {
+		return nil, err
+	}
+	res, err := h.k.StaticCallEVM(ctx, callerAddr, &contract, bz)
+	if err != nil {
+		return nil, err
+	}
+	typed, err := abi.Unpack("uri", res)
+	if err != nil {
+		return nil, err
+	}
+	response := bindings.ERC1155UriResponse{Uri: typed[0].(string)}
+	return json.Marshal(response)
+}
+
+func (h *EVMQueryHandler) HandleERC1155TotalSupply(ctx sdk.Context, caller string, contractAddress string, tokenId string) ([]byte, error) {
+	callerAddr, err := sdk.AccAddressFromBech32(caller)
+	if err != nil {
+		return nil, err
+	}
+	t, ok := sdk.NewIntFromString(tokenId)
+	if !ok {
+		return nil, errors.New("invalid token ID for ERC1155, must be a big Int")
+	}
+	contract := common.HexToAddress(contractAddress)
+	abi, err := cw1155.Cw1155MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	bz, err := abi.Pack("totalSupply", t.BigInt())
+	if err != nil {
+		return nil, err
+	}
+	res, err := h.k.StaticCallEVM(ctx, callerAddr, &contract, bz)
+	if err != nil {
+		return nil, err
+	}
+	typed, err := abi.Unpack("totalSupply", res)
+	if err != nil {
+		return nil, err
+	}
+	totalSupply := sdk.NewIntFromBigInt(typed[0].(*big.Int))
+	response := bindings.ERC1155TotalSupplyResponse{Supply: &totalSupply}
+	return json.Marshal(response)
+}
+
+func (h *EVMQueryHandler) HandleERC1155NameSymbol(ctx sdk.Context, caller string, contractAddress string) ([]byte, error) {
+	callerAddr, err := sdk.AccAddressFromBech32(caller)
+	if err != nil {
+		return nil, err
+	}
+	contract := common.HexToAddress(contractAddress)
+	abi, err := cw1155.Cw1155MetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	bz, err := abi.Pack("name")
+	if err != nil {
+		return nil, err
+	}
+	res, err := h.k.StaticCallEVM(ctx, callerAddr, &contract, bz)
+	if err != nil {
+		return nil, err
+	}
+	typed, err := abi.Unpack("name", res)
+	if err != nil {
+		return nil, err
+	}
+	name := typed[0].(string)
+	bz, err = abi.Pack("symbol")
+	if err != nil {
+		return nil, err
+	}
+	res, err = h.k.StaticCallEVM(ctx, callerAddr, &contract, bz)
+	if err != nil {
+		return nil, err
+	}
+	typed, err = abi.Unpack("symbol", res)
+	if err != nil {
+		return nil, err
+	}
+	symbol := typed[0].(string)
+	response := bindings.ERC1155NameSymbolResponse{Name: name, Symbol: symbol}
+	return json.Marshal(response)
+}
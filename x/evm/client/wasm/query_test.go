@@ -274,6 +274,79 @@ func TestHandleERC721RoyaltyInfo(t *testing.T) {
 	require.True(t, match)
 }
 
+func TestERC1155TransferPayload(t *testing.T) {
+	k := &testkeeper.EVMTestApp.EvmKeeper
+	ctx := testkeeper.EVMTestApp.GetContextForDeliverTx(nil)
+	addr1, e1 := testkeeper.MockAddressPair()
+	addr2, e2 := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx, addr1, e1)
+	k.SetAddressMapping(ctx, addr2, e2)
+	h := wasm.NewEVMQueryHandler(k)
+	amount := sdk.NewInt(5)
+	res, err := h.HandleERC1155TransferPayload(ctx, addr1.String(), addr2.String(), "1", &amount)
+	require.Nil(t, err)
+	require.NotEmpty(t, res)
+	_, err = h.HandleERC1155TransferPayload(ctx, addr1.String(), addr2.String(), "abc", &amount)
+	require.NotNil(t, err)
+	res, err = h.HandleERC1155BatchTransferPayload(ctx, addr1.String(), addr2.String(), []string{"1", "2"}, []sdk.Int{sdk.NewInt(1), sdk.NewInt(2)})
+	require.Nil(t, err)
+	require.NotEmpty(t, res)
+	_, err = h.HandleERC1155BatchTransferPayload(ctx, addr1.String(), addr2.String(), []string{"1", "2"}, []sdk.Int{sdk.NewInt(1)})
+	require.NotNil(t, err)
+	res, err = h.HandleERC1155SetApprovalAllPayload(ctx, addr2.String(), true)
+	require.Nil(t, err)
+	require.NotEmpty(t, res)
+}
+
+func TestHandleERC1155Queries(t *testing.T) {
+	k := &testkeeper.EVMTestApp.EvmKeeper
+	ctx := testkeeper.EVMTestApp.GetContextForDeliverTx(nil)
+	privKey := testkeeper.MockPrivateKey()
+	res, _ := deployContract(t, ctx, k, "../../../../example/contracts/erc1155/DummyERC1155.bin", privKey)
+	owner, ownerEvm := testkeeper.PrivateKeyToAddresses(privKey)
+	k.SetAddressMapping(ctx, owner, ownerEvm)
+	addr1, e1 := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx, addr1, e1)
+	receipt, err := k.GetReceipt(ctx, common.HexToHash(res.Hash))
+	require.Nil(t, err)
+	contractAddr := common.HexToAddress(receipt.ContractAddress).String()
+	h := wasm.NewEVMQueryHandler(k)
+
+	res2, err := h.HandleERC1155BalanceOf(ctx, addr1.String(), contractAddr, owner.String(), "1")
+	require.Nil(t, err)
+	require.Equal(t, "{\"amount\":\"100\"}", string(res2))
+	res2, err = h.HandleERC1155BalanceOfBatch(ctx, addr1.String(), contractAddr, []string{owner.String(), owner.String(), addr1.String()}, []string{"1", "2", "1"})
+	require.Nil(t, err)
+	require.Equal(t, "{\"amounts\":[\"100\",\"50\",\"0\"]}", string(res2))
+	_, err = h.HandleERC1155BalanceOfBatch(ctx, addr1.String(), contractAddr, []string{owner.String()}, []string{"1", "2"})
+	require.NotNil(t, err)
+	res2, err = h.HandleERC1155IsApprovedForAll(ctx, addr1.String(), contractAddr, owner.String(), addr1.String())
+	require.Nil(t, err)
+	require.Equal(t, "{\"is_approved\":false}", string(res2))
+	res2, err = h.HandleERC1155Uri(ctx, addr1.String(), contractAddr, "1")
+	require.Nil(t, err)
+	require.Equal(t, "{\"uri\":\"https://example.com/{id}\"}", string(res2))
+	res2, err = h.HandleERC1155TotalSupply(ctx, addr1.String(), contractAddr, "2")
+	require.Nil(t, err)
+	require.Equal(t, "{\"supply\":\"50\"}", string(res2))
+	res2, err = h.HandleERC1155NameSymbol(ctx, addr1.String(), contractAddr)
+	require.Nil(t, err)
+	require.Equal(t, "{\"name\":\"DummyERC1155\",\"symbol\":\"DUMMY\"}", string(res2))
+}
+
+func TestHandleNativeSupply(t *testing.T) {
+	k := &testkeeper.EVMTestApp.EvmKeeper
+	ctx := testkeeper.EVMTestApp.GetContextForDeliverTx(nil)
+	amts := sdk.NewCoins(sdk.NewCoin("unativesupply", sdk.NewInt(500)))
+	require.Nil(t, k.BankKeeper().MintCoins(ctx, evmtypes.ModuleName, amts))
+	h := wasm.NewEVMQueryHandler(k)
+	res, err := h.HandleNativeSupply(ctx, "unativesupply")
+	require.Nil(t, err)
+	require.Equal(t, "{\"amount\":{\"denom\":\"unativesupply\",\"amount\":\"500\"}}", string(res))
+	_, err = h.HandleNativeSupply(ctx, "!")
+	require.NotNil(t, err)
+}
+
 func TestGetAddress(t *testing.T) {
 	k := &testkeeper.EVMTestApp.EvmKeeper
 	ctx := testkeeper.EVMTestApp.GetContextForDeliverTx(nil)
@@ -83,6 +83,12 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
 			return false
 		})
 	}
+	if bz := ctx.KVStore(k.GetStoreKey()).Get(types.BurnedBaseFeeKey); bz != nil {
+		genesis.Serialized = append(genesis.Serialized, &types.Serialized{
+			Prefix: types.BurnedBaseFeeKey,
+			Value:  bz,
+		})
+	}
 
 	return genesis
 }
@@ -167,6 +173,15 @@ func ExportGenesisStream(ctx sdk.Context, k *keeper.Keeper) <-chan *types.Genesi
 			})
 			ch <- genesis
 		}
+		if bz := ctx.KVStore(k.GetStoreKey()).Get(types.BurnedBaseFeeKey); bz != nil {
+			genesis := types.DefaultGenesis()
+			genesis.Params = k.GetParams(ctx)
+			genesis.Serialized = append(genesis.Serialized, &types.Serialized{
+				Prefix: types.BurnedBaseFeeKey,
+				Value:  bz,
+			})
+			ch <- genesis
+		}
 		close(ch)
 	}()
 	return ch
@@ -3,6 +3,7 @@ package evm_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	testkeeper "github.com/kiichain/kiichain3/testutil/keeper"
@@ -26,6 +27,7 @@ func TestExportImportGenesis(t *testing.T) {
 	keeper.SetBlockBloom(ctx, []ethtypes.Bloom{{1}})
 	keeper.SetTxHashesOnHeight(ctx, 5, []common.Hash{common.BytesToHash([]byte("123"))})
 	keeper.SetERC20CW20Pointer(ctx, "cw20addr", codeAddr)
+	keeper.AddBurnedBaseFee(ctx, sdk.NewInt(7))
 	genesis := evm.ExportGenesis(ctx, keeper)
 	assert.NoError(t, genesis.Validate())
 	param := genesis.GetParams()
@@ -48,4 +50,5 @@ func TestExportImportGenesis(t *testing.T) {
 	require.Equal(t, keeper.GetTxHashesOnHeight(ctx, 5), keeper.GetTxHashesOnHeight(origctx, 5))
 	_, _, exists := keeper.GetERC20CW20Pointer(origctx, "cw20addr")
 	require.True(t, exists)
+	require.Equal(t, sdk.NewInt(7), keeper.GetBurnedBaseFee(origctx))
 }
@@ -1,8 +1,13 @@
 package evm_test
 
 import (
+	"fmt"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	paramsproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 	testkeeper "github.com/kiichain/kiichain3/testutil/keeper"
 	"github.com/kiichain/kiichain3/x/evm"
 	"github.com/kiichain/kiichain3/x/evm/artifacts/native"
@@ -48,3 +53,37 @@ func TestAddERCNativePointerProposalsV2(t *testing.T) {
 	require.True(t, exists2)
 	require.NotEqual(t, pointer, pointer2)
 }
+
+func TestParamChangeProposalHandler(t *testing.T) {
+	ctx := testkeeper.EVMTestApp.GetContextForDeliverTx(nil)
+	k := &testkeeper.EVMTestApp.EvmKeeper
+	called := false
+	handler := evm.NewParamChangeProposalHandler(k, func(sdk.Context, govtypes.Content) error {
+		called = true
+		return nil
+	})
+	err := handler(ctx, paramsproposal.NewParameterChangeProposal("title", "description", []paramsproposal.ParamChange{
+		{Subspace: types.ModuleName, Key: string(types.KeyLiveChain), Value: "true"},
+		{Subspace: types.ModuleName, Key: string(types.KeyChainID), Value: `"1"`},
+	}, false))
+	require.ErrorContains(t, err, "evm param KeyChainID is immutable")
+	require.False(t, called)
+
+	require.Nil(t, handler(ctx, paramsproposal.NewParameterChangeProposal("title", "description", []paramsproposal.ParamChange{
+		{Subspace: types.ModuleName, Key: string(types.KeyLiveChain), Value: "true"},
+	}, false)))
+	require.True(t, called)
+
+	// params that are only valid together are validated once changed
+	ctx, _ = ctx.CacheContext()
+	handler = evm.NewParamChangeProposalHandler(k, params.NewParamChangeProposalHandler(testkeeper.EVMTestApp.ParamsKeeper))
+	maxFee := k.GetParams(ctx).MaximumFeePerGas
+	err = handler(ctx, paramsproposal.NewParameterChangeProposal("title", "description", []paramsproposal.ParamChange{
+		{Subspace: types.ModuleName, Key: string(types.KeyMinFeePerGas), Value: fmt.Sprintf(`"%s"`, maxFee.Add(sdk.OneDec()))},
+	}, false))
+	require.ErrorContains(t, err, "maximum fee cannot be lower than minimum fee")
+	require.Nil(t, handler(ctx, paramsproposal.NewParameterChangeProposal("title", "description", []paramsproposal.ParamChange{
+		{Subspace: types.ModuleName, Key: string(types.KeyMinFeePerGas), Value: fmt.Sprintf(`"%s"`, maxFee)},
+	}, false)))
+	require.Equal(t, maxFee, k.GetParams(ctx).MinimumFeePerGas)
+}
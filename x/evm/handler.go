@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramsproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 
 	"github.com/kiichain/kiichain3/x/evm/keeper"
 	"github.com/kiichain/kiichain3/x/evm/types"
@@ -57,3 +58,36 @@ func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
 		}
 	}
 }
+
+// NewParamChangeProposalHandler wraps the handler of param change proposals so
+// that proposals changing the EVM chain ID, which is fixed at genesis, fail,
+// and so that the EVM params are validated as a whole once changed, since the
+// param store only validates each param on its own.
+func NewParamChangeProposalHandler(k *keeper.Keeper, next govtypes.Handler) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		c, ok := content.(*paramsproposal.ParameterChangeProposal)
+		if !ok {
+			return next(ctx, content)
+		}
+		changesEVMParams := false
+		for _, change := range c.Changes {
+			if change.Subspace != types.ModuleName {
+				continue
+			}
+			if change.Key == string(types.KeyChainID) {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "evm param %s is immutable", change.Key)
+			}
+			changesEVMParams = true
+		}
+		if err := next(ctx, content); err != nil {
+			return err
+		}
+		if !changesEVMParams {
+			return nil
+		}
+		if err := k.GetParams(ctx).Validate(); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid evm params: %s", err)
+		}
+		return nil
+	}
+}
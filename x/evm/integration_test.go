@@ -20,6 +20,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	xauthsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -28,6 +29,7 @@ import (
 	"github.com/kiichain/kiichain3/precompiles/pointer"
 	testkeeper "github.com/kiichain/kiichain3/testutil/keeper"
 	"github.com/kiichain/kiichain3/x/evm/artifacts/cw721"
+	"github.com/kiichain/kiichain3/x/evm/artifacts/erc1155"
 	"github.com/kiichain/kiichain3/x/evm/keeper"
 	"github.com/kiichain/kiichain3/x/evm/types"
 	"github.com/kiichain/kiichain3/x/evm/types/ethtx"
@@ -254,6 +256,251 @@ func TestCW2981PointerToERC2981(t *testing.T) {
 	require.Equal(t, fmt.Sprintf("{\"address\":\"%s\",\"royalty_amount\":\"1000\"}", kiiAddr.String()), string(ret))
 }
 
+func TestCW1155PointerToERC1155(t *testing.T) {
+	k := testkeeper.EVMTestApp.EvmKeeper
+	ctx := testkeeper.EVMTestApp.GetContextForDeliverTx([]byte{}).WithBlockTime(time.Now())
+	// the pointer contract is built with the CosmWasm optimizer and is not
+	// embedded in the binary, so its code has to be stored first
+	wasmCode, err := os.ReadFile("../../example/cosmwasm/cw1155/artifacts/cwerc1155.wasm")
+	if err != nil {
+		t.Skip("cwerc1155.wasm has not been built")
+	}
+	_, err = k.StoreCW1155PointerCode(ctx, wasmCode)
+	require.Nil(t, err)
+	// deploy erc1155
+	privKey := testkeeper.MockPrivateKey()
+	kiiAddr, evmAddr := testkeeper.PrivateKeyToAddresses(privKey)
+	k.SetAddressMapping(ctx, kiiAddr, evmAddr)
+	require.Nil(t, k.BankKeeper().AddCoins(ctx, kiiAddr, sdk.NewCoins(sdk.NewCoin("ukii", sdk.NewInt(10000000))), true))
+	testPrivHex := hex.EncodeToString(privKey.Bytes())
+	key, _ := crypto.HexToECDSA(testPrivHex)
+	code, err := os.ReadFile("../../example/contracts/erc1155/DummyERC1155.bin")
+	require.Nil(t, err)
+	bz, err := hex.DecodeString(strings.TrimSpace(string(code)))
+	require.Nil(t, err)
+	txData := ethtypes.LegacyTx{
+		Nonce:    0,
+		GasPrice: big.NewInt(100000000000),
+		Gas:      6000000,
+		To:       nil,
+		Data:     bz,
+	}
+	chainID := k.ChainID(ctx)
+	chainCfg := types.DefaultChainConfig()
+	ethCfg := chainCfg.EthereumConfig(chainID)
+	blockNum := big.NewInt(ctx.BlockHeight())
+	signer := ethtypes.MakeSigner(ethCfg, blockNum, uint64(ctx.BlockTime().Unix()))
+	tx, err := ethtypes.SignTx(ethtypes.NewTx(&txData), signer, key)
+	require.Nil(t, err)
+	typedTx, err := ethtx.NewLegacyTx(tx)
+	require.Nil(t, err)
+	msg, err := types.NewMsgEVMTransaction(typedTx)
+	require.Nil(t, err)
+	txBuilder := testkeeper.EVMTestApp.GetTxConfig().NewTxBuilder()
+	txBuilder.SetMsgs(msg)
+	cosmosTx := txBuilder.GetTx()
+	txbz, err := testkeeper.EVMTestApp.GetTxConfig().TxEncoder()(cosmosTx)
+	require.Nil(t, err)
+	res := testkeeper.EVMTestApp.DeliverTx(ctx, abci.RequestDeliverTx{Tx: txbz}, cosmosTx, sha256.Sum256(txbz))
+	require.Equal(t, uint32(0), res.Code)
+	err = k.FlushTransientReceipts(ctx)
+	require.NoError(t, err)
+	receipt, err := k.GetReceipt(ctx, tx.Hash())
+	require.Nil(t, err)
+	require.NotEmpty(t, receipt.ContractAddress)
+	require.Empty(t, receipt.VmError)
+	// deploy CW->ERC pointer
+	res2, err := keeper.NewMsgServerImpl(&k).RegisterPointer(sdk.WrapSDKContext(ctx), &types.MsgRegisterPointer{
+		Sender:      kiiAddr.String(),
+		PointerType: types.PointerType_ERC1155,
+		ErcAddress:  receipt.ContractAddress,
+	})
+	require.Nil(t, err)
+	require.NotEmpty(t, res2.PointerAddress)
+	pointerAddr := sdk.MustAccAddressFromBech32(res2.PointerAddress)
+	pointer, version, exists := k.GetCW1155ERC1155Pointer(ctx, common.HexToAddress(receipt.ContractAddress))
+	require.True(t, exists)
+	require.Equal(t, res2.PointerAddress, pointer.String())
+	require.Equal(t, erc1155.CurrentVersion, version)
+	// transfer through the pointer
+	recipientKii, recipientEvm := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx, recipientKii, recipientEvm)
+	executeMsg, err := json.Marshal(map[string]interface{}{
+		"send": map[string]interface{}{
+			"to":       recipientKii.String(),
+			"token_id": "1",
+			"amount":   "10",
+		},
+	})
+	require.Nil(t, err)
+	_, err = k.WasmKeeper().Execute(ctx, pointerAddr, kiiAddr, executeMsg, sdk.NewCoins())
+	require.Nil(t, err)
+	executeMsg, err = json.Marshal(map[string]interface{}{
+		"send_batch": map[string]interface{}{
+			"to": recipientKii.String(),
+			"batch": []map[string]interface{}{
+				{"token_id": "1", "amount": "5"},
+				{"token_id": "2", "amount": "20"},
+			},
+		},
+	})
+	require.Nil(t, err)
+	_, err = k.WasmKeeper().Execute(ctx, pointerAddr, kiiAddr, executeMsg, sdk.NewCoins())
+	require.Nil(t, err)
+	// the recipient cannot move the owner's tokens without an approval
+	executeMsg, err = json.Marshal(map[string]interface{}{
+		"send": map[string]interface{}{
+			"from":     kiiAddr.String(),
+			"to":       recipientKii.String(),
+			"token_id": "1",
+			"amount":   "1",
+		},
+	})
+	require.Nil(t, err)
+	_, err = k.WasmKeeper().Execute(ctx, pointerAddr, recipientKii, executeMsg, sdk.NewCoins())
+	require.NotNil(t, err)
+	// balances are read from the ERC1155 contract
+	query, err := json.Marshal(map[string]interface{}{
+		"balance_of_batch": []map[string]interface{}{
+			{"owner": kiiAddr.String(), "token_id": "1"},
+			{"owner": recipientKii.String(), "token_id": "1"},
+			{"owner": kiiAddr.String(), "token_id": "2"},
+			{"owner": recipientKii.String(), "token_id": "2"},
+		},
+	})
+	require.Nil(t, err)
+	ret, err := testkeeper.EVMTestApp.WasmKeeper.QuerySmart(ctx, pointerAddr, query)
+	require.Nil(t, err)
+	require.Equal(t, fmt.Sprintf(
+		"{\"balances\":[{\"token_id\":\"1\",\"owner\":\"%[1]s\",\"amount\":\"85\"},{\"token_id\":\"1\",\"owner\":\"%[2]s\",\"amount\":\"15\"},{\"token_id\":\"2\",\"owner\":\"%[1]s\",\"amount\":\"30\"},{\"token_id\":\"2\",\"owner\":\"%[2]s\",\"amount\":\"20\"}]}",
+		kiiAddr.String(), recipientKii.String()), string(ret))
+	query, err = json.Marshal(map[string]interface{}{
+		"contract_info": map[string]interface{}{},
+	})
+	require.Nil(t, err)
+	ret, err = testkeeper.EVMTestApp.WasmKeeper.QuerySmart(ctx, pointerAddr, query)
+	require.Nil(t, err)
+	require.Equal(t, "{\"name\":\"DummyERC1155\",\"symbol\":\"DUMMY\"}", string(ret))
+	query, err = json.Marshal(map[string]interface{}{
+		"num_tokens": map[string]interface{}{"token_id": "2"},
+	})
+	require.Nil(t, err)
+	ret, err = testkeeper.EVMTestApp.WasmKeeper.QuerySmart(ctx, pointerAddr, query)
+	require.Nil(t, err)
+	require.Equal(t, "{\"count\":\"50\"}", string(ret))
+}
+
+func TestCW20NativePointer(t *testing.T) {
+	k := testkeeper.EVMTestApp.EvmKeeper
+	ctx := testkeeper.EVMTestApp.GetContextForDeliverTx([]byte{}).WithBlockTime(time.Now())
+	// the pointer contract is built with the CosmWasm optimizer and is not
+	// embedded in the binary, so its code has to be stored first
+	wasmCode, err := os.ReadFile("../../example/cosmwasm/native/artifacts/cwnative.wasm")
+	if err != nil {
+		t.Skip("cwnative.wasm has not been built")
+	}
+	_, err = k.StoreCWNativePointerCode(ctx, wasmCode)
+	require.Nil(t, err)
+	denom := "unativepointer"
+	k.BankKeeper().SetDenomMetaData(ctx, banktypes.Metadata{
+		Base:       denom,
+		Display:    "nativepointer",
+		Name:       "nativepointer",
+		Symbol:     "NPT",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}, {Denom: "nativepointer", Exponent: 6}},
+	})
+	owner, _ := testkeeper.MockAddressPair()
+	spender, _ := testkeeper.MockAddressPair()
+	recipient, _ := testkeeper.MockAddressPair()
+	amts := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(1000)))
+	require.Nil(t, testkeeper.EVMTestApp.BankKeeper.MintCoins(ctx, types.ModuleName, amts))
+	require.Nil(t, testkeeper.EVMTestApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, amts))
+	// deploy the CW20 pointer of the denom
+	res, err := keeper.NewMsgServerImpl(&k).RegisterPointer(sdk.WrapSDKContext(ctx), types.NewMsgRegisterCW20NativePointer(owner, denom))
+	require.Nil(t, err)
+	pointerAddr := sdk.MustAccAddressFromBech32(res.PointerAddress)
+	balanceOf := func(addr sdk.AccAddress) int64 {
+		return k.BankKeeper().GetBalance(ctx, addr, denom).Amount.Int64()
+	}
+	// transfer moves the native funds
+	executeMsg, err := json.Marshal(map[string]interface{}{
+		"transfer": map[string]interface{}{
+			"recipient": recipient.String(),
+			"amount":    "100",
+		},
+	})
+	require.Nil(t, err)
+	_, err = k.WasmKeeper().Execute(ctx, pointerAddr, owner, executeMsg, sdk.NewCoins())
+	require.Nil(t, err)
+	require.Equal(t, int64(900), balanceOf(owner))
+	require.Equal(t, int64(100), balanceOf(recipient))
+	// the spender can only move what it is allowed to
+	executeMsg, err = json.Marshal(map[string]interface{}{
+		"increase_allowance": map[string]interface{}{
+			"spender": spender.String(),
+			"amount":  "50",
+		},
+	})
+	require.Nil(t, err)
+	_, err = k.WasmKeeper().Execute(ctx, pointerAddr, owner, executeMsg, sdk.NewCoins())
+	require.Nil(t, err)
+	executeMsg, err = json.Marshal(map[string]interface{}{
+		"transfer_from": map[string]interface{}{
+			"owner":     owner.String(),
+			"recipient": recipient.String(),
+			"amount":    "30",
+		},
+	})
+	require.Nil(t, err)
+	_, err = k.WasmKeeper().Execute(ctx, pointerAddr, spender, executeMsg, sdk.NewCoins())
+	require.Nil(t, err)
+	require.Equal(t, int64(870), balanceOf(owner))
+	require.Equal(t, int64(130), balanceOf(recipient))
+	_, err = k.WasmKeeper().Execute(ctx, pointerAddr, spender, executeMsg, sdk.NewCoins())
+	require.ErrorContains(t, err, "No allowance for this account")
+	require.Equal(t, int64(870), balanceOf(owner))
+	// zero transfers are rejected
+	executeMsg, err = json.Marshal(map[string]interface{}{
+		"transfer": map[string]interface{}{
+			"recipient": recipient.String(),
+			"amount":    "0",
+		},
+	})
+	require.Nil(t, err)
+	_, err = k.WasmKeeper().Execute(ctx, pointerAddr, owner, executeMsg, sdk.NewCoins())
+	require.ErrorContains(t, err, "Invalid zero amount")
+	// nobody but the pointer can move the funds
+	_, err = k.HandleInternalSendNative(ctx, &types.MsgInternalSendNative{
+		FromContract: spender.String(),
+		FromAddress:  owner.String(),
+		ToAddress:    recipient.String(),
+		Amount:       sdk.NewCoin(denom, sdk.NewInt(1)),
+	})
+	require.NotNil(t, err)
+	// queries are answered from the bank module
+	query, err := json.Marshal(map[string]interface{}{
+		"balance": map[string]interface{}{"address": owner.String()},
+	})
+	require.Nil(t, err)
+	ret, err := testkeeper.EVMTestApp.WasmKeeper.QuerySmart(ctx, pointerAddr, query)
+	require.Nil(t, err)
+	require.Equal(t, "{\"balance\":\"870\"}", string(ret))
+	query, err = json.Marshal(map[string]interface{}{
+		"token_info": map[string]interface{}{},
+	})
+	require.Nil(t, err)
+	ret, err = testkeeper.EVMTestApp.WasmKeeper.QuerySmart(ctx, pointerAddr, query)
+	require.Nil(t, err)
+	require.Equal(t, "{\"name\":\"nativepointer\",\"symbol\":\"nativepointer\",\"decimals\":6,\"total_supply\":\"1000\"}", string(ret))
+	query, err = json.Marshal(map[string]interface{}{
+		"allowance": map[string]interface{}{"owner": owner.String(), "spender": spender.String()},
+	})
+	require.Nil(t, err)
+	ret, err = testkeeper.EVMTestApp.WasmKeeper.QuerySmart(ctx, pointerAddr, query)
+	require.Nil(t, err)
+	require.Equal(t, "{\"allowance\":\"20\",\"expires\":{\"never\":{}}}", string(ret))
+}
+
 func TestNonceIncrementsForInsufficientFunds(t *testing.T) {
 	k := testkeeper.EVMTestApp.EvmKeeper
 	ctx := testkeeper.EVMTestApp.GetContextForDeliverTx([]byte{}).WithBlockTime(time.Now())
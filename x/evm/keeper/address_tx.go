@@ -0,0 +1,174 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/binary"
+	"slices"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/kiichain/kiichain3/x/evm/types"
+	seidbtypes "github.com/sei-protocol/sei-db/ss/types"
+)
+
+// AddressTx is a tx in which an address appears, with the roles of the address
+// in the tx.
+type AddressTx struct {
+	Height  int64
+	TxIndex uint32
+	TxHash  common.Hash
+	Roles   []byte
+}
+
+// AddressTxPosition is the position of a tx in the chain, by which the address
+// tx index is ordered.
+type AddressTxPosition struct {
+	Height  int64
+	TxIndex uint32
+}
+
+type addressTxEntry struct {
+	addr common.Address
+	role byte
+}
+
+// addressTxEntries returns the addresses that appear in a receipt with their
+// roles: the sender, the recipient or the created contract, and the addresses
+// in the topics of the logs.
+func addressTxEntries(receipt *types.Receipt) []addressTxEntry {
+	entries := []addressTxEntry{}
+	seen := map[addressTxEntry]bool{}
+	add := func(addr common.Address, role byte) {
+		entry := addressTxEntry{addr: addr, role: role}
+		if addr == (common.Address{}) || seen[entry] {
+			return
+		}
+		seen[entry] = true
+		entries = append(entries, entry)
+	}
+	if receipt.From != "" {
+		add(common.HexToAddress(receipt.From), types.AddressTxRoleFrom)
+	}
+	if receipt.To != "" {
+		add(common.HexToAddress(receipt.To), types.AddressTxRoleTo)
+	} else if receipt.ContractAddress != "" {
+		add(common.HexToAddress(receipt.ContractAddress), types.AddressTxRoleCreated)
+	}
+	for _, log := range receipt.Logs {
+		for _, topic := range log.Topics {
+			// addresses are left-padded with zeros in topics
+			hash := common.HexToHash(topic)
+			if bytes.Equal(hash[:common.HashLength-common.AddressLength], make([]byte, common.HashLength-common.AddressLength)) {
+				add(common.BytesToAddress(hash[:]), types.AddressTxRoleLog)
+			}
+		}
+	}
+	return entries
+}
+
+// IndexAddressTxs records the addresses of a receipt in the address tx index.
+// Like receipts, the index goes through the transient store into the receipt
+// store, so it is node-local and never touches consensus state. Since only
+// some nodes enable it, the writes must not consume gas either.
+func (k *Keeper) IndexAddressTxs(ctx sdk.Context, receipt *types.Receipt) {
+	if !k.AddressTxIndexEnabled {
+		return
+	}
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeterWithMultiplier(ctx))
+	store := ctx.TransientStore(k.transientStoreKey)
+	txHash := common.HexToHash(receipt.TxHashHex)
+	for _, entry := range addressTxEntries(receipt) {
+		store.Set(types.AddressTxKey(entry.addr, int64(receipt.BlockNumber), receipt.TransactionIndex, entry.role), txHash[:])
+	}
+}
+
+// PruneAddressTxIndex deletes the address tx index entries of the height that
+// falls out of the retention window with the current block. The entries are
+// derived again from the receipts of that height.
+func (k *Keeper) PruneAddressTxIndex(ctx sdk.Context) {
+	if !k.AddressTxIndexEnabled || k.AddressTxIndexRetention <= 0 {
+		return
+	}
+	height := ctx.BlockHeight() - k.AddressTxIndexRetention
+	if height <= 0 {
+		return
+	}
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeterWithMultiplier(ctx))
+	store := ctx.TransientStore(k.transientStoreKey)
+	for _, txHash := range k.GetTxHashesOnHeight(ctx, height) {
+		receipt, err := k.GetReceipt(ctx, txHash)
+		if err != nil {
+			continue
+		}
+		for _, entry := range addressTxEntries(receipt) {
+			store.Set(types.AddressTxDeleteKey(types.AddressTxKey(entry.addr, height, receipt.TransactionIndex, entry.role)), []byte{1})
+		}
+	}
+}
+
+// GetAddressTxs returns up to limit txs of the address tx index in which an
+// address has any of the given roles, or any role if roles is empty. Txs are
+// listed in ascending order of position starting after the given position, or
+// in descending order starting before it. A nil position starts at the
+// beginning, or at the end.
+func (k *Keeper) GetAddressTxs(addr common.Address, roles []byte, after *AddressTxPosition, limit int, descending bool) ([]AddressTx, error) {
+	lv, err := k.receiptStore.GetLatestVersion()
+	if err != nil {
+		return nil, err
+	}
+	prefix := types.AddressTxAddressPrefix(addr)
+	start, end := prefix, sdk.PrefixEndBytes(prefix)
+	if after != nil {
+		position := types.AddressTxPositionKey(addr, after.Height, after.TxIndex)
+		if descending {
+			end = position
+		} else {
+			start = sdk.PrefixEndBytes(position)
+		}
+	}
+	var iter seidbtypes.DBIterator
+	if descending {
+		iter, err = k.receiptStore.ReverseIterator(types.ReceiptStoreKey, lv, start, end)
+	} else {
+		iter, err = k.receiptStore.Iterator(types.ReceiptStoreKey, lv, start, end)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = iter.Close() }()
+
+	res := []AddressTx{}
+	var cur *AddressTx
+	// adds the current tx to the result if it matches, and returns whether the
+	// result is full
+	flush := func() bool {
+		if cur != nil {
+			slices.Sort(cur.Roles)
+			if len(roles) == 0 || slices.ContainsFunc(cur.Roles, func(role byte) bool { return slices.Contains(roles, role) }) {
+				res = append(res, *cur)
+			}
+			cur = nil
+		}
+		return len(res) >= limit
+	}
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()[len(prefix):]
+		if len(key) != 13 {
+			continue
+		}
+		height := int64(binary.BigEndian.Uint64(key))
+		txIndex := binary.BigEndian.Uint32(key[8:])
+		if cur == nil || cur.Height != height || cur.TxIndex != txIndex {
+			if flush() {
+				return res, nil
+			}
+			cur = &AddressTx{Height: height, TxIndex: txIndex, TxHash: common.BytesToHash(iter.Value())}
+		}
+		cur.Roles = append(cur.Roles, key[12])
+	}
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+	flush()
+	return res, nil
+}
@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	testkeeper "github.com/kiichain/kiichain3/testutil/keeper"
+	"github.com/kiichain/kiichain3/x/evm/artifacts/native"
+	"github.com/kiichain/kiichain3/x/evm/keeper"
+	"github.com/kiichain/kiichain3/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddressTxIndex(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeperWithVersionedReceiptStore()
+	k.AddressTxIndexEnabled = true
+	alice := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	bob := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	contract := common.HexToAddress("0x3333333333333333333333333333333333333333")
+	receipts := []*types.Receipt{
+		{TxHashHex: common.Hash{1}.Hex(), BlockNumber: 10, TransactionIndex: 0, From: alice.Hex(), To: bob.Hex()},
+		{TxHashHex: common.Hash{2}.Hex(), BlockNumber: 10, TransactionIndex: 3, From: bob.Hex(), ContractAddress: contract.Hex()},
+		{TxHashHex: common.Hash{3}.Hex(), BlockNumber: 12, TransactionIndex: 1, From: bob.Hex(), To: contract.Hex(), Logs: []*types.Log{
+			{Topics: []string{common.Hash{0xff}.Hex(), common.BytesToHash(alice[:]).Hex()}},
+		}},
+	}
+	for _, receipt := range receipts {
+		blockCtx, _ := ctx.WithBlockHeight(int64(receipt.BlockNumber)).CacheContext()
+		k.IndexAddressTxs(blockCtx, receipt)
+		require.Nil(t, k.FlushTransientReceipts(blockCtx))
+	}
+	require.Eventually(t, func() bool {
+		txs, err := k.GetAddressTxs(alice, nil, nil, 10, false)
+		return err == nil && len(txs) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// ascending and descending
+	txs, err := k.GetAddressTxs(bob, nil, nil, 10, false)
+	require.Nil(t, err)
+	require.Equal(t, []keeper.AddressTx{
+		{Height: 10, TxIndex: 0, TxHash: common.Hash{1}, Roles: []byte{types.AddressTxRoleTo}},
+		{Height: 10, TxIndex: 3, TxHash: common.Hash{2}, Roles: []byte{types.AddressTxRoleFrom}},
+		{Height: 12, TxIndex: 1, TxHash: common.Hash{3}, Roles: []byte{types.AddressTxRoleFrom}},
+	}, txs)
+	txs, err = k.GetAddressTxs(bob, nil, nil, 10, true)
+	require.Nil(t, err)
+	require.Len(t, txs, 3)
+	require.Equal(t, common.Hash{3}, txs[0].TxHash)
+	require.Equal(t, common.Hash{1}, txs[2].TxHash)
+
+	// roles
+	txs, err = k.GetAddressTxs(alice, []byte{types.AddressTxRoleLog}, nil, 10, false)
+	require.Nil(t, err)
+	require.Len(t, txs, 1)
+	require.Equal(t, common.Hash{3}, txs[0].TxHash)
+	txs, err = k.GetAddressTxs(contract, []byte{types.AddressTxRoleCreated}, nil, 10, false)
+	require.Nil(t, err)
+	require.Len(t, txs, 1)
+	require.Equal(t, common.Hash{2}, txs[0].TxHash)
+
+	// pagination
+	txs, err = k.GetAddressTxs(bob, nil, nil, 2, true)
+	require.Nil(t, err)
+	require.Len(t, txs, 2)
+	txs, err = k.GetAddressTxs(bob, nil, &keeper.AddressTxPosition{Height: txs[1].Height, TxIndex: txs[1].TxIndex}, 2, true)
+	require.Nil(t, err)
+	require.Len(t, txs, 1)
+	require.Equal(t, common.Hash{1}, txs[0].TxHash)
+	txs, err = k.GetAddressTxs(bob, nil, &keeper.AddressTxPosition{Height: 10, TxIndex: 0}, 10, false)
+	require.Nil(t, err)
+	require.Len(t, txs, 2)
+	require.Equal(t, common.Hash{2}, txs[0].TxHash)
+}
+
+func TestAddressTxIndexPruning(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeperWithVersionedReceiptStore()
+	k.AddressTxIndexEnabled = true
+	k.AddressTxIndexRetention = 5
+	alice := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	bob := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	for height := int64(1); height <= 2; height++ {
+		txHash := common.Hash{byte(height)}
+		receipt := &types.Receipt{TxHashHex: txHash.Hex(), BlockNumber: uint64(height), From: alice.Hex(), To: bob.Hex()}
+		blockCtx, _ := ctx.WithBlockHeight(height).CacheContext()
+		k.SetTxHashesOnHeight(ctx, height, []common.Hash{txHash})
+		k.IndexAddressTxs(blockCtx, receipt)
+		require.Nil(t, k.MockReceipt(blockCtx, txHash, receipt))
+	}
+	require.Eventually(t, func() bool {
+		txs, err := k.GetAddressTxs(alice, nil, nil, 10, false)
+		return err == nil && len(txs) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// block 6 prunes height 1
+	blockCtx, _ := ctx.WithBlockHeight(6).CacheContext()
+	k.PruneAddressTxIndex(blockCtx)
+	require.Nil(t, k.FlushTransientReceipts(blockCtx))
+	require.Eventually(t, func() bool {
+		txs, err := k.GetAddressTxs(bob, nil, nil, 10, false)
+		return err == nil && len(txs) == 1 && txs[0].Height == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	txs, err := k.GetAddressTxs(alice, nil, nil, 10, false)
+	require.Nil(t, err)
+	require.Len(t, txs, 1)
+}
+
+func TestAddressTxIndexDisabled(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeperWithVersionedReceiptStore()
+	alice := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	k.IndexAddressTxs(ctx, &types.Receipt{TxHashHex: common.Hash{1}.Hex(), BlockNumber: 1, From: alice.Hex()})
+	require.Nil(t, k.FlushTransientReceipts(ctx))
+	txs, err := k.GetAddressTxs(alice, nil, nil, 10, false)
+	require.Nil(t, err)
+	require.Empty(t, txs)
+}
+
+func TestAddressTxIndexGasNeutral(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	abi, err := native.NativeMetaData.GetAbi()
+	require.Nil(t, err)
+	args, err := abi.Pack("", "test", "TST", "TST", uint8(6))
+	require.Nil(t, err)
+	testAddr, senderEvmAddr := testkeeper.MockAddressPair()
+	k.SetAddressMapping(ctx, testAddr, senderEvmAddr)
+	amt := sdk.NewCoins(sdk.NewCoin(k.GetBaseDenom(ctx), sdk.NewInt(200000000)))
+	require.Nil(t, k.BankKeeper().MintCoins(ctx, types.ModuleName, amt))
+	require.Nil(t, k.BankKeeper().SendCoinsFromModuleToAccount(ctx, types.ModuleName, testAddr, amt))
+	req := &types.MsgInternalEVMCall{
+		Sender: testAddr.String(),
+		Data:   append(native.GetBin(), args...),
+	}
+	// the index is a node-local option, so a CW->EVM call must consume the
+	// same gas whether it is on or off
+	gasUsed := func(enabled bool) uint64 {
+		k.AddressTxIndexEnabled = enabled
+		callCtx, _ := ctx.WithTxSum([32]byte{1}).WithGasMeter(sdk.NewGasMeterWithMultiplier(ctx, 10000000)).CacheContext()
+		_, err := k.HandleInternalEVMCall(callCtx, req)
+		require.Nil(t, err)
+		return callCtx.GasMeter().GasConsumed()
+	}
+	require.Equal(t, gasUsed(false), gasUsed(true))
+}
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/kiichain/kiichain3/x/evm/types"
+)
+
+// bloomBitsSection accumulates the block blooms of the bloom-bits section that
+// is currently being built, so that the section can be written as soon as its
+// last block is committed without waiting on asynchronous receipt store writes.
+type bloomBitsSection struct {
+	mtx     sync.Mutex
+	section uint64
+	blooms  map[int64]ethtypes.Bloom
+}
+
+// IndexBlockBloom records the bloom of the current block in the bloom-bits
+// index and, on the last block of a section, writes the bit vectors of that
+// section. Like receipts, everything goes through the transient store into the
+// receipt store, so the index is node-local and never touches consensus state.
+func (k *Keeper) IndexBlockBloom(ctx sdk.Context, bloom ethtypes.Bloom) {
+	if !k.BloomBitsIndexEnabled || ctx.BlockHeight() <= 0 {
+		return
+	}
+	height := ctx.BlockHeight()
+	store := ctx.TransientStore(k.transientStoreKey)
+	store.Set(types.BloomBitsBlockBloomKey(height), bloom[:])
+
+	k.bloomBitsSection.mtx.Lock()
+	defer k.bloomBitsSection.mtx.Unlock()
+	section := uint64(height) / types.BloomBitsSectionSize
+	if k.bloomBitsSection.blooms == nil || k.bloomBitsSection.section != section {
+		k.bloomBitsSection.section = section
+		k.bloomBitsSection.blooms = make(map[int64]ethtypes.Bloom, types.BloomBitsSectionSize)
+	}
+	k.bloomBitsSection.blooms[height] = bloom
+	if (uint64(height)+1)%types.BloomBitsSectionSize != 0 {
+		return
+	}
+
+	vectors := make([][]byte, ethtypes.BloomBitLength)
+	first := int64(section * types.BloomBitsSectionSize)
+	for i := int64(0); i < types.BloomBitsSectionSize; i++ {
+		h := first + i
+		if h == 0 {
+			// no block bloom on genesis height
+			continue
+		}
+		blockBloom, known := k.bloomBitsSection.blooms[h]
+		if !known {
+			blockBloom, known = k.getIndexedBlockBloom(h)
+		}
+		for bit := uint(0); bit < ethtypes.BloomBitLength; bit++ {
+			// a block whose bloom is unknown (e.g. the index was enabled mid-section)
+			// is marked as a possible match for every bit so it is never skipped
+			if known && !BloomHasBit(blockBloom, bit) {
+				continue
+			}
+			if vectors[bit] == nil {
+				vectors[bit] = make([]byte, types.BloomBitsSectionSize/8)
+			}
+			vectors[bit][i/8] |= 1 << (7 - i%8)
+		}
+	}
+	for bit, vector := range vectors {
+		// all-zero vectors are omitted and implied by the section marker
+		if vector != nil {
+			store.Set(types.BloomBitsVectorKey(uint(bit), section), vector)
+		}
+	}
+	store.Set(types.BloomBitsSectionKey(section), []byte{1})
+	k.bloomBitsSection.blooms = nil
+}
+
+// GetBloomBits returns the bit vector of a bloom bit over the given section, in
+// which bit i (most significant first) is set if block section*BloomBitsSectionSize+i
+// may contain that bit. The second return value is false if the section has not
+// been indexed, in which case callers have to fall back to the block blooms.
+func (k *Keeper) GetBloomBits(bit uint, section uint64) ([]byte, bool, error) {
+	lv, err := k.receiptStore.GetLatestVersion()
+	if err != nil {
+		return nil, false, err
+	}
+	marker, err := k.receiptStore.Get(types.ReceiptStoreKey, lv, types.BloomBitsSectionKey(section))
+	if err != nil || marker == nil {
+		return nil, false, err
+	}
+	vector, err := k.receiptStore.Get(types.ReceiptStoreKey, lv, types.BloomBitsVectorKey(bit, section))
+	if err != nil {
+		return nil, false, err
+	}
+	if vector == nil {
+		vector = make([]byte, types.BloomBitsSectionSize/8)
+	}
+	return vector, true, nil
+}
+
+func (k *Keeper) getIndexedBlockBloom(height int64) (ethtypes.Bloom, bool) {
+	lv, err := k.receiptStore.GetLatestVersion()
+	if err != nil {
+		return ethtypes.Bloom{}, false
+	}
+	bz, err := k.receiptStore.Get(types.ReceiptStoreKey, lv, types.BloomBitsBlockBloomKey(height))
+	if err != nil || len(bz) != ethtypes.BloomByteLength {
+		return ethtypes.Bloom{}, false
+	}
+	return ethtypes.BytesToBloom(bz), true
+}
+
+// BloomHasBit returns whether the given bit (0-2047, as produced by the bloom
+// hash) is set in the bloom.
+func BloomHasBit(bloom ethtypes.Bloom, bit uint) bool {
+	return bloom[ethtypes.BloomByteLength-1-bit/8]&(1<<(bit%8)) != 0
+}
@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	testkeeper "github.com/kiichain/kiichain3/testutil/keeper"
+	"github.com/kiichain/kiichain3/x/evm/keeper"
+	"github.com/kiichain/kiichain3/x/evm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBloomBitsIndex(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeperWithVersionedReceiptStore()
+	k.BloomBitsIndexEnabled = true
+	section := uint64(3)
+	first := int64(section * types.BloomBitsSectionSize)
+
+	// nothing is indexed before the section completes
+	_, indexed, err := k.GetBloomBits(0, section)
+	require.Nil(t, err)
+	require.False(t, indexed)
+
+	addr := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	bloom := ethtypes.CreateBloom(ethtypes.Receipts{{Logs: []*ethtypes.Log{{Address: addr}}}})
+	var setBit uint
+	for bit := uint(0); bit < ethtypes.BloomBitLength; bit++ {
+		if keeper.BloomHasBit(bloom, bit) {
+			setBit = bit
+			break
+		}
+	}
+	matching := map[int64]bool{first + 5: true, first + 4000: true}
+	for i := int64(0); i < types.BloomBitsSectionSize; i++ {
+		height := first + i
+		if matching[height] {
+			k.IndexBlockBloom(ctx.WithBlockHeight(height), bloom)
+		} else {
+			k.IndexBlockBloom(ctx.WithBlockHeight(height), ethtypes.Bloom{})
+		}
+	}
+	require.Nil(t, k.FlushTransientReceipts(ctx.WithBlockHeight(first+types.BloomBitsSectionSize-1)))
+
+	require.Eventually(t, func() bool {
+		_, indexed, err := k.GetBloomBits(setBit, section)
+		return err == nil && indexed
+	}, 5*time.Second, 10*time.Millisecond)
+	vector, _, err := k.GetBloomBits(setBit, section)
+	require.Nil(t, err)
+	require.Len(t, vector, types.BloomBitsSectionSize/8)
+	for i := int64(0); i < types.BloomBitsSectionSize; i++ {
+		require.Equal(t, matching[first+i], vector[i/8]&(1<<(7-i%8)) != 0)
+	}
+
+	// bits not set by any block read back as an all-zero vector
+	var unsetBit uint
+	for bit := uint(0); bit < ethtypes.BloomBitLength; bit++ {
+		if !keeper.BloomHasBit(bloom, bit) {
+			unsetBit = bit
+			break
+		}
+	}
+	vector, indexed, err = k.GetBloomBits(unsetBit, section)
+	require.Nil(t, err)
+	require.True(t, indexed)
+	require.Equal(t, make([]byte, types.BloomBitsSectionSize/8), vector)
+}
+
+func TestBloomBitsIndexDisabled(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeperWithVersionedReceiptStore()
+	k.IndexBlockBloom(ctx.WithBlockHeight(types.BloomBitsSectionSize-1), ethtypes.Bloom{})
+	require.Nil(t, k.FlushTransientReceipts(ctx))
+	_, indexed, err := k.GetBloomBits(0, 0)
+	require.Nil(t, err)
+	require.False(t, indexed)
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/kiichain/kiichain3/x/evm/state"
+	"github.com/kiichain/kiichain3/x/evm/types"
+)
+
+// DistributeBaseFee burns the base fee burn ratio of the base fee paid by the
+// EVM txs of a block, and sends the rest to the fee collector. The base fee,
+// in wei, must already be held by the EVM module account. Amounts below one
+// ukii stay in the EVM module account.
+func (k *Keeper) DistributeBaseFee(ctx sdk.Context, baseFee sdk.Int) {
+	baseFeeUkii, _ := state.SplitUkiiWeiAmount(baseFee.BigInt())
+	if !baseFeeUkii.IsPositive() {
+		return
+	}
+	denom := k.GetBaseDenom(ctx)
+	burned := k.GetBaseFeeBurnRatio(ctx).MulInt(baseFeeUkii).TruncateInt()
+	collected := baseFeeUkii.Sub(burned)
+	if burned.IsPositive() {
+		if err := k.BankKeeper().BurnCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(denom, burned))); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("failed to burn base fee of %s due to %s", burned, err))
+			return
+		}
+		k.AddBurnedBaseFee(ctx, burned)
+	}
+	if collected.IsPositive() {
+		if err := k.BankKeeper().SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewCoin(denom, collected))); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("failed to send base fee of %s to fee collector due to %s", collected, err))
+			collected = sdk.ZeroInt()
+		}
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeBaseFee,
+		sdk.NewAttribute(types.AttributeKeyBurned, sdk.NewCoin(denom, burned).String()),
+		sdk.NewAttribute(types.AttributeKeyFeeCollected, sdk.NewCoin(denom, collected).String()),
+	))
+}
+
+// GetBurnedBaseFee returns the total base fee of EVM txs burned so far, in
+// ukii.
+func (k *Keeper) GetBurnedBaseFee(ctx sdk.Context) sdk.Int {
+	bz := ctx.KVStore(k.storeKey).Get(types.BurnedBaseFeeKey)
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+	burned := sdk.Int{}
+	if err := burned.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return burned
+}
+
+func (k *Keeper) AddBurnedBaseFee(ctx sdk.Context, amount sdk.Int) {
+	bz, err := k.GetBurnedBaseFee(ctx).Add(amount).Marshal()
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.BurnedBaseFeeKey, bz)
+}
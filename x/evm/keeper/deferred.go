@@ -49,7 +49,9 @@ func (k *Keeper) GetAllEVMTxDeferredInfo(ctx sdk.Context) (res []*types.Deferred
 	return
 }
 
-func (k *Keeper) AppendToEvmTxDeferredInfo(ctx sdk.Context, bloom ethtypes.Bloom, txHash common.Hash, surplus sdk.Int) {
+// AppendToEvmTxDeferredInfo records the deferred info of the current tx. The
+// base fee is the part of the surplus, in wei, paid as base fee.
+func (k *Keeper) AppendToEvmTxDeferredInfo(ctx sdk.Context, bloom ethtypes.Bloom, txHash common.Hash, surplus sdk.Int, baseFee sdk.Int) {
 	key := make([]byte, 8)
 	binary.BigEndian.PutUint64(key, uint64(ctx.TxIndex()))
 	val := &types.DeferredInfo{
@@ -57,6 +59,7 @@ func (k *Keeper) AppendToEvmTxDeferredInfo(ctx sdk.Context, bloom ethtypes.Bloom
 		TxBloom: bloom[:],
 		TxHash:  txHash[:],
 		Surplus: surplus,
+		BaseFee: baseFee,
 	}
 	bz, err := val.Marshal()
 	if err != nil {
@@ -73,6 +73,29 @@ func (k *Keeper) HandleInternalEVMDelegateCall(ctx sdk.Context, req *types.MsgIn
 	return &sdk.Result{Data: ret}, nil
 }
 
+// HandleInternalSendNative moves native funds on behalf of the CW20 pointer of
+// a denom. Like the bank precompile, only the registered pointer of the denom
+// may move it between arbitrary accounts.
+func (k *Keeper) HandleInternalSendNative(ctx sdk.Context, req *types.MsgInternalSendNative) (*sdk.Result, error) {
+	denom := req.Amount.Denom
+	pointer, _, exists := k.GetCW20NativePointer(ctx, denom)
+	if !exists || pointer.String() != req.FromContract {
+		return nil, fmt.Errorf("only pointer %s can send %s but got %s", pointer.String(), denom, req.FromContract)
+	}
+	from, err := sdk.AccAddressFromBech32(req.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+	to, err := sdk.AccAddressFromBech32(req.ToAddress)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.SendCoins(ctx, from, to, sdk.NewCoins(req.Amount)); err != nil {
+		return nil, err
+	}
+	return &sdk.Result{}, nil
+}
+
 func (k *Keeper) CallEVM(ctx sdk.Context, from common.Address, to *common.Address, val *sdk.Int, data []byte) (retdata []byte, reterr error) {
 	if ctx.IsEVM() && !ctx.EVMEntryViaWasmdPrecompile() {
 		return nil, errors.New("kii does not support EVM->CW->EVM call pattern")
@@ -142,7 +165,7 @@ func (k *Keeper) CallEVM(ctx sdk.Context, from common.Address, to *common.Addres
 	}
 	bloom := ethtypes.Bloom{}
 	bloom.SetBytes(receipt.LogsBloom)
-	k.AppendToEvmTxDeferredInfo(ctx, bloom, ctx.TxSum(), surplus)
+	k.AppendToEvmTxDeferredInfo(ctx, bloom, ctx.TxSum(), surplus, sdk.ZeroInt())
 	return res.ReturnData, nil
 }
 
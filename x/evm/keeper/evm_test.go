@@ -212,3 +212,34 @@ func TestHandleInternalEVMDelegateCall_AssociationError(t *testing.T) {
 	_, err := k.HandleInternalEVMDelegateCall(ctx, req)
 	require.Equal(t, err.Error(), types.NewAssociationMissingErr(testAddr.String()).Error())
 }
+
+func TestHandleInternalSendNative(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	from, _ := testkeeper.MockAddressPair()
+	to, _ := testkeeper.MockAddressPair()
+	pointer, _ := testkeeper.MockAddressPair()
+	other, _ := testkeeper.MockAddressPair()
+	amt := sdk.NewCoins(sdk.NewCoin("ufoo", sdk.NewInt(100)))
+	require.Nil(t, k.BankKeeper().MintCoins(ctx, types.ModuleName, amt))
+	require.Nil(t, k.BankKeeper().SendCoinsFromModuleToAccount(ctx, types.ModuleName, from, amt))
+
+	// no pointer registered for the denom
+	req := types.NewMessageInternalSendNative(pointer.String(), from, to, sdk.NewCoin("ufoo", sdk.NewInt(40)))
+	_, err := k.HandleInternalSendNative(ctx, req)
+	require.NotNil(t, err)
+
+	require.Nil(t, k.SetCW20NativePointer(ctx, "ufoo", pointer.String()))
+	// only the pointer of the denom can send it
+	_, err = k.HandleInternalSendNative(ctx, types.NewMessageInternalSendNative(other.String(), from, to, sdk.NewCoin("ufoo", sdk.NewInt(40))))
+	require.NotNil(t, err)
+	require.Equal(t, sdk.NewInt(100), k.BankKeeper().GetBalance(ctx, from, "ufoo").Amount)
+
+	_, err = k.HandleInternalSendNative(ctx, req)
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewInt(60), k.BankKeeper().GetBalance(ctx, from, "ufoo").Amount)
+	require.Equal(t, sdk.NewInt(40), k.BankKeeper().GetBalance(ctx, to, "ufoo").Amount)
+
+	// insufficient funds
+	_, err = k.HandleInternalSendNative(ctx, types.NewMessageInternalSendNative(pointer.String(), from, to, sdk.NewCoin("ufoo", sdk.NewInt(61))))
+	require.NotNil(t, err)
+}
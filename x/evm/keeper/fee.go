@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"encoding/binary"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/kiichain/kiichain3/x/evm/types"
 )
@@ -11,7 +13,9 @@ func (k *Keeper) AdjustDynamicBaseFeePerGas(ctx sdk.Context, blockGasUsed uint64
 		return nil
 	}
 	currentBaseFee := k.GetDynamicBaseFeePerGas(ctx)
-	minimumFeePerGas := k.GetParams(ctx).MinimumFeePerGas
+	params := k.GetParams(ctx)
+	minimumFeePerGas := params.MinimumFeePerGas
+	maximumFeePerGas := params.MaximumFeePerGas
 	blockGasLimit := sdk.NewDec(ctx.ConsensusParams().Block.MaxGas)
 	blockGasUsedDec := sdk.NewDec(int64(blockGasUsed))
 	targetGasUsed := sdk.NewDec(int64(k.GetTargetGasUsedPerBlock(ctx)))
@@ -38,6 +42,11 @@ func (k *Keeper) AdjustDynamicBaseFeePerGas(ctx sdk.Context, blockGasUsed uint64
 		newBaseFee = minimumFeePerGas
 	}
 
+	// Ensure the new base fee is not higher than the maximum fee
+	if newBaseFee.GT(maximumFeePerGas) {
+		newBaseFee = maximumFeePerGas
+	}
+
 	// Set the new base fee for the next height
 	k.SetDynamicBaseFeePerGas(ctx.WithBlockHeight(ctx.BlockHeight()+1), newBaseFee)
 
@@ -67,3 +76,68 @@ func (k *Keeper) SetDynamicBaseFeePerGas(ctx sdk.Context, baseFeePerGas sdk.Dec)
 	}
 	store.Set(types.BaseFeePerGasPrefix, bz)
 }
+
+// RecordBaseFeePerGas records the base fee the current block is executed with
+// in the base fee history, and prunes the records that fall out of the
+// retention window.
+func (k *Keeper) RecordBaseFeePerGas(ctx sdk.Context) {
+	height := ctx.BlockHeight()
+	k.SetBaseFeePerGasAtHeight(ctx, height, k.GetDynamicBaseFeePerGas(ctx))
+	retention := int64(k.GetBaseFeeHistoryRetention(ctx))
+	if height < retention {
+		return
+	}
+	// the retention may have been lowered, so prune everything below the window
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.BaseFeeHistoryPrefix, types.BaseFeeHistoryKey(height-retention+1))
+	defer iter.Close()
+	keys := [][]byte{}
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetBaseFeePerGasAtHeight returns the base fee a block was executed with, if
+// it is still in the base fee history.
+func (k *Keeper) GetBaseFeePerGasAtHeight(ctx sdk.Context, height int64) (sdk.Dec, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.BaseFeeHistoryKey(height))
+	if bz == nil {
+		return sdk.Dec{}, false
+	}
+	d := sdk.Dec{}
+	if err := d.UnmarshalJSON(bz); err != nil {
+		panic(err)
+	}
+	return d, true
+}
+
+func (k *Keeper) SetBaseFeePerGasAtHeight(ctx sdk.Context, height int64, baseFeePerGas sdk.Dec) {
+	bz, err := baseFeePerGas.MarshalJSON()
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.BaseFeeHistoryKey(height), bz)
+}
+
+// GetBaseFeeHistory returns the heights and base fees of the contiguous
+// records of the base fee history between two heights, both inclusive.
+func (k *Keeper) GetBaseFeeHistory(ctx sdk.Context, from int64, to int64) (heights []int64, baseFees []sdk.Dec) {
+	iter := ctx.KVStore(k.storeKey).Iterator(types.BaseFeeHistoryKey(from), types.BaseFeeHistoryKey(to+1))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		height := int64(binary.BigEndian.Uint64(iter.Key()[len(types.BaseFeeHistoryPrefix):]))
+		if len(heights) > 0 && height != heights[len(heights)-1]+1 {
+			break
+		}
+		d := sdk.Dec{}
+		if err := d.UnmarshalJSON(iter.Value()); err != nil {
+			panic(err)
+		}
+		heights = append(heights, height)
+		baseFees = append(baseFees, d)
+	}
+	return heights, baseFees
+}
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	testkeeper "github.com/kiichain/kiichain3/testutil/keeper"
+	"github.com/kiichain/kiichain3/x/evm/types"
 	"github.com/stretchr/testify/require"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 )
@@ -25,6 +26,7 @@ func TestAdjustBaseFeePerGas(t *testing.T) {
 		name            string
 		currentBaseFee  float64
 		minimumFee      float64
+		maximumFee      float64
 		blockGasUsed    uint64
 		blockGasLimit   uint64
 		upwardAdj       sdk.Dec
@@ -36,6 +38,7 @@ func TestAdjustBaseFeePerGas(t *testing.T) {
 			name:            "Block gas usage exactly half of limit, 0% up, 0% down, no fee change",
 			currentBaseFee:  100,
 			minimumFee:      10,
+			maximumFee:      1000000,
 			blockGasUsed:    500000,
 			blockGasLimit:   1000000,
 			upwardAdj:       sdk.NewDec(0),
@@ -47,6 +50,7 @@ func TestAdjustBaseFeePerGas(t *testing.T) {
 			name:            "Block gas usage 50%, 50% up, 50% down, no fee change",
 			currentBaseFee:  100,
 			minimumFee:      10,
+			maximumFee:      1000000,
 			blockGasUsed:    500000,
 			blockGasLimit:   1000000,
 			upwardAdj:       sdk.NewDecWithPrec(5, 1),
@@ -58,6 +62,7 @@ func TestAdjustBaseFeePerGas(t *testing.T) {
 			name:            "Block gas usage 75%, 0% up, 0% down, base fee stays the same",
 			currentBaseFee:  10000,
 			minimumFee:      10,
+			maximumFee:      1000000,
 			blockGasUsed:    750000,
 			blockGasLimit:   1000000,
 			upwardAdj:       sdk.NewDec(0),
@@ -69,6 +74,7 @@ func TestAdjustBaseFeePerGas(t *testing.T) {
 			name:            "Block gas usage 25%, 0% up, 0% down, base fee stays the same",
 			currentBaseFee:  10000,
 			minimumFee:      10,
+			maximumFee:      1000000,
 			blockGasUsed:    250000,
 			blockGasLimit:   1000000,
 			upwardAdj:       sdk.NewDec(0),
@@ -80,6 +86,7 @@ func TestAdjustBaseFeePerGas(t *testing.T) {
 			name:            "Block gas usage 75%, 50% up, 0% down, base fee increases by 25%",
 			currentBaseFee:  10000,
 			minimumFee:      10,
+			maximumFee:      1000000,
 			blockGasUsed:    750000,
 			blockGasLimit:   1000000,
 			upwardAdj:       sdk.NewDecWithPrec(5, 1),
@@ -91,6 +98,7 @@ func TestAdjustBaseFeePerGas(t *testing.T) {
 			name:            "Block gas usage 25%, 0% up, 50% down, base fee decreases by 25%",
 			currentBaseFee:  10000,
 			minimumFee:      10,
+			maximumFee:      1000000,
 			blockGasUsed:    250000,
 			blockGasLimit:   1000000,
 			upwardAdj:       sdk.NewDec(0),
@@ -102,6 +110,7 @@ func TestAdjustBaseFeePerGas(t *testing.T) {
 			name:            "Block gas usage low, new base fee below minimum, set to minimum",
 			currentBaseFee:  100,
 			minimumFee:      99,
+			maximumFee:      1000000,
 			blockGasUsed:    0,
 			blockGasLimit:   1000000,
 			upwardAdj:       sdk.NewDecWithPrec(5, 2),
@@ -109,6 +118,18 @@ func TestAdjustBaseFeePerGas(t *testing.T) {
 			targetGasUsed:   500000,
 			expectedBaseFee: 99, // Should not go below the minimum fee
 		},
+		{
+			name:            "Block gas usage high, new base fee above maximum, set to maximum",
+			currentBaseFee:  10000,
+			minimumFee:      10,
+			maximumFee:      11000,
+			blockGasUsed:    1000000,
+			blockGasLimit:   1000000,
+			upwardAdj:       sdk.NewDecWithPrec(5, 1),
+			downwardAdj:     sdk.NewDec(0),
+			targetGasUsed:   500000,
+			expectedBaseFee: 11000, // Should not go above the maximum fee
+		},
 	}
 
 	for _, tc := range testCases {
@@ -119,6 +140,7 @@ func TestAdjustBaseFeePerGas(t *testing.T) {
 			k.SetDynamicBaseFeePerGas(ctx, sdk.NewDecFromInt(sdk.NewInt(int64(tc.currentBaseFee))))
 			p := k.GetParams(ctx)
 			p.MinimumFeePerGas = sdk.NewDec(int64(tc.minimumFee))
+			p.MaximumFeePerGas = sdk.NewDec(int64(tc.maximumFee))
 			p.MaxDynamicBaseFeeUpwardAdjustment = tc.upwardAdj
 			p.MaxDynamicBaseFeeDownwardAdjustment = tc.downwardAdj
 			p.TargetGasUsedPerBlock = tc.targetGasUsed
@@ -130,3 +152,33 @@ func TestAdjustBaseFeePerGas(t *testing.T) {
 		})
 	}
 }
+
+func TestBaseFeeHistory(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	p := k.GetParams(ctx)
+	p.BaseFeeHistoryRetention = 3
+	k.SetParams(ctx, p)
+	for height := int64(1); height <= 5; height++ {
+		k.SetDynamicBaseFeePerGas(ctx, sdk.NewDec(height))
+		k.RecordBaseFeePerGas(ctx.WithBlockHeight(height))
+	}
+	_, found := k.GetBaseFeePerGasAtHeight(ctx, 2)
+	require.False(t, found)
+	baseFee, found := k.GetBaseFeePerGasAtHeight(ctx, 3)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(3), baseFee)
+	heights, baseFees := k.GetBaseFeeHistory(ctx, 1, 4)
+	require.Equal(t, []int64{3, 4}, heights)
+	require.Equal(t, []sdk.Dec{sdk.NewDec(3), sdk.NewDec(4)}, baseFees)
+	// the history is encoded like the current base fee
+	store := ctx.KVStore(k.GetStoreKey())
+	k.SetDynamicBaseFeePerGas(ctx, sdk.NewDec(5))
+	require.Equal(t, store.Get(types.BaseFeePerGasPrefix), store.Get(types.BaseFeeHistoryKey(5)))
+
+	// lowering the retention prunes everything outside of the new window
+	p.BaseFeeHistoryRetention = 1
+	k.SetParams(ctx, p)
+	k.RecordBaseFeePerGas(ctx.WithBlockHeight(6))
+	heights, _ = k.GetBaseFeeHistory(ctx, 1, 6)
+	require.Equal(t, []int64{6}, heights)
+}
@@ -7,8 +7,11 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/kiichain/kiichain3/x/evm/artifacts/cw1155"
 	"github.com/kiichain/kiichain3/x/evm/artifacts/cw20"
 	"github.com/kiichain/kiichain3/x/evm/artifacts/cw721"
+	"github.com/kiichain/kiichain3/x/evm/artifacts/cwnative"
+	"github.com/kiichain/kiichain3/x/evm/artifacts/erc1155"
 	"github.com/kiichain/kiichain3/x/evm/artifacts/erc20"
 	"github.com/kiichain/kiichain3/x/evm/artifacts/erc721"
 	"github.com/kiichain/kiichain3/x/evm/artifacts/native"
@@ -98,6 +101,13 @@ func (q Querier) Pointer(c context.Context, req *types.QueryPointerRequest) (*ty
 			Version: uint32(v),
 			Exists:  e,
 		}, nil
+	case types.PointerType_CW1155:
+		p, v, e := q.Keeper.GetERC1155CW1155Pointer(ctx, req.Pointee)
+		return &types.QueryPointerResponse{
+			Pointer: p.Hex(),
+			Version: uint32(v),
+			Exists:  e,
+		}, nil
 	case types.PointerType_ERC20:
 		p, v, e := q.Keeper.GetCW20ERC20Pointer(ctx, common.HexToAddress(req.Pointee))
 		return &types.QueryPointerResponse{
@@ -112,6 +122,20 @@ func (q Querier) Pointer(c context.Context, req *types.QueryPointerRequest) (*ty
 			Version: uint32(v),
 			Exists:  e,
 		}, nil
+	case types.PointerType_ERC1155:
+		p, v, e := q.Keeper.GetCW1155ERC1155Pointer(ctx, common.HexToAddress(req.Pointee))
+		return &types.QueryPointerResponse{
+			Pointer: p.String(),
+			Version: uint32(v),
+			Exists:  e,
+		}, nil
+	case types.PointerType_NATIVE_CW20:
+		p, v, e := q.Keeper.GetCW20NativePointer(ctx, req.Pointee)
+		return &types.QueryPointerResponse{
+			Pointer: p.String(),
+			Version: uint32(v),
+			Exists:  e,
+		}, nil
 	default:
 		return nil, errors.ErrUnsupported
 	}
@@ -126,12 +150,16 @@ func (q Querier) PointerVersion(c context.Context, req *types.QueryPointerVersio
 		}, nil
 	case types.PointerType_CW20:
 		return &types.QueryPointerVersionResponse{
-			Version: uint32(cw20.CurrentVersion(ctx)),
+			Version: uint32(cw20.CurrentVersion(q.IsLiveChain(ctx))),
 		}, nil
 	case types.PointerType_CW721:
 		return &types.QueryPointerVersionResponse{
 			Version: uint32(cw721.CurrentVersion),
 		}, nil
+	case types.PointerType_CW1155:
+		return &types.QueryPointerVersionResponse{
+			Version: uint32(cw1155.CurrentVersion),
+		}, nil
 	case types.PointerType_ERC20:
 		return &types.QueryPointerVersionResponse{
 			Version:  uint32(erc20.CurrentVersion),
@@ -142,6 +170,16 @@ func (q Querier) PointerVersion(c context.Context, req *types.QueryPointerVersio
 			Version:  uint32(erc721.CurrentVersion),
 			CwCodeId: q.GetStoredPointerCodeID(ctx, types.PointerType_ERC721),
 		}, nil
+	case types.PointerType_ERC1155:
+		return &types.QueryPointerVersionResponse{
+			Version:  uint32(erc1155.CurrentVersion),
+			CwCodeId: q.GetStoredPointerCodeID(ctx, types.PointerType_ERC1155),
+		}, nil
+	case types.PointerType_NATIVE_CW20:
+		return &types.QueryPointerVersionResponse{
+			Version:  uint32(cwnative.CurrentVersion),
+			CwCodeId: q.GetStoredPointerCodeID(ctx, types.PointerType_NATIVE_CW20),
+		}, nil
 	default:
 		return nil, errors.ErrUnsupported
 	}
@@ -171,6 +209,13 @@ func (q Querier) Pointee(c context.Context, req *types.QueryPointeeRequest) (*ty
 			Version: uint32(v),
 			Exists:  e,
 		}, nil
+	case types.PointerType_CW1155:
+		p, v, e := q.Keeper.GetCW1155Pointee(ctx, common.HexToAddress(req.Pointer))
+		return &types.QueryPointeeResponse{
+			Pointee: p,
+			Version: uint32(v),
+			Exists:  e,
+		}, nil
 	case types.PointerType_ERC20:
 		p, v, e := q.Keeper.GetERC20Pointee(ctx, req.Pointer)
 		return &types.QueryPointeeResponse{
@@ -185,7 +230,83 @@ func (q Querier) Pointee(c context.Context, req *types.QueryPointeeRequest) (*ty
 			Version: uint32(v),
 			Exists:  e,
 		}, nil
+	case types.PointerType_ERC1155:
+		p, v, e := q.Keeper.GetERC1155Pointee(ctx, req.Pointer)
+		return &types.QueryPointeeResponse{
+			Pointee: p.Hex(),
+			Version: uint32(v),
+			Exists:  e,
+		}, nil
+	case types.PointerType_NATIVE_CW20:
+		p, v, e := q.Keeper.GetCW20NativePointee(ctx, req.Pointer)
+		return &types.QueryPointeeResponse{
+			Pointee: p,
+			Version: uint32(v),
+			Exists:  e,
+		}, nil
 	default:
 		return nil, errors.ErrUnsupported
 	}
 }
+
+func (q Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryParamsResponse{Params: q.GetParams(ctx)}, nil
+}
+
+func (q Querier) BaseFee(c context.Context, req *types.QueryBaseFeeRequest) (*types.QueryBaseFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	next := ctx.BlockHeight() + 1
+	switch {
+	case req.Height < 0:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid height %d", req.Height)
+	case req.Height == 0 || req.Height == next:
+		// the base fee of the next block is already known
+		return &types.QueryBaseFeeResponse{BaseFeePerGas: q.GetDynamicBaseFeePerGas(ctx), Height: next}, nil
+	}
+	baseFee, found := q.GetBaseFeePerGasAtHeight(ctx, req.Height)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no base fee recorded at height %d", req.Height)
+	}
+	return &types.QueryBaseFeeResponse{BaseFeePerGas: baseFee, Height: req.Height}, nil
+}
+
+// FeeHistory returns the base fees of up to block_count blocks ending at
+// last_block, like eth_feeHistory. Blocks that are no longer in the base fee
+// history are left out.
+func (q Querier) FeeHistory(c context.Context, req *types.QueryFeeHistoryRequest) (*types.QueryFeeHistoryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if req.BlockCount == 0 || req.LastBlock < 0 {
+		return nil, sdkerrors.ErrInvalidRequest
+	}
+	lastBlock := req.LastBlock
+	if lastBlock == 0 || lastBlock > ctx.BlockHeight() {
+		lastBlock = ctx.BlockHeight()
+	}
+	blockCount := req.BlockCount
+	if retention := q.GetBaseFeeHistoryRetention(ctx); blockCount > retention {
+		blockCount = retention
+	}
+	oldestBlock := lastBlock - int64(blockCount) + 1
+	if oldestBlock < 1 {
+		oldestBlock = 1
+	}
+	heights, baseFees := q.GetBaseFeeHistory(ctx, oldestBlock, lastBlock)
+	if len(heights) == 0 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "no base fee recorded between heights %d and %d", oldestBlock, lastBlock)
+	}
+	// like eth_feeHistory, also return the base fee of the block after the
+	// last one
+	lastRecorded := heights[len(heights)-1]
+	if lastRecorded == ctx.BlockHeight() {
+		baseFees = append(baseFees, q.GetDynamicBaseFeePerGas(ctx))
+	} else if baseFee, found := q.GetBaseFeePerGasAtHeight(ctx, lastRecorded+1); found {
+		baseFees = append(baseFees, baseFee)
+	}
+	return &types.QueryFeeHistoryResponse{OldestBlock: heights[0], BaseFeePerGas: baseFees}, nil
+}
+
+func (q Querier) BurnedBaseFee(c context.Context, _ *types.QueryBurnedBaseFeeRequest) (*types.QueryBurnedBaseFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryBurnedBaseFeeResponse{Burned: sdk.NewCoin(q.GetBaseDenom(ctx), q.GetBurnedBaseFee(ctx))}, nil
+}
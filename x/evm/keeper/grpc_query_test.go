@@ -7,8 +7,10 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	testkeeper "github.com/kiichain/kiichain3/testutil/keeper"
+	"github.com/kiichain/kiichain3/x/evm/artifacts/cw1155"
 	"github.com/kiichain/kiichain3/x/evm/artifacts/cw20"
 	"github.com/kiichain/kiichain3/x/evm/artifacts/cw721"
+	"github.com/kiichain/kiichain3/x/evm/artifacts/cwnative"
 	"github.com/kiichain/kiichain3/x/evm/artifacts/erc20"
 	"github.com/kiichain/kiichain3/x/evm/artifacts/erc721"
 	"github.com/kiichain/kiichain3/x/evm/artifacts/native"
@@ -25,19 +27,23 @@ func TestQueryPointer(t *testing.T) {
 	kiiAddr3, evmAddr3 := testkeeper.MockAddressPair()
 	kiiAddr4, evmAddr4 := testkeeper.MockAddressPair()
 	kiiAddr5, evmAddr5 := testkeeper.MockAddressPair()
+	kiiAddr6, evmAddr6 := testkeeper.MockAddressPair()
+	kiiAddr7, _ := testkeeper.MockAddressPair()
 	goCtx := sdk.WrapSDKContext(ctx)
 	k.SetERC20NativePointer(ctx, kiiAddr1.String(), evmAddr1)
 	k.SetERC20CW20Pointer(ctx, kiiAddr2.String(), evmAddr2)
 	k.SetERC721CW721Pointer(ctx, kiiAddr3.String(), evmAddr3)
 	k.SetCW20ERC20Pointer(ctx, evmAddr4, kiiAddr4.String())
 	k.SetCW721ERC721Pointer(ctx, evmAddr5, kiiAddr5.String())
+	k.SetERC1155CW1155Pointer(ctx, kiiAddr6.String(), evmAddr6)
+	k.SetCW20NativePointer(ctx, "ufoo", kiiAddr7.String())
 	q := keeper.Querier{k}
 	res, err := q.Pointer(goCtx, &types.QueryPointerRequest{PointerType: types.PointerType_NATIVE, Pointee: kiiAddr1.String()})
 	require.Nil(t, err)
 	require.Equal(t, types.QueryPointerResponse{Pointer: evmAddr1.Hex(), Version: uint32(native.CurrentVersion), Exists: true}, *res)
 	res, err = q.Pointer(goCtx, &types.QueryPointerRequest{PointerType: types.PointerType_CW20, Pointee: kiiAddr2.String()})
 	require.Nil(t, err)
-	require.Equal(t, types.QueryPointerResponse{Pointer: evmAddr2.Hex(), Version: uint32(cw20.CurrentVersion(ctx)), Exists: true}, *res)
+	require.Equal(t, types.QueryPointerResponse{Pointer: evmAddr2.Hex(), Version: uint32(cw20.CurrentVersion(k.IsLiveChain(ctx))), Exists: true}, *res)
 	res, err = q.Pointer(goCtx, &types.QueryPointerRequest{PointerType: types.PointerType_CW721, Pointee: kiiAddr3.String()})
 	require.Nil(t, err)
 	require.Equal(t, types.QueryPointerResponse{Pointer: evmAddr3.Hex(), Version: uint32(cw721.CurrentVersion), Exists: true}, *res)
@@ -47,6 +53,19 @@ func TestQueryPointer(t *testing.T) {
 	res, err = q.Pointer(goCtx, &types.QueryPointerRequest{PointerType: types.PointerType_ERC721, Pointee: evmAddr5.Hex()})
 	require.Nil(t, err)
 	require.Equal(t, types.QueryPointerResponse{Pointer: kiiAddr5.String(), Version: uint32(erc721.CurrentVersion), Exists: true}, *res)
+	res, err = q.Pointer(goCtx, &types.QueryPointerRequest{PointerType: types.PointerType_CW1155, Pointee: kiiAddr6.String()})
+	require.Nil(t, err)
+	require.Equal(t, types.QueryPointerResponse{Pointer: evmAddr6.Hex(), Version: uint32(cw1155.CurrentVersion), Exists: true}, *res)
+	res, err = q.Pointer(goCtx, &types.QueryPointerRequest{PointerType: types.PointerType_NATIVE_CW20, Pointee: "ufoo"})
+	require.Nil(t, err)
+	require.Equal(t, types.QueryPointerResponse{Pointer: kiiAddr7.String(), Version: uint32(cwnative.CurrentVersion), Exists: true}, *res)
+	versionRes, err := q.PointerVersion(goCtx, &types.QueryPointerVersionRequest{PointerType: types.PointerType_CW1155})
+	require.Nil(t, err)
+	require.Equal(t, uint32(cw1155.CurrentVersion), versionRes.Version)
+	versionRes, err = q.PointerVersion(goCtx, &types.QueryPointerVersionRequest{PointerType: types.PointerType_NATIVE_CW20})
+	require.Nil(t, err)
+	require.Equal(t, uint32(cwnative.CurrentVersion), versionRes.Version)
+	require.Equal(t, k.GetStoredPointerCodeID(ctx, types.PointerType_NATIVE_CW20), versionRes.CwCodeId)
 }
 
 func TestQueryPointee(t *testing.T) {
@@ -56,6 +75,8 @@ func TestQueryPointee(t *testing.T) {
 	kiiAddr3, evmAddr3 := testkeeper.MockAddressPair()
 	kiiAddr4, evmAddr4 := testkeeper.MockAddressPair()
 	kiiAddr5, evmAddr5 := testkeeper.MockAddressPair()
+	kiiAddr6, evmAddr6 := testkeeper.MockAddressPair()
+	kiiAddr7, _ := testkeeper.MockAddressPair()
 	goCtx := sdk.WrapSDKContext(ctx)
 
 	// Set up pointers for each type
@@ -64,6 +85,8 @@ func TestQueryPointee(t *testing.T) {
 	k.SetERC721CW721Pointer(ctx, kiiAddr3.String(), evmAddr3)
 	k.SetCW20ERC20Pointer(ctx, evmAddr4, kiiAddr4.String())
 	k.SetCW721ERC721Pointer(ctx, evmAddr5, kiiAddr5.String())
+	k.SetERC1155CW1155Pointer(ctx, kiiAddr6.String(), evmAddr6)
+	k.SetCW20NativePointer(ctx, "ufoo", kiiAddr7.String())
 
 	q := keeper.Querier{k}
 
@@ -75,13 +98,18 @@ func TestQueryPointee(t *testing.T) {
 	// Test for CW20 Pointee
 	res, err = q.Pointee(goCtx, &types.QueryPointeeRequest{PointerType: types.PointerType_CW20, Pointer: evmAddr2.Hex()})
 	require.Nil(t, err)
-	require.Equal(t, types.QueryPointeeResponse{Pointee: kiiAddr2.String(), Version: uint32(cw20.CurrentVersion(ctx)), Exists: true}, *res)
+	require.Equal(t, types.QueryPointeeResponse{Pointee: kiiAddr2.String(), Version: uint32(cw20.CurrentVersion(k.IsLiveChain(ctx))), Exists: true}, *res)
 
 	// Test for CW721 Pointee
 	res, err = q.Pointee(goCtx, &types.QueryPointeeRequest{PointerType: types.PointerType_CW721, Pointer: evmAddr3.Hex()})
 	require.Nil(t, err)
 	require.Equal(t, types.QueryPointeeResponse{Pointee: kiiAddr3.String(), Version: uint32(cw721.CurrentVersion), Exists: true}, *res)
 
+	// Test for CW1155 Pointee
+	res, err = q.Pointee(goCtx, &types.QueryPointeeRequest{PointerType: types.PointerType_CW1155, Pointer: evmAddr6.Hex()})
+	require.Nil(t, err)
+	require.Equal(t, types.QueryPointeeResponse{Pointee: kiiAddr6.String(), Version: uint32(cw1155.CurrentVersion), Exists: true}, *res)
+
 	// Test for ERC20 Pointee
 	res, err = q.Pointee(goCtx, &types.QueryPointeeRequest{PointerType: types.PointerType_ERC20, Pointer: kiiAddr4.String()})
 	require.Nil(t, err)
@@ -92,6 +120,16 @@ func TestQueryPointee(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, types.QueryPointeeResponse{Pointee: evmAddr5.Hex(), Version: uint32(erc721.CurrentVersion), Exists: true}, *res)
 
+	// Test for Native CW20 Pointee
+	res, err = q.Pointee(goCtx, &types.QueryPointeeRequest{PointerType: types.PointerType_NATIVE_CW20, Pointer: kiiAddr7.String()})
+	require.Nil(t, err)
+	require.Equal(t, types.QueryPointeeResponse{Pointee: "ufoo", Version: uint32(cwnative.CurrentVersion), Exists: true}, *res)
+
+	// Test for a CW20 pointer of an ERC20 queried as a Native CW20 Pointee
+	res, err = q.Pointee(goCtx, &types.QueryPointeeRequest{PointerType: types.PointerType_NATIVE_CW20, Pointer: kiiAddr4.String()})
+	require.Nil(t, err)
+	require.Equal(t, types.QueryPointeeResponse{Pointee: "", Version: 0, Exists: false}, *res)
+
 	// Test for not registered Native Pointee
 	res, err = q.Pointee(goCtx, &types.QueryPointeeRequest{PointerType: types.PointerType_NATIVE, Pointer: "0x1234567890123456789012345678901234567890"})
 	require.Nil(t, err)
@@ -107,6 +145,11 @@ func TestQueryPointee(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, types.QueryPointeeResponse{Pointee: "", Version: 0, Exists: false}, *res)
 
+	// Test for not registered CW1155 Pointee
+	res, err = q.Pointee(goCtx, &types.QueryPointeeRequest{PointerType: types.PointerType_CW1155, Pointer: "0x1234567890123456789012345678901234567890"})
+	require.Nil(t, err)
+	require.Equal(t, types.QueryPointeeResponse{Pointee: "", Version: 0, Exists: false}, *res)
+
 	// Test for not registered ERC20 Pointee
 	res, err = q.Pointee(goCtx, &types.QueryPointeeRequest{PointerType: types.PointerType_ERC20, Pointer: "kii1notregistered"})
 	require.Nil(t, err)
@@ -163,3 +206,51 @@ func TestQueryPointee(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryBaseFee(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	ctx = ctx.WithBlockHeight(3)
+	for height := int64(1); height <= 3; height++ {
+		k.SetDynamicBaseFeePerGas(ctx, sdk.NewDec(height*100))
+		k.RecordBaseFeePerGas(ctx.WithBlockHeight(height))
+	}
+	k.SetDynamicBaseFeePerGas(ctx, sdk.NewDec(400))
+	goCtx := sdk.WrapSDKContext(ctx)
+	q := keeper.Querier{k}
+
+	params, err := q.Params(goCtx, &types.QueryParamsRequest{})
+	require.Nil(t, err)
+	require.Equal(t, k.GetParams(ctx), params.Params)
+
+	res, err := q.BaseFee(goCtx, &types.QueryBaseFeeRequest{})
+	require.Nil(t, err)
+	require.Equal(t, types.QueryBaseFeeResponse{BaseFeePerGas: sdk.NewDec(400), Height: 4}, *res)
+	res, err = q.BaseFee(goCtx, &types.QueryBaseFeeRequest{Height: 2})
+	require.Nil(t, err)
+	require.Equal(t, types.QueryBaseFeeResponse{BaseFeePerGas: sdk.NewDec(200), Height: 2}, *res)
+	_, err = q.BaseFee(goCtx, &types.QueryBaseFeeRequest{Height: 5})
+	require.ErrorContains(t, err, "no base fee recorded at height 5")
+
+	history, err := q.FeeHistory(goCtx, &types.QueryFeeHistoryRequest{BlockCount: 2})
+	require.Nil(t, err)
+	require.Equal(t, types.QueryFeeHistoryResponse{OldestBlock: 2, BaseFeePerGas: []sdk.Dec{sdk.NewDec(200), sdk.NewDec(300), sdk.NewDec(400)}}, *history)
+	history, err = q.FeeHistory(goCtx, &types.QueryFeeHistoryRequest{BlockCount: 10, LastBlock: 1})
+	require.Nil(t, err)
+	require.Equal(t, types.QueryFeeHistoryResponse{OldestBlock: 1, BaseFeePerGas: []sdk.Dec{sdk.NewDec(100), sdk.NewDec(200)}}, *history)
+	_, err = q.FeeHistory(goCtx, &types.QueryFeeHistoryRequest{})
+	require.NotNil(t, err)
+}
+
+func TestQueryBurnedBaseFee(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	q := keeper.Querier{k}
+	res, err := q.BurnedBaseFee(sdk.WrapSDKContext(ctx), &types.QueryBurnedBaseFeeRequest{})
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewCoin("ukii", sdk.ZeroInt()), res.Burned)
+
+	k.AddBurnedBaseFee(ctx, sdk.NewInt(3))
+	k.AddBurnedBaseFee(ctx, sdk.NewInt(4))
+	res, err = q.BurnedBaseFee(sdk.WrapSDKContext(ctx), &types.QueryBurnedBaseFeeRequest{})
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewCoin("ukii", sdk.NewInt(7)), res.Burned)
+}
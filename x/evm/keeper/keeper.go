@@ -80,6 +80,19 @@ type Keeper struct {
 	ReplayBlock *ethtypes.Block
 
 	receiptStore seidbtypes.StateStore
+
+	// node-local index of block blooms kept in the receipt store. Not used in chain critical path.
+	BloomBitsIndexEnabled bool
+	bloomBitsSection      *bloomBitsSection
+
+	// node-local index of the txs of each address kept in the receipt store, and the
+	// number of recent blocks it keeps (0 for all). Not used in chain critical path.
+	AddressTxIndexEnabled   bool
+	AddressTxIndexRetention int64
+
+	// translates the Cosmos events emitted by wasm contracts into the logs of their
+	// EVM pointers. Set by the app, only used by RPC simulations. Not used in chain critical path.
+	CosmosEventsTranslator func(ctx sdk.Context, events []abci.Event) []*ethtypes.Log
 }
 
 type AddressNoncePair struct {
@@ -132,6 +145,7 @@ func NewKeeper(
 		cachedFeeCollectorAddressMtx: &sync.RWMutex{},
 		keyToNonce:                   make(map[tmtypes.TxKey]*AddressNoncePair),
 		receiptStore:                 receiptStateStore,
+		bloomBitsSection:             &bloomBitsSection{},
 	}
 	return k
 }
@@ -371,6 +385,22 @@ func (k *Keeper) GetKeysToNonces() map[tmtypes.TxKey]*AddressNoncePair {
 	return k.keyToNonce
 }
 
+// GetPendingTxsSnapshot returns a copy of the pending nonces of the mempool by
+// sender, sorted by nonce.
+func (k *Keeper) GetPendingTxsSnapshot() map[common.Address][]PendingTx {
+	k.nonceMx.Lock()
+	defer k.nonceMx.Unlock()
+	res := make(map[common.Address][]PendingTx, len(k.pendingTxs))
+	for addr, pendings := range k.pendingTxs {
+		txs := make([]PendingTx, 0, len(pendings))
+		for _, pendingTx := range pendings {
+			txs = append(txs, *pendingTx)
+		}
+		res[common.HexToAddress(addr)] = txs
+	}
+	return res
+}
+
 // Only used in ETH replay
 func (k *Keeper) PrepareReplayedAddr(ctx sdk.Context, addr common.Address) {
 	if !k.EthReplayConfig.Enabled {
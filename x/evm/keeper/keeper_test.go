@@ -40,15 +40,22 @@ func TestPurgePrefixNotHang(t *testing.T) {
 func TestGetChainID(t *testing.T) {
 	k, ctx := keeper.MockEVMKeeper()
 	require.Equal(t, config.DefaultChainID, k.ChainID(ctx).Int64())
+	require.False(t, k.IsLiveChain(ctx))
 
+	// the cosmos chain ID no longer matters
 	ctx = ctx.WithChainID("pacific-1")
-	require.Equal(t, int64(1334), k.ChainID(ctx).Int64())
+	require.Equal(t, config.DefaultChainID, k.ChainID(ctx).Int64())
 
-	ctx = ctx.WithChainID("atlantic-2")
-	require.Equal(t, int64(1335), k.ChainID(ctx).Int64())
+	params := k.GetParams(ctx)
+	params.ChainId = 1234
+	params.LiveChain = true
+	k.SetParams(ctx, params)
+	require.Equal(t, int64(1234), k.ChainID(ctx).Int64())
+	require.True(t, k.IsLiveChain(ctx))
 
-	ctx = ctx.WithChainID("arctic-1")
-	require.Equal(t, int64(1336), k.ChainID(ctx).Int64())
+	// heights before the migration keep the chain ID derived from the cosmos one
+	k.Paramstore.Set(ctx, types.KeyChainID, uint64(0))
+	require.Equal(t, int64(1334), k.ChainID(ctx).Int64())
 }
 
 func TestGetVMBlockContext(t *testing.T) {
@@ -209,9 +216,9 @@ func TestDeferredInfo(t *testing.T) {
 	k := a.EvmKeeper
 	ctx := a.GetContextForDeliverTx([]byte{})
 	ctx = ctx.WithTxIndex(1)
-	k.AppendToEvmTxDeferredInfo(ctx, ethtypes.Bloom{1, 2, 3}, common.Hash{4, 5, 6}, sdk.NewInt(1))
+	k.AppendToEvmTxDeferredInfo(ctx, ethtypes.Bloom{1, 2, 3}, common.Hash{4, 5, 6}, sdk.NewInt(1), sdk.ZeroInt())
 	ctx = ctx.WithTxIndex(2)
-	k.AppendToEvmTxDeferredInfo(ctx, ethtypes.Bloom{7, 8}, common.Hash{9, 0}, sdk.NewInt(1))
+	k.AppendToEvmTxDeferredInfo(ctx, ethtypes.Bloom{7, 8}, common.Hash{9, 0}, sdk.NewInt(1), sdk.ZeroInt())
 	k.SetTxResults([]*abci.ExecTxResult{{Code: 0}, {Code: 0}, {Code: 0}, {Code: 1, Log: "test error"}})
 	msg := mockEVMTransactionMessage(t)
 	k.SetMsgs([]*types.MsgEVMTransaction{nil, {}, {}, msg})
@@ -258,6 +265,10 @@ func TestAddPendingNonce(t *testing.T) {
 	require.Equal(t, common.HexToAddress("123"), keyToNonce[tmtypes.TxKey{3}].Address)
 	require.Equal(t, uint64(2), keyToNonce[tmtypes.TxKey{3}].Nonce)
 	require.NotContains(t, keyToNonce, tmtypes.TxKey{2})
+	snapshot := k.GetPendingTxsSnapshot()
+	require.Equal(t, []evmkeeper.PendingTx{{Key: tmtypes.TxKey{1}, Nonce: 1, Priority: 1}, {Key: tmtypes.TxKey{3}, Nonce: 2, Priority: 2}}, snapshot[common.HexToAddress("123")])
+	k.RemovePendingNonce(tmtypes.TxKey{1})
+	require.Len(t, snapshot[common.HexToAddress("123")], 2)
 }
 
 func mockEVMTransactionMessage(t *testing.T) *types.MsgEVMTransaction {
@@ -24,6 +24,8 @@ import (
 
 	"github.com/kiichain/kiichain3/precompiles/wasmd"
 	"github.com/kiichain/kiichain3/utils"
+	"github.com/kiichain/kiichain3/x/evm/artifacts/cwnative"
+	"github.com/kiichain/kiichain3/x/evm/artifacts/erc1155"
 	"github.com/kiichain/kiichain3/x/evm/artifacts/erc20"
 	"github.com/kiichain/kiichain3/x/evm/artifacts/erc721"
 	"github.com/kiichain/kiichain3/x/evm/state"
@@ -149,7 +151,8 @@ func (server msgServer) EVMTransaction(goCtx context.Context, msg *types.MsgEVMT
 		surplus = surplus.Add(extraSurplus)
 		bloom := ethtypes.Bloom{}
 		bloom.SetBytes(receipt.LogsBloom)
-		server.AppendToEvmTxDeferredInfo(ctx, bloom, tx.Hash(), surplus)
+		baseFee := server.GetDynamicBaseFeePerGas(ctx).TruncateInt().Mul(sdk.NewIntFromUint64(serverRes.GasUsed))
+		server.AppendToEvmTxDeferredInfo(ctx, bloom, tx.Hash(), surplus, baseFee)
 
 		// GasUsed in serverRes is in EVM's gas unit, not Kii's gas unit.
 		// PriorityNormalizer is the coefficient that's used to adjust EVM
@@ -256,6 +259,9 @@ func (server msgServer) Send(goCtx context.Context, msg *types.MsgSend) (*types.
 
 func (server msgServer) RegisterPointer(goCtx context.Context, msg *types.MsgRegisterPointer) (*types.MsgRegisterPointerResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if msg.PointerType == types.PointerType_NATIVE_CW20 {
+		return server.registerCW20NativePointer(ctx, msg.ErcAddress)
+	}
 	var existingPointer sdk.AccAddress
 	var existingVersion uint16
 	var currentVersion uint16
@@ -267,6 +273,9 @@ func (server msgServer) RegisterPointer(goCtx context.Context, msg *types.MsgReg
 	case types.PointerType_ERC721:
 		currentVersion = erc721.CurrentVersion
 		existingPointer, existingVersion, exists = server.GetCW721ERC721Pointer(ctx, common.HexToAddress(msg.ErcAddress))
+	case types.PointerType_ERC1155:
+		currentVersion = erc1155.CurrentVersion
+		existingPointer, existingVersion, exists = server.GetCW1155ERC1155Pointer(ctx, common.HexToAddress(msg.ErcAddress))
 	default:
 		panic("unknown pointer type")
 	}
@@ -279,10 +288,15 @@ func (server msgServer) RegisterPointer(goCtx context.Context, msg *types.MsgReg
 		payload["erc20_address"] = msg.ErcAddress
 	case types.PointerType_ERC721:
 		payload["erc721_address"] = msg.ErcAddress
+	case types.PointerType_ERC1155:
+		payload["erc1155_address"] = msg.ErcAddress
 	default:
 		panic("unknown pointer type")
 	}
 	codeID := server.GetStoredPointerCodeID(ctx, msg.PointerType)
+	if codeID == 0 {
+		return nil, fmt.Errorf("no code stored for %s pointer version %d", msg.PointerType, currentVersion)
+	}
 	moduleAcct := server.accountKeeper.GetModuleAddress(types.ModuleName)
 	var err error
 	var pointerAddr sdk.AccAddress
@@ -313,12 +327,37 @@ func (server msgServer) RegisterPointer(goCtx context.Context, msg *types.MsgReg
 			types.EventTypePointerRegistered, sdk.NewAttribute(types.AttributeKeyPointerType, "erc721"),
 			sdk.NewAttribute(types.AttributeKeyPointerAddress, pointerAddr.String()), sdk.NewAttribute(types.AttributeKeyPointee, msg.ErcAddress),
 			sdk.NewAttribute(types.AttributeKeyPointerVersion, fmt.Sprintf("%d", erc721.CurrentVersion))))
+	case types.PointerType_ERC1155:
+		err = server.SetCW1155ERC1155Pointer(ctx, common.HexToAddress(msg.ErcAddress), pointerAddr.String())
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypePointerRegistered, sdk.NewAttribute(types.AttributeKeyPointerType, "erc1155"),
+			sdk.NewAttribute(types.AttributeKeyPointerAddress, pointerAddr.String()), sdk.NewAttribute(types.AttributeKeyPointee, msg.ErcAddress),
+			sdk.NewAttribute(types.AttributeKeyPointerVersion, fmt.Sprintf("%d", erc1155.CurrentVersion))))
 	default:
 		panic("unknown pointer type")
 	}
 	return &types.MsgRegisterPointerResponse{PointerAddress: pointerAddr.String()}, err
 }
 
+// registerCW20NativePointer creates or upgrades the CW20 pointer of a native
+// denom. Like addNativePointer of the pointer precompile, it only works for
+// denoms with bank metadata.
+func (server msgServer) registerCW20NativePointer(ctx sdk.Context, token string) (*types.MsgRegisterPointerResponse, error) {
+	existingPointer, existingVersion, exists := server.GetCW20NativePointer(ctx, token)
+	if exists && existingVersion >= cwnative.CurrentVersion {
+		return nil, fmt.Errorf("pointer %s already registered at version %d", existingPointer.String(), existingVersion)
+	}
+	metadata, found := server.bankKeeper.GetDenomMetaData(ctx, token)
+	if !found {
+		return nil, fmt.Errorf("denom %s does not have metadata stored", token)
+	}
+	pointerAddr, err := server.UpsertCWNativePointer(ctx, token, utils.ERCMetadataFromDenomMetadata(metadata))
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgRegisterPointerResponse{PointerAddress: pointerAddr.String()}, nil
+}
+
 func (server msgServer) AssociateContractAddress(goCtx context.Context, msg *types.MsgAssociateContractAddress) (*types.MsgAssociateContractAddressResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	addr := sdk.MustAccAddressFromBech32(msg.Address) // already validated
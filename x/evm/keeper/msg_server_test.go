@@ -14,6 +14,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -625,6 +626,17 @@ func TestRegisterPointer(t *testing.T) {
 	require.Equal(t, erc721.CurrentVersion, version)
 	require.Equal(t, newPointer.String(), res.PointerAddress)
 	require.Equal(t, newPointer.String(), pointer.String()) // should retain the existing contract address
+
+	// the ERC1155 pointer code is not stored at genesis
+	_, err = keeper.NewMsgServerImpl(k).RegisterPointer(sdk.WrapSDKContext(ctx), &types.MsgRegisterPointer{
+		Sender:      sender.String(),
+		PointerType: types.PointerType_ERC1155,
+		ErcAddress:  pointee.Hex(),
+	})
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "no code stored for ERC1155 pointer version 1")
+	_, _, exists = k.GetCW1155ERC1155Pointer(ctx, pointee)
+	require.False(t, exists)
 }
 
 func TestEvmError(t *testing.T) {
@@ -794,3 +806,30 @@ func TestAssociate(t *testing.T) {
 	res = testkeeper.EVMTestApp.DeliverTx(ctx, abci.RequestDeliverTx{Tx: txbz}, sdktx, sha256.Sum256(txbz))
 	require.Equal(t, uint32(0), res.Code)
 }
+
+func TestRegisterCW20NativePointer(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	sender, _ := testkeeper.MockAddressPair()
+	msg := types.NewMsgRegisterCW20NativePointer(sender, "ufoo")
+
+	// no metadata for the denom
+	_, err := keeper.NewMsgServerImpl(k).RegisterPointer(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorContains(t, err, "does not have metadata stored")
+
+	// no pointer code stored
+	k.BankKeeper().SetDenomMetaData(ctx, banktypes.Metadata{
+		Base:       "ufoo",
+		Display:    "foo",
+		Name:       "foo",
+		Symbol:     "FOO",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: "ufoo", Exponent: 0}, {Denom: "foo", Exponent: 6}},
+	})
+	_, err = keeper.NewMsgServerImpl(k).RegisterPointer(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorContains(t, err, "no code stored")
+
+	// already registered at the current version
+	pointer, _ := testkeeper.MockAddressPair()
+	require.Nil(t, k.SetCW20NativePointer(ctx, "ufoo", pointer.String()))
+	_, err = keeper.NewMsgServerImpl(k).RegisterPointer(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorContains(t, err, "already registered")
+}
@@ -49,6 +49,18 @@ func (k *Keeper) GetMinimumFeePerGas(ctx sdk.Context) sdk.Dec {
 	return k.GetParams(ctx).MinimumFeePerGas
 }
 
+func (k *Keeper) GetMaximumFeePerGas(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).MaximumFeePerGas
+}
+
+func (k *Keeper) GetBaseFeeHistoryRetention(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).BaseFeeHistoryRetention
+}
+
+func (k *Keeper) GetBaseFeeBurnRatio(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).BaseFeeBurnRatio
+}
+
 func (k *Keeper) GetTargetGasUsedPerBlock(ctx sdk.Context) uint64 {
 	return k.GetParams(ctx).TargetGasUsedPerBlock
 }
@@ -62,9 +74,28 @@ func (k *Keeper) ChainID(ctx sdk.Context) *big.Int {
 		// replay is for eth mainnet so always return 1
 		return utils.Big1
 	}
-	// return mapped chain ID
-	return config.GetEVMChainID(ctx.ChainID())
+	var chainID uint64
+	// the chain ID used to be free to look up, so reading it must not consume gas
+	k.Paramstore.GetIfExists(ctx.WithGasMeter(sdk.NewInfiniteGasMeterWithMultiplier(ctx)), types.KeyChainID, &chainID)
+	if chainID == 0 {
+		// only before the chain ID param is set by genesis or the migration,
+		// when the chain ID was derived from the cosmos chain ID
+		return config.GetLegacyEVMChainID(ctx.ChainID())
+	}
+	return new(big.Int).SetUint64(chainID)
+}
 
+// IsLiveChain returns true on production networks, where test-only overrides
+// are disabled.
+func (k *Keeper) IsLiveChain(ctx sdk.Context) bool {
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeterWithMultiplier(ctx))
+	if !k.Paramstore.Has(ctx, types.KeyLiveChain) {
+		// like the chain ID, before the param is set by genesis or the migration
+		return config.IsLegacyLiveChainID(ctx.ChainID())
+	}
+	var live bool
+	k.Paramstore.Get(ctx, types.KeyLiveChain, &live)
+	return live
 }
 
 /*
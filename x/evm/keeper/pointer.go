@@ -8,8 +8,11 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/common"
 
+	"github.com/kiichain/kiichain3/x/evm/artifacts/cw1155"
 	"github.com/kiichain/kiichain3/x/evm/artifacts/cw20"
 	"github.com/kiichain/kiichain3/x/evm/artifacts/cw721"
+	"github.com/kiichain/kiichain3/x/evm/artifacts/cwnative"
+	"github.com/kiichain/kiichain3/x/evm/artifacts/erc1155"
 	"github.com/kiichain/kiichain3/x/evm/artifacts/erc20"
 	"github.com/kiichain/kiichain3/x/evm/artifacts/erc721"
 	"github.com/kiichain/kiichain3/x/evm/artifacts/native"
@@ -59,7 +62,7 @@ func (k *Keeper) DeleteERC20NativePointer(ctx sdk.Context, token string, version
 
 // ERC20 -> CW20
 func (k *Keeper) SetERC20CW20Pointer(ctx sdk.Context, cw20Address string, addr common.Address) error {
-	return k.SetERC20CW20PointerWithVersion(ctx, cw20Address, addr, cw20.CurrentVersion(ctx))
+	return k.SetERC20CW20PointerWithVersion(ctx, cw20Address, addr, cw20.CurrentVersion(k.IsLiveChain(ctx)))
 }
 
 // ERC20 -> CW20
@@ -127,6 +130,41 @@ func (k *Keeper) DeleteERC721CW721Pointer(ctx sdk.Context, cw721Address string,
 	}
 }
 
+// ERC1155 -> CW1155
+func (k *Keeper) SetERC1155CW1155Pointer(ctx sdk.Context, cw1155Address string, addr common.Address) error {
+	return k.SetERC1155CW1155PointerWithVersion(ctx, cw1155Address, addr, cw1155.CurrentVersion)
+}
+
+// ERC1155 -> CW1155
+func (k *Keeper) SetERC1155CW1155PointerWithVersion(ctx sdk.Context, cw1155Address string, addr common.Address, version uint16) error {
+	if k.cwAddressIsPointer(ctx, cw1155Address) {
+		return ErrorPointerToPointerNotAllowed
+	}
+	err := k.setPointerInfo(ctx, types.PointerERC1155CW1155Key(cw1155Address), addr[:], version)
+	if err != nil {
+		return err
+	}
+	return k.setPointerInfo(ctx, types.PointerReverseRegistryKey(addr), []byte(cw1155Address), version)
+}
+
+// ERC1155 -> CW1155
+func (k *Keeper) GetERC1155CW1155Pointer(ctx sdk.Context, cw1155Address string) (addr common.Address, version uint16, exists bool) {
+	addrBz, version, exists := k.GetPointerInfo(ctx, types.PointerERC1155CW1155Key(cw1155Address))
+	if exists {
+		addr = common.BytesToAddress(addrBz)
+	}
+	return
+}
+
+// ERC1155 -> CW1155
+func (k *Keeper) DeleteERC1155CW1155Pointer(ctx sdk.Context, cw1155Address string, version uint16) {
+	addr, _, exists := k.GetERC1155CW1155Pointer(ctx, cw1155Address)
+	if exists {
+		k.deletePointerInfo(ctx, types.PointerERC1155CW1155Key(cw1155Address), version)
+		k.deletePointerInfo(ctx, types.PointerReverseRegistryKey(addr), version)
+	}
+}
+
 // CW20 -> ERC20
 func (k *Keeper) SetCW20ERC20Pointer(ctx sdk.Context, erc20Address common.Address, addr string) error {
 	return k.SetCW20ERC20PointerWithVersion(ctx, erc20Address, addr, erc20.CurrentVersion)
@@ -162,6 +200,38 @@ func (k *Keeper) DeleteCW20ERC20Pointer(ctx sdk.Context, erc20Address common.Add
 	}
 }
 
+// CW20 -> Native Token
+func (k *Keeper) SetCW20NativePointer(ctx sdk.Context, token string, addr string) error {
+	return k.SetCW20NativePointerWithVersion(ctx, token, addr, cwnative.CurrentVersion)
+}
+
+// CW20 -> Native Token
+func (k *Keeper) SetCW20NativePointerWithVersion(ctx sdk.Context, token string, addr string, version uint16) error {
+	err := k.setPointerInfo(ctx, types.PointerCW20NativeKey(token), []byte(addr), version)
+	if err != nil {
+		return err
+	}
+	return k.setPointerInfo(ctx, types.PointerReverseRegistryKey(common.BytesToAddress([]byte(addr))), []byte(token), version)
+}
+
+// CW20 -> Native Token
+func (k *Keeper) GetCW20NativePointer(ctx sdk.Context, token string) (addr sdk.AccAddress, version uint16, exists bool) {
+	addrBz, version, exists := k.GetPointerInfo(ctx, types.PointerCW20NativeKey(token))
+	if exists {
+		addr = sdk.MustAccAddressFromBech32(string(addrBz))
+	}
+	return
+}
+
+// CW20 -> Native Token
+func (k *Keeper) DeleteCW20NativePointer(ctx sdk.Context, token string, version uint16) {
+	addr, _, exists := k.GetCW20NativePointer(ctx, token)
+	if exists {
+		k.deletePointerInfo(ctx, types.PointerCW20NativeKey(token), version)
+		k.deletePointerInfo(ctx, types.PointerReverseRegistryKey(common.BytesToAddress([]byte(addr.String()))), version)
+	}
+}
+
 func (k *Keeper) evmAddressIsPointer(ctx sdk.Context, addr common.Address) bool {
 	_, _, exists := k.GetPointerInfo(ctx, types.PointerReverseRegistryKey(addr))
 	return exists
@@ -207,6 +277,41 @@ func (k *Keeper) DeleteCW721ERC721Pointer(ctx sdk.Context, erc721Address common.
 	}
 }
 
+// CW1155 -> ERC1155
+func (k *Keeper) SetCW1155ERC1155Pointer(ctx sdk.Context, erc1155Address common.Address, addr string) error {
+	return k.SetCW1155ERC1155PointerWithVersion(ctx, erc1155Address, addr, erc1155.CurrentVersion)
+}
+
+// CW1155 -> ERC1155
+func (k *Keeper) SetCW1155ERC1155PointerWithVersion(ctx sdk.Context, erc1155Address common.Address, addr string, version uint16) error {
+	if k.evmAddressIsPointer(ctx, erc1155Address) {
+		return ErrorPointerToPointerNotAllowed
+	}
+	err := k.setPointerInfo(ctx, types.PointerCW1155ERC1155Key(erc1155Address), []byte(addr), version)
+	if err != nil {
+		return err
+	}
+	return k.setPointerInfo(ctx, types.PointerReverseRegistryKey(common.BytesToAddress([]byte(addr))), erc1155Address[:], version)
+}
+
+// CW1155 -> ERC1155
+func (k *Keeper) GetCW1155ERC1155Pointer(ctx sdk.Context, erc1155Address common.Address) (addr sdk.AccAddress, version uint16, exists bool) {
+	addrBz, version, exists := k.GetPointerInfo(ctx, types.PointerCW1155ERC1155Key(erc1155Address))
+	if exists {
+		addr = sdk.MustAccAddressFromBech32(string(addrBz))
+	}
+	return
+}
+
+// CW1155 -> ERC1155
+func (k *Keeper) DeleteCW1155ERC1155Pointer(ctx sdk.Context, erc1155Address common.Address, version uint16) {
+	addr, _, exists := k.GetCW1155ERC1155Pointer(ctx, erc1155Address)
+	if exists {
+		k.deletePointerInfo(ctx, types.PointerCW1155ERC1155Key(erc1155Address), version)
+		k.deletePointerInfo(ctx, types.PointerReverseRegistryKey(common.BytesToAddress([]byte(addr.String()))), version)
+	}
+}
+
 func (k *Keeper) GetPointerInfo(ctx sdk.Context, pref []byte) (addr []byte, version uint16, exists bool) {
 	store := prefix.NewStore(ctx.KVStore(k.GetStoreKey()), pref)
 	iter := store.ReverseIterator(nil, nil)
@@ -245,6 +350,12 @@ func (k *Keeper) GetStoredPointerCodeID(ctx sdk.Context, pointerType types.Point
 	case types.PointerType_ERC721:
 		store = prefix.NewStore(store, types.PointerCW721ERC721Prefix)
 		versionBz = artifactsutils.GetVersionBz(erc721.CurrentVersion)
+	case types.PointerType_ERC1155:
+		store = prefix.NewStore(store, types.PointerCW1155ERC1155Prefix)
+		versionBz = artifactsutils.GetVersionBz(erc1155.CurrentVersion)
+	case types.PointerType_NATIVE_CW20:
+		store = prefix.NewStore(store, types.PointerCW20NativePrefix)
+		versionBz = artifactsutils.GetVersionBz(cwnative.CurrentVersion)
 	default:
 		return 0
 	}
@@ -271,6 +382,14 @@ func (k *Keeper) GetCW721Pointee(ctx sdk.Context, erc721Address common.Address)
 	return
 }
 
+func (k *Keeper) GetCW1155Pointee(ctx sdk.Context, erc1155Address common.Address) (cw1155Address string, version uint16, exists bool) {
+	addrBz, version, exists := k.GetPointerInfo(ctx, types.PointerReverseRegistryKey(erc1155Address))
+	if exists {
+		cw1155Address = string(addrBz)
+	}
+	return
+}
+
 func (k *Keeper) GetERC20Pointee(ctx sdk.Context, cw20Address string) (erc20Address common.Address, version uint16, exists bool) {
 	addrBz, version, exists := k.GetPointerInfo(ctx, types.PointerReverseRegistryKey(common.BytesToAddress([]byte(cw20Address))))
 	if exists {
@@ -287,6 +406,14 @@ func (k *Keeper) GetERC721Pointee(ctx sdk.Context, cw721Address string) (erc721A
 	return
 }
 
+func (k *Keeper) GetERC1155Pointee(ctx sdk.Context, cw1155Address string) (erc1155Address common.Address, version uint16, exists bool) {
+	addrBz, version, exists := k.GetPointerInfo(ctx, types.PointerReverseRegistryKey(common.BytesToAddress([]byte(cw1155Address))))
+	if exists {
+		erc1155Address = common.BytesToAddress(addrBz)
+	}
+	return
+}
+
 func (k *Keeper) GetNativePointee(ctx sdk.Context, erc20Address string) (token string, version uint16, exists bool) {
 	// Ensure the key matches how it was set in SetERC20NativePointer
 	key := types.PointerReverseRegistryKey(common.HexToAddress(erc20Address))
@@ -296,3 +423,17 @@ func (k *Keeper) GetNativePointee(ctx sdk.Context, erc20Address string) (token s
 	}
 	return
 }
+
+func (k *Keeper) GetCW20NativePointee(ctx sdk.Context, cw20Address string) (token string, version uint16, exists bool) {
+	addrBz, version, exists := k.GetPointerInfo(ctx, types.PointerReverseRegistryKey(common.BytesToAddress([]byte(cw20Address))))
+	if !exists {
+		return
+	}
+	// the reverse registry is shared with the other pointer types, so make sure
+	// the entry actually belongs to a CW20 pointer of a native denom
+	if pointer, _, found := k.GetCW20NativePointer(ctx, string(addrBz)); !found || pointer.String() != cw20Address {
+		return "", 0, false
+	}
+	token = string(addrBz)
+	return
+}
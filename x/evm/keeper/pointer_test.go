@@ -9,8 +9,10 @@ import (
 	"github.com/stretchr/testify/require"
 
 	testkeeper "github.com/kiichain/kiichain3/testutil/keeper"
+	"github.com/kiichain/kiichain3/x/evm/artifacts/cw1155"
 	"github.com/kiichain/kiichain3/x/evm/artifacts/cw20"
 	"github.com/kiichain/kiichain3/x/evm/artifacts/cw721"
+	"github.com/kiichain/kiichain3/x/evm/artifacts/cwnative"
 	"github.com/kiichain/kiichain3/x/evm/artifacts/native"
 	evmkeeper "github.com/kiichain/kiichain3/x/evm/keeper"
 )
@@ -72,7 +74,7 @@ func TestEVMtoCWPointers(t *testing.T) {
 					cwGetter:   k.GetCW721ERC721Pointer,
 				}
 			},
-			version: cw20.CurrentVersion(ctx),
+			version: cw20.CurrentVersion(testkeeper.EVMTestApp.EvmKeeper.IsLiveChain(ctx)),
 		},
 		{
 			name: "ERC20CW20Pointer prevents pointer to cw20 pointer",
@@ -85,7 +87,7 @@ func TestEVMtoCWPointers(t *testing.T) {
 					cwGetter:   k.GetCW20ERC20Pointer,
 				}
 			},
-			version: cw20.CurrentVersion(ctx),
+			version: cw20.CurrentVersion(testkeeper.EVMTestApp.EvmKeeper.IsLiveChain(ctx)),
 		},
 		{
 			name: "ERC721CW721Pointer prevents pointer to cw721 pointer",
@@ -113,6 +115,32 @@ func TestEVMtoCWPointers(t *testing.T) {
 			},
 			version: cw721.CurrentVersion,
 		},
+		{
+			name: "ERC1155CW1155Pointer prevents pointer to cw721 pointer",
+			getHandlers: func(k *evmkeeper.Keeper) *handlers {
+				return &handlers{
+					evmSetter:  k.SetERC1155CW1155Pointer,
+					evmGetter:  k.GetERC1155CW1155Pointer,
+					evmDeleter: k.DeleteERC1155CW1155Pointer,
+					cwSetter:   k.SetCW721ERC721Pointer,
+					cwGetter:   k.GetCW721ERC721Pointer,
+				}
+			},
+			version: cw1155.CurrentVersion,
+		},
+		{
+			name: "ERC1155CW1155Pointer prevents pointer to cw20 pointer",
+			getHandlers: func(k *evmkeeper.Keeper) *handlers {
+				return &handlers{
+					evmSetter:  k.SetERC1155CW1155Pointer,
+					evmGetter:  k.GetERC1155CW1155Pointer,
+					evmDeleter: k.DeleteERC1155CW1155Pointer,
+					cwSetter:   k.SetCW20ERC20Pointer,
+					cwGetter:   k.GetCW20ERC20Pointer,
+				}
+			},
+			version: cw1155.CurrentVersion,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -218,6 +246,17 @@ func TestCWtoEVMPointers(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "CW721ERC721Pointer prevents pointer to erc1155 pointer",
+			getHandlers: func(k *evmkeeper.Keeper) *handlers {
+				return &handlers{
+					cwSetter:  k.SetCW721ERC721Pointer,
+					cwGetter:  k.GetCW721ERC721Pointer,
+					evmSetter: k.SetERC1155CW1155Pointer,
+					evmGetter: k.GetERC1155CW1155Pointer,
+				}
+			},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -247,3 +286,34 @@ func TestCWtoEVMPointers(t *testing.T) {
 		})
 	}
 }
+
+func TestCW20NativePointer(t *testing.T) {
+	k := &testkeeper.EVMTestApp.EvmKeeper
+	ctx := testkeeper.EVMTestApp.GetContextForDeliverTx([]byte{}).WithBlockTime(time.Now())
+	cwAddress, evmAddress := testkeeper.MockAddressPair()
+
+	require.Nil(t, k.SetCW20NativePointer(ctx, "ufoo", cwAddress.String()))
+	addr, version, exists := k.GetCW20NativePointer(ctx, "ufoo")
+	require.True(t, exists)
+	require.Equal(t, cwAddress, addr)
+	require.Equal(t, cwnative.CurrentVersion, version)
+	token, version, exists := k.GetCW20NativePointee(ctx, cwAddress.String())
+	require.True(t, exists)
+	require.Equal(t, "ufoo", token)
+	require.Equal(t, cwnative.CurrentVersion, version)
+
+	// should not allow an ERC20 pointer to the CW20 pointer
+	require.Error(t, k.SetERC20CW20Pointer(ctx, cwAddress.String(), evmAddress), evmkeeper.ErrorPointerToPointerNotAllowed)
+
+	// pointers of other types are not pointers to native denoms
+	cwAddress2, evmAddress2 := testkeeper.MockAddressPair()
+	require.Nil(t, k.SetCW20ERC20Pointer(ctx, evmAddress2, cwAddress2.String()))
+	_, _, exists = k.GetCW20NativePointee(ctx, cwAddress2.String())
+	require.False(t, exists)
+
+	k.DeleteCW20NativePointer(ctx, "ufoo", cwnative.CurrentVersion)
+	_, _, exists = k.GetCW20NativePointer(ctx, "ufoo")
+	require.False(t, exists)
+	_, _, exists = k.GetCW20NativePointee(ctx, cwAddress.String())
+	require.False(t, exists)
+}
@@ -1,14 +1,20 @@
 package keeper
 
 import (
+	"encoding/json"
+	"fmt"
 	"math"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/kiichain/kiichain3/utils"
 	"github.com/kiichain/kiichain3/x/evm/artifacts"
+	"github.com/kiichain/kiichain3/x/evm/artifacts/cwnative"
+	"github.com/kiichain/kiichain3/x/evm/artifacts/erc1155"
+	artifactsutils "github.com/kiichain/kiichain3/x/evm/artifacts/utils"
 	"github.com/kiichain/kiichain3/x/evm/state"
 	"github.com/kiichain/kiichain3/x/evm/types"
 )
@@ -73,6 +79,16 @@ func (k *Keeper) UpsertERCCW721Pointer(
 	)
 }
 
+func (k *Keeper) UpsertERCCW1155Pointer(
+	ctx sdk.Context, evm *vm.EVM, cw1155Addr string, metadata utils.ERCMetadata,
+) (contractAddr common.Address, err error) {
+	return k.UpsertERCPointer(
+		ctx, evm, "cw1155", []interface{}{
+			cw1155Addr, metadata.Name, metadata.Symbol,
+		}, k.GetERC1155CW1155Pointer, k.SetERC1155CW1155Pointer,
+	)
+}
+
 func (k *Keeper) UpsertERCPointer(
 	ctx sdk.Context, evm *vm.EVM, typ string, args []interface{}, getter PointerGetter, setter PointerSetter,
 ) (contractAddr common.Address, err error) {
@@ -108,3 +124,71 @@ func (k *Keeper) UpsertERCPointer(
 		sdk.NewAttribute(types.AttributeKeyPointerAddress, contractAddr.Hex()), sdk.NewAttribute(types.AttributeKeyPointee, pointee)))
 	return
 }
+
+// StoreCWNativePointerCode uploads the CW20 contract that wraps native denoms
+// and records its code ID as the code of the current version.
+func (k *Keeper) StoreCWNativePointerCode(ctx sdk.Context, wasmCode []byte) (uint64, error) {
+	codeID, err := k.wasmKeeper.Create(ctx, k.AccountKeeper().GetModuleAddress(types.ModuleName), wasmCode, nil)
+	if err != nil {
+		return 0, err
+	}
+	prefix.NewStore(k.PrefixStore(ctx, types.PointerCWCodePrefix), types.PointerCW20NativePrefix).Set(
+		artifactsutils.GetVersionBz(cwnative.CurrentVersion),
+		artifactsutils.GetCodeIDBz(codeID),
+	)
+	return codeID, nil
+}
+
+// StoreCW1155PointerCode uploads the CW1155 contract that wraps ERC1155
+// contracts and records its code ID as the code of the current version.
+func (k *Keeper) StoreCW1155PointerCode(ctx sdk.Context, wasmCode []byte) (uint64, error) {
+	codeID, err := k.wasmKeeper.Create(ctx, k.AccountKeeper().GetModuleAddress(types.ModuleName), wasmCode, nil)
+	if err != nil {
+		return 0, err
+	}
+	prefix.NewStore(k.PrefixStore(ctx, types.PointerCWCodePrefix), types.PointerCW1155ERC1155Prefix).Set(
+		artifactsutils.GetVersionBz(erc1155.CurrentVersion),
+		artifactsutils.GetCodeIDBz(codeID),
+	)
+	return codeID, nil
+}
+
+// UpsertCWNativePointer instantiates a CW20 pointer for a native denom, or
+// migrates the existing one to the current code version.
+func (k *Keeper) UpsertCWNativePointer(
+	ctx sdk.Context, token string, metadata utils.ERCMetadata,
+) (contractAddr sdk.AccAddress, err error) {
+	codeID := k.GetStoredPointerCodeID(ctx, types.PointerType_NATIVE_CW20)
+	if codeID == 0 {
+		return nil, fmt.Errorf("no code stored for CW20 native pointer version %d", cwnative.CurrentVersion)
+	}
+	moduleAcct := k.AccountKeeper().GetModuleAddress(types.ModuleName)
+	existingAddr, _, exists := k.GetCW20NativePointer(ctx, token)
+	if exists {
+		contractAddr = existingAddr
+		bz, _ := json.Marshal(map[string]interface{}{})
+		_, err = k.wasmKeeper.Migrate(ctx, existingAddr, moduleAcct, codeID, bz)
+	} else {
+		bz, jerr := json.Marshal(map[string]interface{}{
+			"denom":    token,
+			"name":     metadata.Name,
+			"symbol":   metadata.Symbol,
+			"decimals": metadata.Decimals,
+		})
+		if jerr != nil {
+			return nil, jerr
+		}
+		contractAddr, _, err = k.wasmKeeper.Instantiate(ctx, codeID, moduleAcct, moduleAcct, bz, fmt.Sprintf("Pointer of %s", token), sdk.NewCoins())
+	}
+	if err != nil {
+		return
+	}
+	if err = k.SetCW20NativePointer(ctx, token, contractAddr.String()); err != nil {
+		return
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypePointerRegistered, sdk.NewAttribute(types.AttributeKeyPointerType, "native_cw20"),
+		sdk.NewAttribute(types.AttributeKeyPointerAddress, contractAddr.String()), sdk.NewAttribute(types.AttributeKeyPointee, token),
+		sdk.NewAttribute(types.AttributeKeyPointerVersion, fmt.Sprintf("%d", cwnative.CurrentVersion))))
+	return
+}
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"errors"
+	"os"
 	"testing"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/vm"
 	testkeeper "github.com/kiichain/kiichain3/testutil/keeper"
 	"github.com/kiichain/kiichain3/utils"
+	"github.com/kiichain/kiichain3/x/evm/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -121,3 +123,54 @@ func TestUpsertERC721Pointer(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, addr, newAddr)
 }
+
+func TestUpsertERC1155Pointer(t *testing.T) {
+	k := &testkeeper.EVMTestApp.EvmKeeper
+	ctx := testkeeper.EVMTestApp.GetContextForDeliverTx([]byte{}).WithBlockTime(time.Now())
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeterWithMultiplier(ctx))
+	var addr common.Address
+	err := k.RunWithOneOffEVMInstance(ctx, func(e *vm.EVM) error {
+		a, err := k.UpsertERCCW1155Pointer(ctx, e, "test", utils.ERCMetadata{
+			Name:   "test",
+			Symbol: "test",
+		})
+		addr = a
+		return err
+	}, func(s1, s2 string) {})
+	require.Nil(t, err)
+	require.NotEmpty(t, k.GetCode(ctx, addr))
+	var newAddr common.Address
+	err = k.RunWithOneOffEVMInstance(ctx, func(e *vm.EVM) error {
+		a, err := k.UpsertERCCW1155Pointer(ctx, e, "test", utils.ERCMetadata{
+			Name:   "test2",
+			Symbol: "test2",
+		})
+		newAddr = a
+		return err
+	}, func(s1, s2 string) {})
+	require.Nil(t, err)
+	require.Equal(t, addr, newAddr)
+	cwAddr, _, exists := k.GetCW1155Pointee(ctx, addr)
+	require.True(t, exists)
+	require.Equal(t, "test", cwAddr)
+}
+
+func TestUpsertCWNativePointer(t *testing.T) {
+	k, ctx := testkeeper.MockEVMKeeper()
+	metadata := utils.ERCMetadata{Name: "foo", Symbol: "FOO", Decimals: 6}
+	// no code stored yet
+	_, err := k.UpsertCWNativePointer(ctx, "ufoo", metadata)
+	require.NotNil(t, err)
+
+	code, err := os.ReadFile("../../../example/cosmwasm/echo/artifacts/echo.wasm")
+	require.Nil(t, err)
+	codeID, err := k.StoreCWNativePointerCode(ctx, code)
+	require.Nil(t, err)
+	require.Equal(t, codeID, k.GetStoredPointerCodeID(ctx, types.PointerType_NATIVE_CW20))
+
+	// the stored code does not accept the instantiate message of the pointer
+	_, err = k.UpsertCWNativePointer(ctx, "ufoo", metadata)
+	require.ErrorContains(t, err, "instantiate wasm contract failed")
+	_, _, exists := k.GetCW20NativePointer(ctx, "ufoo")
+	require.False(t, exists)
+}
@@ -1,8 +1,10 @@
 package keeper
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/iavl"
@@ -96,12 +98,18 @@ func (k *Keeper) FlushTransientReceipts(ctx sdk.Context) error {
 	var pairs []*iavl.KVPair
 	var changesets []*proto.NamedChangeSet
 	for ; iter.Valid(); iter.Next() {
+		if bytes.HasPrefix(iter.Key(), types.AddressTxDeletePrefix) {
+			pairs = append(pairs, &iavl.KVPair{Key: iter.Key()[len(types.AddressTxDeletePrefix):], Delete: true})
+			continue
+		}
 		kvPair := &iavl.KVPair{Key: iter.Key(), Value: iter.Value()}
 		pairs = append(pairs, kvPair)
 	}
 	if len(pairs) == 0 {
 		return nil
 	}
+	// deletions are keyed differently in the transient store
+	sort.SliceStable(pairs, func(i, j int) bool { return bytes.Compare(pairs[i].Key, pairs[j].Key) < 0 })
 	ncs := &proto.NamedChangeSet{
 		Name:      types.ReceiptStoreKey,
 		Changeset: iavl.ChangeSet{Pairs: pairs},
@@ -161,5 +169,6 @@ func (k *Keeper) WriteReceipt(
 
 	receipt.From = msg.From.Hex()
 
+	k.IndexAddressTxs(ctx, receipt)
 	return receipt, k.SetTransientReceipt(ctx, txHash, receipt)
 }
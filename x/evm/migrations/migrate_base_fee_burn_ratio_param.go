@@ -0,0 +1,13 @@
+package migrations
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kiichain/kiichain3/x/evm/keeper"
+	"github.com/kiichain/kiichain3/x/evm/types"
+)
+
+// MigrateBaseFeeBurnRatioParam sets the base fee burn ratio to its default.
+func MigrateBaseFeeBurnRatioParam(ctx sdk.Context, k *keeper.Keeper) error {
+	k.Paramstore.Set(ctx, types.KeyBaseFeeBurnRatio, types.DefaultBaseFeeBurnRatio)
+	return nil
+}
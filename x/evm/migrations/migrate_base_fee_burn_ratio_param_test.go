@@ -0,0 +1,21 @@
+package migrations_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	testkeeper "github.com/kiichain/kiichain3/testutil/keeper"
+	"github.com/kiichain/kiichain3/x/evm/migrations"
+	"github.com/kiichain/kiichain3/x/evm/types"
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestMigrateBaseFeeBurnRatioParam(t *testing.T) {
+	k := testkeeper.EVMTestApp.EvmKeeper
+	ctx := testkeeper.EVMTestApp.NewContext(false, tmtypes.Header{})
+	k.Paramstore.Set(ctx, types.KeyBaseFeeBurnRatio, sdk.OneDec())
+
+	require.NoError(t, migrations.MigrateBaseFeeBurnRatioParam(ctx, &k))
+	require.Equal(t, types.DefaultBaseFeeBurnRatio, k.GetBaseFeeBurnRatio(ctx))
+}
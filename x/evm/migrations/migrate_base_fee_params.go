@@ -0,0 +1,15 @@
+package migrations
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kiichain/kiichain3/x/evm/keeper"
+	"github.com/kiichain/kiichain3/x/evm/types"
+)
+
+// MigrateBaseFeeParams sets the base fee ceiling and the base fee history
+// retention to their defaults.
+func MigrateBaseFeeParams(ctx sdk.Context, k *keeper.Keeper) error {
+	k.Paramstore.Set(ctx, types.KeyMaxFeePerGas, types.DefaultMaxFeePerGas)
+	k.Paramstore.Set(ctx, types.KeyBaseFeeHistoryRetention, types.DefaultBaseFeeHistoryRetention)
+	return nil
+}
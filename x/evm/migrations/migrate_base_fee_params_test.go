@@ -0,0 +1,25 @@
+package migrations_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	testkeeper "github.com/kiichain/kiichain3/testutil/keeper"
+	"github.com/kiichain/kiichain3/x/evm/migrations"
+	"github.com/kiichain/kiichain3/x/evm/types"
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestMigrateBaseFeeParams(t *testing.T) {
+	k := testkeeper.EVMTestApp.EvmKeeper
+	ctx := testkeeper.EVMTestApp.NewContext(false, tmtypes.Header{})
+	k.Paramstore.Set(ctx, types.KeyMaxFeePerGas, sdk.NewDec(1))
+	k.Paramstore.Set(ctx, types.KeyBaseFeeHistoryRetention, uint64(1))
+
+	require.NoError(t, migrations.MigrateBaseFeeParams(ctx, &k))
+	require.Equal(t, types.DefaultMaxFeePerGas, k.GetMaximumFeePerGas(ctx))
+	require.Equal(t, types.DefaultBaseFeeHistoryRetention, k.GetBaseFeeHistoryRetention(ctx))
+	// other params are left untouched
+	require.Equal(t, types.DefaultParams().MinimumFeePerGas, k.GetMinimumFeePerGas(ctx))
+}
@@ -0,0 +1,19 @@
+package migrations
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kiichain/kiichain3/x/evm/config"
+	"github.com/kiichain/kiichain3/x/evm/keeper"
+	"github.com/kiichain/kiichain3/x/evm/types"
+)
+
+// MigrateChainIDParams sets the chain ID and live chain params to what the
+// hard-coded mapping of cosmos chain IDs used to resolve to, so that existing
+// networks keep their EVM chain ID.
+func MigrateChainIDParams(ctx sdk.Context, k *keeper.Keeper) error {
+	// only set the new params, since params added by later migrations don't
+	// exist yet and wouldn't pass validation
+	k.Paramstore.Set(ctx, types.KeyChainID, config.GetLegacyEVMChainID(ctx.ChainID()).Uint64())
+	k.Paramstore.Set(ctx, types.KeyLiveChain, config.IsLegacyLiveChainID(ctx.ChainID()))
+	return nil
+}
@@ -0,0 +1,28 @@
+package migrations_test
+
+import (
+	"testing"
+
+	testkeeper "github.com/kiichain/kiichain3/testutil/keeper"
+	"github.com/kiichain/kiichain3/x/evm/migrations"
+	"github.com/kiichain/kiichain3/x/evm/types"
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestMigrateChainIDParams(t *testing.T) {
+	k := testkeeper.EVMTestApp.EvmKeeper
+	ctx := testkeeper.EVMTestApp.NewContext(false, tmtypes.Header{}).WithChainID("pacific-1")
+	k.Paramstore.Set(ctx, types.KeyChainID, uint64(1))
+
+	require.NoError(t, migrations.MigrateChainIDParams(ctx, &k))
+	require.Equal(t, int64(1334), k.ChainID(ctx).Int64())
+	require.True(t, k.IsLiveChain(ctx))
+	// other params are left untouched
+	require.Equal(t, types.DefaultParams().TargetGasUsedPerBlock, k.GetTargetGasUsedPerBlock(ctx))
+
+	ctx = ctx.WithChainID("kiichain-devnet")
+	require.NoError(t, migrations.MigrateChainIDParams(ctx, &k))
+	require.Equal(t, int64(1336), k.ChainID(ctx).Int64())
+	require.False(t, k.IsLiveChain(ctx))
+}
@@ -221,6 +221,18 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	_ = cfg.RegisterMigration(types.ModuleName, 13, func(ctx sdk.Context) error {
 		return migrations.MigrateEip1559Params(ctx, am.keeper)
 	})
+
+	_ = cfg.RegisterMigration(types.ModuleName, 15, func(ctx sdk.Context) error {
+		return migrations.MigrateChainIDParams(ctx, am.keeper)
+	})
+
+	_ = cfg.RegisterMigration(types.ModuleName, 16, func(ctx sdk.Context) error {
+		return migrations.MigrateBaseFeeParams(ctx, am.keeper)
+	})
+
+	_ = cfg.RegisterMigration(types.ModuleName, 17, func(ctx sdk.Context) error {
+		return migrations.MigrateBaseFeeBurnRatioParam(ctx, am.keeper)
+	})
 }
 
 // RegisterInvariants registers the capability module's invariants.
@@ -258,7 +270,7 @@ func (am AppModule) ExportGenesisStream(ctx sdk.Context, cdc codec.JSONCodec) <-
 }
 
 // ConsensusVersion implements ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 15 }
+func (AppModule) ConsensusVersion() uint64 { return 18 }
 
 // BeginBlock executes all ABCI BeginBlock logic respective to the capability module.
 func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
@@ -286,6 +298,7 @@ func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 // EndBlock executes all ABCI EndBlock logic respective to the evm module. It
 // returns no validator updates.
 func (am AppModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.ValidatorUpdate {
+	am.keeper.RecordBaseFeePerGas(ctx)
 	newBaseFee := am.keeper.AdjustDynamicBaseFeePerGas(ctx, uint64(req.BlockGasUsed))
 	if newBaseFee != nil {
 		metrics.GaugeEvmBlockBaseFee(newBaseFee.TruncateInt().BigInt(), req.Height)
@@ -307,6 +320,7 @@ func (am AppModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.V
 	evmTxDeferredInfoList := am.keeper.GetAllEVMTxDeferredInfo(ctx)
 	denom := am.keeper.GetBaseDenom(ctx)
 	surplus := am.keeper.GetAnteSurplusSum(ctx)
+	baseFee := sdk.ZeroInt()
 	for _, deferredInfo := range evmTxDeferredInfoList {
 		txHash := common.BytesToHash(deferredInfo.TxHash)
 		if deferredInfo.Error != "" && txHash.Cmp(ethtypes.EmptyTxsHash) != 0 {
@@ -328,6 +342,7 @@ func (am AppModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.V
 			}
 		}
 		surplus = surplus.Add(deferredInfo.Surplus)
+		baseFee = baseFee.Add(deferredInfo.BaseFee)
 	}
 	if surplus.IsPositive() {
 		surplusUkii, surplusWei := state.SplitUkiiWeiAmount(surplus.BigInt())
@@ -341,8 +356,12 @@ func (am AppModule) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.V
 				ctx.Logger().Error("failed to send wei surplus of %s to EVM module account", surplusWei)
 			}
 		}
+		// the base fee is part of the surplus
+		am.keeper.DistributeBaseFee(ctx, sdk.MinInt(baseFee, surplus))
 	}
 	am.keeper.SetTxHashesOnHeight(ctx, ctx.BlockHeight(), utils.Filter(utils.Map(evmTxDeferredInfoList, func(i *types.DeferredInfo) common.Hash { return common.BytesToHash(i.TxHash) }), func(h common.Hash) bool { return h.Cmp(ethtypes.EmptyTxsHash) != 0 }))
 	am.keeper.SetBlockBloom(ctx, utils.Map(evmTxDeferredInfoList, func(i *types.DeferredInfo) ethtypes.Bloom { return ethtypes.BytesToBloom(i.TxBloom) }))
+	am.keeper.IndexBlockBloom(ctx, am.keeper.GetBlockBloom(ctx))
+	am.keeper.PruneAddressTxIndex(ctx)
 	return []abci.ValidatorUpdate{}
 }
@@ -79,7 +79,7 @@ func TestModuleExportGenesis(t *testing.T) {
 func TestConsensusVersion(t *testing.T) {
 	k, _ := testkeeper.MockEVMKeeper()
 	module := evm.NewAppModule(nil, k)
-	assert.Equal(t, uint64(15), module.ConsensusVersion())
+	assert.Equal(t, uint64(18), module.ConsensusVersion())
 }
 
 func TestABCI(t *testing.T) {
@@ -102,7 +102,7 @@ func TestABCI(t *testing.T) {
 	surplus, err := s.Finalize()
 	require.Nil(t, err)
 	require.Equal(t, sdk.ZeroInt(), surplus)
-	k.AppendToEvmTxDeferredInfo(ctx.WithTxIndex(1), ethtypes.Bloom{}, common.Hash{4}, surplus)
+	k.AppendToEvmTxDeferredInfo(ctx.WithTxIndex(1), ethtypes.Bloom{}, common.Hash{4}, surplus, sdk.ZeroInt())
 	// 3rd tx
 	s = state.NewDBImpl(ctx.WithTxIndex(3), k, false)
 	s.SubBalance(evmAddr2, big.NewInt(5000000000000), tracing.BalanceChangeUnspecified)
@@ -110,7 +110,7 @@ func TestABCI(t *testing.T) {
 	surplus, err = s.Finalize()
 	require.Nil(t, err)
 	require.Equal(t, sdk.ZeroInt(), surplus)
-	k.AppendToEvmTxDeferredInfo(ctx.WithTxIndex(3), ethtypes.Bloom{}, common.Hash{3}, surplus)
+	k.AppendToEvmTxDeferredInfo(ctx.WithTxIndex(3), ethtypes.Bloom{}, common.Hash{3}, surplus, sdk.ZeroInt())
 	k.SetTxResults([]*abci.ExecTxResult{{Code: 0}, {Code: 0}, {Code: 0}, {Code: 0}})
 	k.SetMsgs([]*types.MsgEVMTransaction{nil, {}, nil, {}})
 	m.EndBlock(ctx, abci.RequestEndBlock{})
@@ -126,7 +126,7 @@ func TestABCI(t *testing.T) {
 	surplus, err = s.Finalize()
 	require.Nil(t, err)
 	require.Equal(t, sdk.NewInt(1000000000000), surplus)
-	k.AppendToEvmTxDeferredInfo(ctx.WithTxIndex(2), ethtypes.Bloom{}, common.Hash{2}, surplus)
+	k.AppendToEvmTxDeferredInfo(ctx.WithTxIndex(2), ethtypes.Bloom{}, common.Hash{2}, surplus, sdk.ZeroInt())
 	k.SetTxResults([]*abci.ExecTxResult{{Code: 0}, {Code: 0}, {Code: 0}})
 	k.SetMsgs([]*types.MsgEVMTransaction{nil, nil, {}})
 	m.EndBlock(ctx, abci.RequestEndBlock{})
@@ -164,7 +164,7 @@ func TestABCI(t *testing.T) {
 	s.AddBalance(feeCollectorAddr, big.NewInt(1000000000000), tracing.BalanceChangeUnspecified)
 	surplus, err = s.Finalize()
 	require.Nil(t, err)
-	k.AppendToEvmTxDeferredInfo(ctx.WithTxIndex(2), ethtypes.Bloom{}, common.Hash{}, surplus)
+	k.AppendToEvmTxDeferredInfo(ctx.WithTxIndex(2), ethtypes.Bloom{}, common.Hash{}, surplus, sdk.ZeroInt())
 	k.SetTxResults([]*abci.ExecTxResult{{Code: 0}, {Code: 0}, {Code: 0}})
 	k.SetMsgs([]*types.MsgEVMTransaction{nil, nil, {}})
 	require.Equal(t, sdk.OneInt(), k.BankKeeper().SpendableCoins(ctx, coinbase).AmountOf("ukii"))
@@ -190,6 +190,45 @@ func TestAnteSurplus(t *testing.T) {
 	require.Equal(t, uint64(0), k.GetAnteSurplusSum(ctx).Uint64())
 }
 
+func TestBaseFeeBurn(t *testing.T) {
+	a := app.Setup(false, false)
+	k := a.EvmKeeper
+	ctx := a.GetContextForDeliverTx([]byte{})
+	params := k.GetParams(ctx)
+	params.BaseFeeBurnRatio = sdk.NewDecWithPrec(75, 2)
+	k.SetParams(ctx, params)
+	m := evm.NewAppModule(nil, &k)
+	// the base fee was paid with existing tokens
+	amt := sdk.NewCoins(sdk.NewCoin("ukii", sdk.NewInt(10)))
+	require.Nil(t, k.BankKeeper().MintCoins(ctx, types.ModuleName, amt))
+	require.Nil(t, k.BankKeeper().SendCoinsFromModuleToAccount(ctx, types.ModuleName, sdk.AccAddress([]byte("holder")), amt))
+	feeCollector := k.AccountKeeper().GetModuleAddress(authtypes.FeeCollectorName)
+	collected := k.BankKeeper().GetBalance(ctx, feeCollector, "ukii").Amount
+	supply := k.BankKeeper().GetSupply(ctx, "ukii").Amount
+
+	m.BeginBlock(ctx, abci.RequestBeginBlock{})
+	// the tx paid 5 ukii of gas fees in the ante handler, 1 of which was refunded
+	k.AddAnteSurplus(ctx, common.BytesToHash([]byte("1234")), sdk.NewInt(5_000_000_000_000))
+	k.AppendToEvmTxDeferredInfo(ctx.WithTxIndex(0), ethtypes.Bloom{}, common.Hash{1}, sdk.NewInt(-1_000_000_000_000), sdk.NewInt(4_000_000_000_000))
+	k.SetTxResults([]*abci.ExecTxResult{{Code: 0}})
+	k.SetMsgs([]*types.MsgEVMTransaction{{}})
+	m.EndBlock(ctx, abci.RequestEndBlock{})
+
+	require.Equal(t, sdk.NewInt(3), k.GetBurnedBaseFee(ctx))
+	require.Equal(t, collected.AddRaw(1), k.BankKeeper().GetBalance(ctx, feeCollector, "ukii").Amount)
+	require.True(t, k.BankKeeper().GetBalance(ctx, k.AccountKeeper().GetModuleAddress(types.ModuleName), "ukii").IsZero())
+	require.Equal(t, supply.SubRaw(3), k.BankKeeper().GetSupply(ctx, "ukii").Amount)
+	var found bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeBaseFee {
+			found = true
+			require.Equal(t, "3ukii", string(event.Attributes[0].Value))
+			require.Equal(t, "1ukii", string(event.Attributes[1].Value))
+		}
+	}
+	require.True(t, found)
+}
+
 // This test is just to make sure that the routes can be added without crashing
 func TestRoutesAddition(t *testing.T) {
 	k, _ := testkeeper.MockEVMKeeper()
@@ -3,6 +3,7 @@ package state
 import (
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 type Logs struct {
@@ -30,3 +31,13 @@ func (s *DBImpl) GetAllLogs() []*ethtypes.Log {
 func (s *DBImpl) GetLogs(common.Hash, uint64, common.Hash) []*ethtypes.Log {
 	return s.GetAllLogs()
 }
+
+// GetAllEvents returns the Cosmos events emitted since the DB was created,
+// excluding those of reverted snapshots.
+func (s *DBImpl) GetAllEvents() []abci.Event {
+	res := []abci.Event{}
+	for i := 1; i < len(s.snapshottedCtxs); i++ {
+		res = append(res, s.snapshottedCtxs[i].EventManager().ABCIEvents()...)
+	}
+	return append(res, s.ctx.EventManager().ABCIEvents()...)
+}
@@ -42,6 +42,7 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgAssociateContractAddress{}, "evm/MsgAssociateContractAddress", nil)
 	cdc.RegisterConcrete(&MsgInternalEVMCall{}, "evm/MsgInternalEVMCall", nil)
 	cdc.RegisterConcrete(&MsgInternalEVMDelegateCall{}, "evm/MsgInternalEVMDelegateCall", nil)
+	cdc.RegisterConcrete(&MsgInternalSendNative{}, "evm/MsgInternalSendNative", nil)
 
 }
 
@@ -66,6 +67,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgAssociate{},
 		&MsgInternalEVMCall{},
 		&MsgInternalEVMDelegateCall{},
+		&MsgInternalSendNative{},
 	)
 	// Register ethereum interfaces
 	registry.RegisterInterface(
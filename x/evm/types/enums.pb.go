@@ -23,11 +23,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type PointerType int32
 
 const (
-	PointerType_ERC20  PointerType = 0
-	PointerType_ERC721 PointerType = 1
-	PointerType_NATIVE PointerType = 2
-	PointerType_CW20   PointerType = 3
-	PointerType_CW721  PointerType = 4
+	PointerType_ERC20       PointerType = 0
+	PointerType_ERC721      PointerType = 1
+	PointerType_NATIVE      PointerType = 2
+	PointerType_CW20        PointerType = 3
+	PointerType_CW721       PointerType = 4
+	PointerType_CW1155      PointerType = 5
+	PointerType_NATIVE_CW20 PointerType = 6
+	PointerType_ERC1155     PointerType = 7
 )
 
 var PointerType_name = map[int32]string{
@@ -36,14 +39,20 @@ var PointerType_name = map[int32]string{
 	2: "NATIVE",
 	3: "CW20",
 	4: "CW721",
+	5: "CW1155",
+	6: "NATIVE_CW20",
+	7: "ERC1155",
 }
 
 var PointerType_value = map[string]int32{
-	"ERC20":  0,
-	"ERC721": 1,
-	"NATIVE": 2,
-	"CW20":   3,
-	"CW721":  4,
+	"ERC20":       0,
+	"ERC721":      1,
+	"NATIVE":      2,
+	"CW20":        3,
+	"CW721":       4,
+	"CW1155":      5,
+	"NATIVE_CW20": 6,
+	"ERC1155":     7,
 }
 
 func (x PointerType) String() string {
@@ -61,17 +70,19 @@ func init() {
 func init() { proto.RegisterFile("evm/enums.proto", fileDescriptor_9ba0923a26222f98) }
 
 var fileDescriptor_9ba0923a26222f98 = []byte{
-	// 184 bytes of a gzipped FileDescriptorProto
+	// 211 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x4f, 0x2d, 0xcb, 0xd5,
 	0x4f, 0xcd, 0x2b, 0xcd, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0xcb, 0xce, 0xcc,
-	0x4c, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x83, 0x31, 0x8c, 0xf5, 0x52, 0xcb, 0x72, 0xb5, 0x5c, 0xb9,
+	0x4c, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x83, 0x31, 0x8c, 0xf5, 0x52, 0xcb, 0x72, 0xb5, 0xf2, 0xb9,
 	0xb8, 0x03, 0xf2, 0x33, 0xf3, 0x4a, 0x52, 0x8b, 0x42, 0x2a, 0x0b, 0x52, 0x85, 0x38, 0xb9, 0x58,
 	0x5d, 0x83, 0x9c, 0x8d, 0x0c, 0x04, 0x18, 0x84, 0xb8, 0xb8, 0xd8, 0x5c, 0x83, 0x9c, 0xcd, 0x8d,
 	0x0c, 0x05, 0x18, 0x41, 0x6c, 0x3f, 0xc7, 0x10, 0xcf, 0x30, 0x57, 0x01, 0x26, 0x21, 0x0e, 0x2e,
-	0x16, 0xe7, 0x70, 0x23, 0x03, 0x01, 0x66, 0x90, 0x62, 0xe7, 0x70, 0x90, 0x02, 0x16, 0x27, 0xe7,
-	0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39,
-	0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xd2, 0x4c, 0xcf, 0x2c, 0xc9, 0x28,
-	0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x87, 0x59, 0x0d, 0x67, 0x18, 0xeb, 0x57, 0xe8, 0x83, 0x5c,
-	0x5a, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x76, 0xaa, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff,
-	0x93, 0x72, 0x46, 0xba, 0xbd, 0x00, 0x00, 0x00,
+	0x16, 0xe7, 0x70, 0x23, 0x03, 0x01, 0x66, 0x90, 0x62, 0xe7, 0x70, 0x90, 0x02, 0x16, 0x90, 0x02,
+	0xe7, 0x70, 0x43, 0x43, 0x53, 0x53, 0x01, 0x56, 0x21, 0x7e, 0x2e, 0x6e, 0x88, 0xe2, 0x78, 0xb0,
+	0x3a, 0x36, 0x21, 0x6e, 0x2e, 0x76, 0xd7, 0x20, 0x67, 0xb0, 0x2c, 0xbb, 0x93, 0xf3, 0x89, 0x47,
+	0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85,
+	0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x69, 0xa6, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9,
+	0x25, 0xe7, 0xe7, 0xea, 0xc3, 0x1c, 0x09, 0x67, 0x18, 0xeb, 0x57, 0xe8, 0x83, 0xfc, 0x54, 0x52,
+	0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0xf6, 0x94, 0x31, 0x60, 0x00, 0x29, 0x56, 0xe3, 0x98, 0xe7,
+	0x00, 0x00, 0x00,
 }
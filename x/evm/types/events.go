@@ -4,6 +4,7 @@ const (
 	EventTypeAddressAssociated = "address_associated"
 	EventTypePointerRegistered = "pointer_registered"
 	EventTypeSigner            = "signer"
+	EventTypeBaseFee           = "evm_base_fee"
 
 	AttributeKeyKiiAddress     = "kii_addr"
 	AttributeKeyEvmAddress     = "evm_addr"
@@ -11,4 +12,6 @@ const (
 	AttributeKeyPointee        = "pointee"
 	AttributeKeyPointerAddress = "pointer_address"
 	AttributeKeyPointerVersion = "pointer_version"
+	AttributeKeyBurned         = "burned"
+	AttributeKeyFeeCollected   = "fee_collected"
 )
@@ -57,14 +57,43 @@ var (
 
 	LegacyBlockBloomCutoffHeightKey = []byte{0x1a}
 	BaseFeePerGasPrefix             = []byte{0x1b}
+
+	BloomBitsPrefix = []byte{0x1c} // receipt store only
+
+	AddressTxPrefix       = []byte{0x1d} // receipt store only
+	AddressTxDeletePrefix = []byte{0x1e} // transient
+
+	BaseFeeHistoryPrefix = []byte{0x1f}
+	BurnedBaseFeeKey     = []byte{0x20}
+)
+
+var (
+	BloomBitsBlockBloomPrefix = []byte{0x0}
+	BloomBitsVectorPrefix     = []byte{0x1}
+	BloomBitsSectionPrefix    = []byte{0x2}
+)
+
+// BloomBitsSectionSize is the number of blocks covered by one section of the
+// bloom-bits index, i.e. the length in bits of every bit vector.
+const BloomBitsSectionSize = 4096
+
+// roles of an address in a tx, as recorded by the address tx index
+const (
+	AddressTxRoleFrom    byte = 0x0
+	AddressTxRoleTo      byte = 0x1
+	AddressTxRoleCreated byte = 0x2
+	AddressTxRoleLog     byte = 0x3
 )
 
 var (
-	PointerERC20NativePrefix = []byte{0x0}
-	PointerERC20CW20Prefix   = []byte{0x1}
-	PointerERC721CW721Prefix = []byte{0x2}
-	PointerCW20ERC20Prefix   = []byte{0x3}
-	PointerCW721ERC721Prefix = []byte{0x4}
+	PointerERC20NativePrefix   = []byte{0x0}
+	PointerERC20CW20Prefix     = []byte{0x1}
+	PointerERC721CW721Prefix   = []byte{0x2}
+	PointerCW20ERC20Prefix     = []byte{0x3}
+	PointerCW721ERC721Prefix   = []byte{0x4}
+	PointerERC1155CW1155Prefix = []byte{0x5}
+	PointerCW20NativePrefix    = []byte{0x6}
+	PointerCW1155ERC1155Prefix = []byte{0x7}
 )
 
 func EVMAddressToKiiAddressKey(evmAddress common.Address) []byte {
@@ -89,12 +118,60 @@ func BlockBloomKey(height int64) []byte {
 	return append(BlockBloomPrefix, bz...)
 }
 
+func BloomBitsBlockBloomKey(height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return append(append(BloomBitsPrefix, BloomBitsBlockBloomPrefix...), bz...)
+}
+
+func BloomBitsVectorKey(bit uint, section uint64) []byte {
+	bz := make([]byte, 10)
+	binary.BigEndian.PutUint16(bz, uint16(bit))
+	binary.BigEndian.PutUint64(bz[2:], section)
+	return append(append(BloomBitsPrefix, BloomBitsVectorPrefix...), bz...)
+}
+
+func BloomBitsSectionKey(section uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, section)
+	return append(append(BloomBitsPrefix, BloomBitsSectionPrefix...), bz...)
+}
+
+func AddressTxAddressPrefix(addr common.Address) []byte {
+	return append(AddressTxPrefix, addr[:]...)
+}
+
+// AddressTxPositionKey is the prefix of the address tx index entries of a tx,
+// which has one entry per role of the address in the tx.
+func AddressTxPositionKey(addr common.Address, height int64, txIndex uint32) []byte {
+	bz := make([]byte, 12)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	binary.BigEndian.PutUint32(bz[8:], txIndex)
+	return append(AddressTxAddressPrefix(addr), bz...)
+}
+
+func AddressTxKey(addr common.Address, height int64, txIndex uint32, role byte) []byte {
+	return append(AddressTxPositionKey(addr, height, txIndex), role)
+}
+
+// AddressTxDeleteKey marks an address tx index entry for deletion when the
+// transient store is flushed into the receipt store.
+func AddressTxDeleteKey(key []byte) []byte {
+	return append(append([]byte{}, AddressTxDeletePrefix...), key...)
+}
+
 func TxHashesKey(height int64) []byte {
 	bz := make([]byte, 8)
 	binary.BigEndian.PutUint64(bz, uint64(height))
 	return append(TxHashesPrefix, bz...)
 }
 
+func BaseFeeHistoryKey(height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return append(BaseFeeHistoryPrefix, bz...)
+}
+
 func PointerERC20NativeKey(token string) []byte {
 	return append(
 		append(PointerRegistryPrefix, PointerERC20NativePrefix...),
@@ -130,6 +207,27 @@ func PointerCW721ERC721Key(erc721Addr common.Address) []byte {
 	)
 }
 
+func PointerERC1155CW1155Key(cw1155Address string) []byte {
+	return append(
+		append(PointerRegistryPrefix, PointerERC1155CW1155Prefix...),
+		[]byte(cw1155Address)...,
+	)
+}
+
+func PointerCW1155ERC1155Key(erc1155Addr common.Address) []byte {
+	return append(
+		append(PointerRegistryPrefix, PointerCW1155ERC1155Prefix...),
+		erc1155Addr[:]...,
+	)
+}
+
+func PointerCW20NativeKey(token string) []byte {
+	return append(
+		append(PointerRegistryPrefix, PointerCW20NativePrefix...),
+		[]byte(token)...,
+	)
+}
+
 func PointerReverseRegistryKey(addr common.Address) []byte {
 	return append(PointerReverseRegistryPrefix, addr[:]...)
 }
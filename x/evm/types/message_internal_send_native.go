@@ -0,0 +1,40 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgInternalSendNative{}
+)
+
+func NewMessageInternalSendNative(fromContract string, from sdk.AccAddress, to sdk.AccAddress, amount sdk.Coin) *MsgInternalSendNative {
+	return &MsgInternalSendNative{
+		FromContract: fromContract,
+		FromAddress:  from.String(),
+		ToAddress:    to.String(),
+		Amount:       amount,
+	}
+}
+
+func (msg *MsgInternalSendNative) GetSigners() []sdk.AccAddress {
+	contractAddr, err := sdk.AccAddressFromBech32(msg.FromContract)
+	if err != nil {
+		return []sdk.AccAddress{}
+	}
+	return []sdk.AccAddress{contractAddr}
+}
+
+func (msg *MsgInternalSendNative) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FromAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.ToAddress); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid recipient address (%s)", err)
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+	return nil
+}
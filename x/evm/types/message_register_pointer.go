@@ -20,6 +20,14 @@ func NewMsgRegisterERC721Pointer(sender sdk.AccAddress, ercAddress common.Addres
 	return &MsgRegisterPointer{Sender: sender.String(), ErcAddress: ercAddress.Hex(), PointerType: PointerType_ERC721}
 }
 
+func NewMsgRegisterERC1155Pointer(sender sdk.AccAddress, ercAddress common.Address) *MsgRegisterPointer {
+	return &MsgRegisterPointer{Sender: sender.String(), ErcAddress: ercAddress.Hex(), PointerType: PointerType_ERC1155}
+}
+
+func NewMsgRegisterCW20NativePointer(sender sdk.AccAddress, token string) *MsgRegisterPointer {
+	return &MsgRegisterPointer{Sender: sender.String(), ErcAddress: token, PointerType: PointerType_NATIVE_CW20}
+}
+
 func (msg *MsgRegisterPointer) Route() string {
 	return RouterKey
 }
@@ -46,6 +54,11 @@ func (msg *MsgRegisterPointer) ValidateBasic() error {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
 	}
 
+	if msg.PointerType == PointerType_NATIVE_CW20 {
+		// the pointee of a CW20 pointer to a native token is a denom
+		return sdk.ValidateDenom(msg.ErcAddress)
+	}
+
 	if !common.IsHexAddress(msg.ErcAddress) {
 		return sdkerrors.ErrInvalidAddress
 	}
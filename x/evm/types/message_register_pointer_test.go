@@ -0,0 +1,21 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kiichain/kiichain3/x/evm/types"
+)
+
+func TestMessageRegisterCW20NativePointerValidate(t *testing.T) {
+	sender, err := sdk.AccAddressFromBech32("kii1v4mx6hmrda5kucnpwdjsqqqqqqqqqqqpkaxwqq")
+	require.Nil(t, err)
+	require.Nil(t, types.NewMsgRegisterCW20NativePointer(sender, "ufoo").ValidateBasic())
+	require.Nil(t, types.NewMsgRegisterCW20NativePointer(sender, "factory/kii1v4mx6hmrda5kucnpwdjsqqqqqqqqqqqpkaxwqq/foo").ValidateBasic())
+
+	// not a denom
+	require.Error(t, types.NewMsgRegisterCW20NativePointer(sender, "").ValidateBasic())
+	require.Error(t, types.NewMsgRegisterCW20NativePointer(sender, "1foo").ValidateBasic())
+}
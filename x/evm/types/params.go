@@ -6,6 +6,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/kiichain/kiichain3/x/evm/config"
 	"gopkg.in/yaml.v2"
 )
 
@@ -16,6 +17,11 @@ var (
 	KeyMaxDynamicBaseFeeUpwardAdjustment   = []byte("KeyMaxDynamicBaseFeeUpwardAdjustment")
 	KeyMaxDynamicBaseFeeDownwardAdjustment = []byte("KeyMaxDynamicBaseFeeDownwardAdjustment")
 	KeyTargetGasUsedPerBlock               = []byte("KeyTargetGasUsedPerBlock")
+	KeyChainID                             = []byte("KeyChainID")
+	KeyLiveChain                           = []byte("KeyLiveChain")
+	KeyMaxFeePerGas                        = []byte("KeyMaxFeePerGas")
+	KeyBaseFeeHistoryRetention             = []byte("KeyBaseFeeHistoryRetention")
+	KeyBaseFeeBurnRatio                    = []byte("KeyBaseFeeBurnRatio")
 	// deprecated
 	KeyBaseFeePerGas                          = []byte("KeyBaseFeePerGas")
 	KeyWhitelistedCwCodeHashesForDelegateCall = []byte("KeyWhitelistedCwCodeHashesForDelegateCall")
@@ -26,8 +32,9 @@ var DefaultPriorityNormalizer = sdk.NewDec(1)
 // DefaultBaseFeePerGas determines how much ukii per gas spent is
 // burnt rather than go to validators (similar to base fee on
 // Ethereum).
-var DefaultBaseFeePerGas = sdk.NewDec(0)         // used for static base fee, deprecated in favor of dynamic base fee
-var DefaultMinFeePerGas = sdk.NewDec(1000000000) // 1gwei
+var DefaultBaseFeePerGas = sdk.NewDec(0)            // used for static base fee, deprecated in favor of dynamic base fee
+var DefaultMinFeePerGas = sdk.NewDec(1000000000)    // 1gwei
+var DefaultMaxFeePerGas = sdk.NewDec(1000000000000) // 1000gwei
 var DefaultDeliverTxHookWasmGasLimit = uint64(300000)
 
 var DefaultWhitelistedCwCodeHashesForDelegateCall = generateDefaultWhitelistedCwCodeHashesForDelegateCall()
@@ -35,6 +42,10 @@ var DefaultWhitelistedCwCodeHashesForDelegateCall = generateDefaultWhitelistedCw
 var DefaultMaxDynamicBaseFeeUpwardAdjustment = sdk.NewDecWithPrec(189, 4)  // 1.89%
 var DefaultMaxDynamicBaseFeeDownwardAdjustment = sdk.NewDecWithPrec(39, 4) // .39%
 var DefaultTargetGasUsedPerBlock = uint64(250000)                          // 250k
+var DefaultChainID = uint64(config.DefaultChainID)
+var DefaultLiveChain = false
+var DefaultBaseFeeHistoryRetention = uint64(1024) // go-ethereum's max fee history
+var DefaultBaseFeeBurnRatio = sdk.ZeroDec()       // all of the base fee goes to the fee collector
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
@@ -52,6 +63,11 @@ func DefaultParams() Params {
 		DeliverTxHookWasmGasLimit:              DefaultDeliverTxHookWasmGasLimit,
 		WhitelistedCwCodeHashesForDelegateCall: DefaultWhitelistedCwCodeHashesForDelegateCall,
 		TargetGasUsedPerBlock:                  DefaultTargetGasUsedPerBlock,
+		ChainId:                                DefaultChainID,
+		LiveChain:                              DefaultLiveChain,
+		MaximumFeePerGas:                       DefaultMaxFeePerGas,
+		BaseFeeHistoryRetention:                DefaultBaseFeeHistoryRetention,
+		BaseFeeBurnRatio:                       DefaultBaseFeeBurnRatio,
 	}
 }
 
@@ -65,6 +81,11 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyWhitelistedCwCodeHashesForDelegateCall, &p.WhitelistedCwCodeHashesForDelegateCall, validateWhitelistedCwHashesForDelegateCall),
 		paramtypes.NewParamSetPair(KeyDeliverTxHookWasmGasLimit, &p.DeliverTxHookWasmGasLimit, validateDeliverTxHookWasmGasLimit),
 		paramtypes.NewParamSetPair(KeyTargetGasUsedPerBlock, &p.TargetGasUsedPerBlock, validateTargetGasUsedPerBlock),
+		paramtypes.NewParamSetPair(KeyChainID, &p.ChainId, validateChainID),
+		paramtypes.NewParamSetPair(KeyLiveChain, &p.LiveChain, validateLiveChain),
+		paramtypes.NewParamSetPair(KeyMaxFeePerGas, &p.MaximumFeePerGas, validateMaxFeePerGas),
+		paramtypes.NewParamSetPair(KeyBaseFeeHistoryRetention, &p.BaseFeeHistoryRetention, validateBaseFeeHistoryRetention),
+		paramtypes.NewParamSetPair(KeyBaseFeeBurnRatio, &p.BaseFeeBurnRatio, validateBaseFeeBurnRatio),
 	}
 }
 
@@ -84,6 +105,12 @@ func (p Params) Validate() error {
 	if p.MinimumFeePerGas.LT(p.BaseFeePerGas) {
 		return errors.New("minimum fee cannot be lower than base fee")
 	}
+	if err := validateMaxFeePerGas(p.MaximumFeePerGas); err != nil {
+		return err
+	}
+	if p.MaximumFeePerGas.LT(p.MinimumFeePerGas) {
+		return errors.New("maximum fee cannot be lower than minimum fee")
+	}
 	if err := validateBaseFeeAdjustment(p.MaxDynamicBaseFeeUpwardAdjustment); err != nil {
 		return fmt.Errorf("invalid max dynamic base fee upward adjustment: %s, err: %s", p.MaxDynamicBaseFeeUpwardAdjustment, err)
 	}
@@ -93,6 +120,15 @@ func (p Params) Validate() error {
 	if err := validateTargetGasUsedPerBlock(p.TargetGasUsedPerBlock); err != nil {
 		return err
 	}
+	if err := validateChainID(p.ChainId); err != nil {
+		return err
+	}
+	if err := validateBaseFeeHistoryRetention(p.BaseFeeHistoryRetention); err != nil {
+		return err
+	}
+	if err := validateBaseFeeBurnRatio(p.BaseFeeBurnRatio); err != nil {
+		return err
+	}
 	return validateWhitelistedCwHashesForDelegateCall(p.WhitelistedCwCodeHashesForDelegateCall)
 }
 
@@ -107,6 +143,25 @@ func validateTargetGasUsedPerBlock(i interface{}) error {
 	return nil
 }
 
+func validateChainID(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return errors.New("invalid chain id: must be greater than 0")
+	}
+	return nil
+}
+
+func validateLiveChain(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
 func validateBaseFeeAdjustment(i interface{}) error {
 	adjustment, ok := i.(sdk.Dec)
 	if !ok {
@@ -121,6 +176,20 @@ func validateBaseFeeAdjustment(i interface{}) error {
 	return nil
 }
 
+func validateBaseFeeBurnRatio(i interface{}) error {
+	ratio, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if ratio.IsNegative() {
+		return fmt.Errorf("negative base fee burn ratio: %s", ratio)
+	}
+	if ratio.GT(sdk.OneDec()) {
+		return fmt.Errorf("base fee burn ratio must be less than or equal to 1: %s", ratio)
+	}
+	return nil
+}
+
 func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
@@ -165,6 +234,30 @@ func validateMinFeePerGas(i interface{}) error {
 	return nil
 }
 
+func validateMaxFeePerGas(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if !v.IsPositive() {
+		return fmt.Errorf("nonpositive max fee per gas: %d", v)
+	}
+
+	return nil
+}
+
+func validateBaseFeeHistoryRetention(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("invalid base fee history retention: must be greater than 0, got %d", v)
+	}
+	return nil
+}
+
 func validateDeliverTxHookWasmGasLimit(i interface{}) error {
 	v, ok := i.(uint64)
 	if !ok {
@@ -49,6 +49,17 @@ type Params struct {
 	MaxDynamicBaseFeeUpwardAdjustment      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=max_dynamic_base_fee_upward_adjustment,json=maxDynamicBaseFeeUpwardAdjustment,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_dynamic_base_fee_upward_adjustment" yaml:"max_dynamic_base_fee_upward_adjustment"`
 	MaxDynamicBaseFeeDownwardAdjustment    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=max_dynamic_base_fee_downward_adjustment,json=maxDynamicBaseFeeDownwardAdjustment,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_dynamic_base_fee_downward_adjustment" yaml:"max_dynamic_base_fee_downward_adjustment"`
 	TargetGasUsedPerBlock                  uint64                                 `protobuf:"varint,12,opt,name=target_gas_used_per_block,json=targetGasUsedPerBlock,proto3" json:"target_gas_used_per_block,omitempty"`
+	// EIP-155 chain ID of the EVM, set at genesis and immutable afterwards
+	ChainId uint64 `protobuf:"varint,13,opt,name=chain_id,json=chainId,proto3" json:"chain_id" yaml:"chain_id"`
+	// set on production networks, where test-only overrides such as the test
+	// RPC namespace and pointer version overrides are disabled
+	LiveChain        bool                                   `protobuf:"varint,14,opt,name=live_chain,json=liveChain,proto3" json:"live_chain" yaml:"live_chain"`
+	MaximumFeePerGas github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=maximum_fee_per_gas,json=maximumFeePerGas,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maximum_fee_per_gas" yaml:"maximum_fee_per_gas"`
+	// number of blocks whose base fee is kept in the base fee history
+	BaseFeeHistoryRetention uint64 `protobuf:"varint,16,opt,name=base_fee_history_retention,json=baseFeeHistoryRetention,proto3" json:"base_fee_history_retention" yaml:"base_fee_history_retention"`
+	// share of the base fee of EVM txs that is burned, the rest goes to the fee
+	// collector
+	BaseFeeBurnRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,17,opt,name=base_fee_burn_ratio,json=baseFeeBurnRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_fee_burn_ratio" yaml:"base_fee_burn_ratio"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -104,6 +115,27 @@ func (m *Params) GetTargetGasUsedPerBlock() uint64 {
 	return 0
 }
 
+func (m *Params) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *Params) GetLiveChain() bool {
+	if m != nil {
+		return m.LiveChain
+	}
+	return false
+}
+
+func (m *Params) GetBaseFeeHistoryRetention() uint64 {
+	if m != nil {
+		return m.BaseFeeHistoryRetention
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "kiichain.kiichain3.evm.Params")
 }
@@ -111,45 +143,55 @@ func init() {
 func init() { proto.RegisterFile("evm/params.proto", fileDescriptor_9272f3679901ea94) }
 
 var fileDescriptor_9272f3679901ea94 = []byte{
-	// 604 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcf, 0x6a, 0xdb, 0x4e,
-	0x10, 0xc7, 0xbd, 0xbf, 0x84, 0xf0, 0x8b, 0x9a, 0x42, 0x50, 0xfa, 0xc7, 0xc9, 0x41, 0x4a, 0x55,
-	0x08, 0x2e, 0x34, 0xd6, 0xc1, 0x97, 0x92, 0x5b, 0x6c, 0x93, 0xe4, 0x50, 0x4a, 0x10, 0x0d, 0x85,
-	0x42, 0x59, 0xd6, 0xda, 0x89, 0xbc, 0xb5, 0xd6, 0x6b, 0x76, 0xd7, 0xb1, 0xdd, 0x07, 0x28, 0xf4,
-	0x50, 0x28, 0xa5, 0x87, 0x1e, 0xfb, 0x32, 0x85, 0x1c, 0x73, 0x2c, 0x85, 0x8a, 0x92, 0xd0, 0x4b,
-	0x8e, 0x7e, 0x82, 0xa2, 0x95, 0xe2, 0xa4, 0x89, 0x28, 0x76, 0x4f, 0x5a, 0x66, 0x3e, 0x33, 0x3b,
-	0xdf, 0x99, 0xd5, 0x58, 0xcb, 0x70, 0xc4, 0xfd, 0x1e, 0x91, 0x84, 0xab, 0x6a, 0x4f, 0x0a, 0x2d,
-	0xec, 0x7b, 0x1d, 0xc6, 0xc2, 0x36, 0x61, 0xdd, 0xea, 0xc5, 0xa1, 0x56, 0x85, 0x23, 0xbe, 0x76,
-	0x27, 0x12, 0x91, 0x30, 0x88, 0x9f, 0x9e, 0x32, 0xda, 0x3b, 0x59, 0xb4, 0x16, 0xf6, 0x4d, 0xb8,
-	0xfd, 0x09, 0x59, 0x2b, 0x3d, 0xc9, 0x84, 0x64, 0x7a, 0x84, 0xbb, 0x42, 0x72, 0x12, 0xb3, 0x37,
-	0x20, 0xcb, 0xff, 0xad, 0xa3, 0xca, 0x62, 0x3d, 0x3c, 0x4e, 0xdc, 0xd2, 0xf7, 0xc4, 0xdd, 0x88,
-	0x98, 0x6e, 0xf7, 0x5b, 0xd5, 0x50, 0x70, 0x3f, 0x14, 0x8a, 0x0b, 0x95, 0x7f, 0x36, 0x15, 0xed,
-	0xf8, 0x7a, 0xd4, 0x03, 0x55, 0x6d, 0x42, 0x78, 0x9e, 0xb8, 0x45, 0xc9, 0xc6, 0x89, 0xbb, 0x36,
-	0x22, 0x3c, 0xde, 0xf2, 0x0a, 0x9c, 0x5e, 0x60, 0x5f, 0x58, 0x9f, 0x4d, 0x8c, 0xf6, 0x5b, 0x64,
-	0x2d, 0xb7, 0x88, 0x02, 0x7c, 0x08, 0x80, 0x7b, 0x20, 0x71, 0x44, 0x54, 0x79, 0xce, 0xd4, 0xf4,
-	0x6a, 0xe6, 0x9a, 0x6e, 0x64, 0x1a, 0x27, 0xee, 0xfd, 0xac, 0xa0, 0xeb, 0x1e, 0x2f, 0xb8, 0x9d,
-	0x9a, 0x76, 0x00, 0xf6, 0x41, 0xee, 0x12, 0x65, 0x7f, 0x44, 0xd6, 0x0a, 0x67, 0x5d, 0xc6, 0xfb,
-	0xfc, 0x8f, 0x5a, 0xe6, 0xff, 0xb5, 0x3f, 0x05, 0xc9, 0x2e, 0xfb, 0x53, 0xe0, 0xf4, 0x82, 0xe5,
-	0xdc, 0x7a, 0x59, 0xd4, 0x57, 0x64, 0x3d, 0x1e, 0xb4, 0x99, 0x86, 0x98, 0x29, 0x0d, 0x14, 0x87,
-	0x03, 0x1c, 0x0a, 0x0a, 0xb8, 0x4d, 0x54, 0x1b, 0x14, 0x3e, 0x14, 0x12, 0x53, 0x88, 0x21, 0x22,
-	0x1a, 0x70, 0x48, 0xe2, 0xb8, 0xfc, 0xff, 0xfa, 0x5c, 0x65, 0xa9, 0x1e, 0x9d, 0x27, 0xee, 0x4c,
-	0x71, 0xe3, 0xc4, 0xad, 0x65, 0x85, 0xcd, 0x12, 0xe5, 0x05, 0x1b, 0x57, 0xf0, 0xc6, 0xa0, 0x21,
-	0x28, 0xec, 0x19, 0x76, 0x47, 0xc8, 0x66, 0x4e, 0x36, 0x48, 0x1c, 0xdb, 0xdb, 0x96, 0x43, 0x21,
-	0x66, 0x47, 0x20, 0xb1, 0x1e, 0xe2, 0xb6, 0x10, 0x1d, 0x3c, 0x20, 0x8a, 0xa7, 0xb2, 0x71, 0xcc,
-	0x38, 0xd3, 0xe5, 0xc5, 0x75, 0x54, 0x99, 0x0f, 0x56, 0x73, 0xea, 0xf9, 0x70, 0x4f, 0x88, 0xce,
-	0x0b, 0xa2, 0xf8, 0x2e, 0x51, 0x4f, 0x53, 0xc0, 0xfe, 0x81, 0xac, 0x0d, 0x4e, 0x86, 0x98, 0x8e,
-	0xba, 0x84, 0xb3, 0x10, 0x4f, 0x06, 0xda, 0xef, 0x0d, 0x88, 0xa4, 0x98, 0xd0, 0xd7, 0x7d, 0xa5,
-	0x39, 0x74, 0x75, 0xd9, 0x32, 0x23, 0x7b, 0x87, 0x66, 0x9e, 0xd9, 0x94, 0x17, 0x8c, 0x13, 0x77,
-	0x33, 0x1f, 0xe3, 0x54, 0xbc, 0x17, 0x3c, 0xe0, 0x64, 0xd8, 0xcc, 0xb8, 0x7a, 0xf6, 0xea, 0x0e,
-	0x0c, 0xb4, 0x3d, 0x61, 0xec, 0x5f, 0xc8, 0xaa, 0x14, 0xa6, 0xa3, 0x62, 0xd0, 0xbd, 0xae, 0xf0,
-	0x96, 0x51, 0xf8, 0x7e, 0x76, 0x85, 0x53, 0x5f, 0x31, 0x4e, 0x5c, 0xff, 0x2f, 0x1a, 0x0b, 0x22,
-	0xbc, 0xe0, 0xe1, 0x0d, 0x95, 0xcd, 0x1c, 0xbb, 0xa2, 0xf3, 0x89, 0xb5, 0xaa, 0x89, 0x8c, 0x40,
-	0x9b, 0xe1, 0xf7, 0x15, 0x50, 0xf3, 0x03, 0xb4, 0x62, 0x11, 0x76, 0xca, 0x4b, 0xe6, 0x15, 0xdc,
-	0xcd, 0x80, 0x5d, 0xa2, 0x0e, 0x14, 0xd0, 0x7d, 0x90, 0xf5, 0xd4, 0xb9, 0x35, 0xff, 0xf9, 0x8b,
-	0x5b, 0xaa, 0x37, 0x8e, 0x4f, 0x1d, 0x74, 0x72, 0xea, 0xa0, 0x9f, 0xa7, 0x0e, 0xfa, 0x70, 0xe6,
-	0x94, 0x4e, 0xce, 0x9c, 0xd2, 0xb7, 0x33, 0xa7, 0xf4, 0xf2, 0xd1, 0x95, 0x2e, 0x5c, 0x2c, 0xc7,
-	0xc9, 0xa1, 0xe6, 0x0f, 0xfd, 0x74, 0x99, 0x9a, 0x66, 0xb4, 0x16, 0xcc, 0x7a, 0xac, 0xfd, 0x0e,
-	0x00, 0x00, 0xff, 0xff, 0x8c, 0x54, 0x53, 0x84, 0x60, 0x05, 0x00, 0x00,
+	// 768 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcf, 0x6b, 0xe3, 0x46,
+	0x14, 0xb6, 0x9a, 0x90, 0x26, 0x6a, 0xd2, 0x38, 0x4a, 0xdb, 0x28, 0x39, 0x78, 0x1c, 0x05, 0x82,
+	0x0b, 0x8d, 0x7d, 0xf0, 0xa5, 0xe4, 0x16, 0xd9, 0x24, 0x29, 0x94, 0x12, 0x44, 0x43, 0xa1, 0x50,
+	0x86, 0xb1, 0x34, 0xb1, 0xa7, 0xd6, 0x68, 0xcc, 0xcc, 0x38, 0x96, 0x7b, 0xea, 0xa9, 0xd0, 0x43,
+	0xa1, 0x94, 0x1e, 0x7a, 0xec, 0x3f, 0xb3, 0x90, 0x63, 0x8e, 0xcb, 0xc2, 0x8a, 0x25, 0x61, 0x2f,
+	0x3e, 0xea, 0xbe, 0xb0, 0x68, 0x24, 0xff, 0x48, 0x2c, 0x42, 0x9c, 0x93, 0x9f, 0xde, 0xfb, 0xde,
+	0x9b, 0xef, 0xbd, 0x37, 0xfe, 0x46, 0x2f, 0xe2, 0x6b, 0x5a, 0xeb, 0x21, 0x8e, 0xa8, 0xa8, 0xf6,
+	0x38, 0x93, 0xcc, 0xf8, 0xaa, 0x4b, 0x88, 0xdb, 0x41, 0x24, 0xa8, 0x8e, 0x8d, 0x7a, 0x15, 0x5f,
+	0xd3, 0xbd, 0x2f, 0xda, 0xac, 0xcd, 0x14, 0xa4, 0x96, 0x58, 0x29, 0xda, 0xfa, 0xb0, 0xa1, 0xaf,
+	0x5c, 0xa8, 0x74, 0xe3, 0x5f, 0x4d, 0xdf, 0xee, 0x71, 0xc2, 0x38, 0x91, 0x43, 0x18, 0x30, 0x4e,
+	0x91, 0x4f, 0x7e, 0xc3, 0xdc, 0xfc, 0xa4, 0xac, 0x55, 0xd6, 0x6c, 0xf7, 0x26, 0x02, 0x85, 0x37,
+	0x11, 0x38, 0x6c, 0x13, 0xd9, 0xe9, 0xb7, 0xaa, 0x2e, 0xa3, 0x35, 0x97, 0x09, 0xca, 0x44, 0xf6,
+	0x73, 0x24, 0xbc, 0x6e, 0x4d, 0x0e, 0x7b, 0x58, 0x54, 0x9b, 0xd8, 0x1d, 0x45, 0x20, 0xaf, 0x58,
+	0x1c, 0x81, 0xbd, 0x21, 0xa2, 0xfe, 0xb1, 0x95, 0x13, 0xb4, 0x1c, 0x63, 0xec, 0xfd, 0x61, 0xe2,
+	0x34, 0xfe, 0xd0, 0xf4, 0x62, 0x0b, 0x09, 0x0c, 0xaf, 0x30, 0x86, 0x3d, 0xcc, 0x61, 0x1b, 0x09,
+	0x73, 0x49, 0x71, 0xfa, 0x65, 0x61, 0x4e, 0x73, 0x95, 0xe2, 0x08, 0xec, 0xa4, 0x84, 0x1e, 0x47,
+	0x2c, 0x67, 0x23, 0x71, 0x9d, 0x62, 0x7c, 0x81, 0xf9, 0x19, 0x12, 0xc6, 0x3f, 0x9a, 0xbe, 0x4d,
+	0x49, 0x40, 0x68, 0x9f, 0x3e, 0xe0, 0xb2, 0xfc, 0xd2, 0xf9, 0xe4, 0x14, 0x9b, 0xce, 0x27, 0x27,
+	0x68, 0x39, 0xc5, 0xcc, 0x3b, 0x25, 0xf5, 0x4a, 0xd3, 0xbf, 0x19, 0x74, 0x88, 0xc4, 0x3e, 0x11,
+	0x12, 0x7b, 0xd0, 0x1d, 0x40, 0x97, 0x79, 0x18, 0x76, 0x90, 0xe8, 0x60, 0x01, 0xaf, 0x18, 0x87,
+	0x1e, 0xf6, 0x71, 0x1b, 0x49, 0x0c, 0x5d, 0xe4, 0xfb, 0xe6, 0x6a, 0x79, 0xa9, 0xb2, 0x6e, 0xb7,
+	0x47, 0x11, 0x58, 0x28, 0x2f, 0x8e, 0x40, 0x3d, 0x25, 0xb6, 0x48, 0x96, 0xe5, 0x1c, 0xce, 0xc0,
+	0x1b, 0x83, 0x06, 0xf3, 0xf0, 0xb9, 0xc2, 0x9e, 0x32, 0xde, 0xcc, 0x90, 0x0d, 0xe4, 0xfb, 0xc6,
+	0x89, 0x5e, 0xf2, 0xb0, 0x4f, 0xae, 0x31, 0x87, 0x32, 0x84, 0x1d, 0xc6, 0xba, 0x70, 0x80, 0x04,
+	0x4d, 0xda, 0x86, 0x3e, 0xa1, 0x44, 0x9a, 0x6b, 0x65, 0xad, 0xb2, 0xec, 0xec, 0x66, 0xa8, 0x1f,
+	0xc3, 0x73, 0xc6, 0xba, 0x3f, 0x21, 0x41, 0xcf, 0x90, 0xf8, 0x3e, 0x01, 0x18, 0x6f, 0x35, 0xfd,
+	0x90, 0xa2, 0x10, 0x7a, 0xc3, 0x00, 0x51, 0xe2, 0xc2, 0xc9, 0x42, 0xfb, 0xbd, 0x01, 0xe2, 0x1e,
+	0x44, 0xde, 0xaf, 0x7d, 0x21, 0x29, 0x0e, 0xa4, 0xa9, 0xab, 0x95, 0xfd, 0xa9, 0x2d, 0xbc, 0xb3,
+	0x67, 0x1e, 0x10, 0x47, 0xe0, 0x28, 0x5b, 0xe3, 0xb3, 0xf0, 0x96, 0xb3, 0x4f, 0x51, 0xd8, 0x4c,
+	0x71, 0x76, 0x7a, 0xeb, 0x2e, 0x15, 0xe8, 0x64, 0x82, 0x31, 0xde, 0x6b, 0x7a, 0x25, 0xb7, 0x9c,
+	0xc7, 0x06, 0xc1, 0xe3, 0x0e, 0x3f, 0x53, 0x1d, 0xfe, 0xb5, 0x78, 0x87, 0xcf, 0x3e, 0x22, 0x8e,
+	0x40, 0xed, 0x89, 0x1e, 0x73, 0x32, 0x2c, 0xe7, 0x60, 0xae, 0xcb, 0x66, 0x06, 0x9b, 0xe9, 0xf3,
+	0x5b, 0x7d, 0x57, 0x22, 0xde, 0xc6, 0x52, 0x2d, 0xbf, 0x2f, 0xb0, 0xa7, 0xfe, 0x00, 0x2d, 0x9f,
+	0xb9, 0x5d, 0x73, 0x5d, 0xdd, 0x82, 0x2f, 0x53, 0xc0, 0x19, 0x12, 0x97, 0x02, 0x7b, 0x17, 0x98,
+	0xdb, 0x49, 0xd0, 0x38, 0xd6, 0x57, 0x95, 0xe0, 0x41, 0xe2, 0x99, 0x1b, 0x09, 0xd0, 0x06, 0xa3,
+	0x08, 0x4c, 0x7c, 0x71, 0x04, 0x36, 0x53, 0xc6, 0x63, 0x8f, 0xe5, 0x7c, 0xaa, 0xcc, 0xef, 0x3c,
+	0xc3, 0xd6, 0xf5, 0xe4, 0x62, 0x41, 0xf5, 0x6d, 0x7e, 0x5e, 0xd6, 0x2a, 0xab, 0xf6, 0xc1, 0x28,
+	0x02, 0x33, 0xde, 0x38, 0x02, 0x5b, 0x69, 0xfe, 0xd4, 0x67, 0x39, 0x6b, 0xc9, 0x47, 0x23, 0xb1,
+	0x53, 0x85, 0x40, 0xe1, 0x9c, 0x42, 0x6c, 0xbe, 0x58, 0x21, 0x50, 0xf8, 0x84, 0x42, 0xa0, 0x30,
+	0x4f, 0x21, 0x50, 0xf8, 0x50, 0x21, 0x7e, 0xd7, 0xf4, 0xbd, 0xc9, 0x56, 0x3a, 0x44, 0x48, 0xc6,
+	0x87, 0x90, 0x63, 0x89, 0x03, 0x49, 0x58, 0x60, 0x16, 0xd5, 0x9c, 0x1a, 0xa3, 0x08, 0x3c, 0x81,
+	0x8a, 0x23, 0xb0, 0xff, 0x48, 0x25, 0xe7, 0x30, 0x96, 0xb3, 0x93, 0xe9, 0xe5, 0x79, 0x1a, 0x72,
+	0xc6, 0x11, 0x35, 0x97, 0x49, 0x62, 0xab, 0xcf, 0x03, 0xc8, 0x91, 0x24, 0xcc, 0xdc, 0x7a, 0xe9,
+	0x5c, 0x72, 0x8a, 0x4d, 0xe7, 0x92, 0x13, 0xb4, 0x9c, 0x62, 0xc6, 0xcd, 0xee, 0xf3, 0xc0, 0x49,
+	0x5c, 0xc7, 0xcb, 0xff, 0xfd, 0x0f, 0x0a, 0x76, 0xe3, 0xe6, 0xae, 0xa4, 0xdd, 0xde, 0x95, 0xb4,
+	0x77, 0x77, 0x25, 0xed, 0xef, 0xfb, 0x52, 0xe1, 0xf6, 0xbe, 0x54, 0x78, 0x7d, 0x5f, 0x2a, 0xfc,
+	0xfc, 0xf5, 0x0c, 0x9d, 0xf1, 0x4b, 0x3a, 0x31, 0xea, 0xb5, 0xb0, 0x96, 0xbc, 0xbc, 0x8a, 0x55,
+	0x6b, 0x45, 0xbd, 0xa5, 0xf5, 0x8f, 0x03, 0x00, 0x79, 0xff, 0xde, 0x1b, 0x8d, 0x07, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -172,6 +214,50 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.BaseFeeBurnRatio.Size()
+		i -= size
+		if _, err := m.BaseFeeBurnRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if m.BaseFeeHistoryRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BaseFeeHistoryRetention))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	{
+		size := m.MaximumFeePerGas.Size()
+		i -= size
+		if _, err := m.MaximumFeePerGas.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if m.LiveChain {
+		i--
+		if m.LiveChain {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x68
+	}
 	if m.TargetGasUsedPerBlock != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.TargetGasUsedPerBlock))
 		i--
@@ -283,6 +369,19 @@ func (m *Params) Size() (n int) {
 	if m.TargetGasUsedPerBlock != 0 {
 		n += 1 + sovParams(uint64(m.TargetGasUsedPerBlock))
 	}
+	if m.ChainId != 0 {
+		n += 1 + sovParams(uint64(m.ChainId))
+	}
+	if m.LiveChain {
+		n += 2
+	}
+	l = m.MaximumFeePerGas.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.BaseFeeHistoryRetention != 0 {
+		n += 2 + sovParams(uint64(m.BaseFeeHistoryRetention))
+	}
+	l = m.BaseFeeBurnRatio.Size()
+	n += 2 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -561,6 +660,132 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiveChain", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LiveChain = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumFeePerGas", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaximumFeePerGas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeHistoryRetention", wireType)
+			}
+			m.BaseFeeHistoryRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeHistoryRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeBurnRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFeeBurnRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
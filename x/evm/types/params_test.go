@@ -19,6 +19,11 @@ func TestDefaultParams(t *testing.T) {
 		MaxDynamicBaseFeeUpwardAdjustment:      types.DefaultMaxDynamicBaseFeeUpwardAdjustment,
 		MaxDynamicBaseFeeDownwardAdjustment:    types.DefaultMaxDynamicBaseFeeDownwardAdjustment,
 		TargetGasUsedPerBlock:                  types.DefaultTargetGasUsedPerBlock,
+		ChainId:                                types.DefaultChainID,
+		LiveChain:                              types.DefaultLiveChain,
+		MaximumFeePerGas:                       types.DefaultMaxFeePerGas,
+		BaseFeeHistoryRetention:                types.DefaultBaseFeeHistoryRetention,
+		BaseFeeBurnRatio:                       types.DefaultBaseFeeBurnRatio,
 	}, types.DefaultParams())
 	require.Nil(t, types.DefaultParams().Validate())
 }
@@ -97,3 +102,36 @@ func TestValidateParamsValidDeliverTxHookWasmGasLimit(t *testing.T) {
 	err := params.Validate()
 	require.NoError(t, err)
 }
+
+func TestValidateParamsInvalidChainID(t *testing.T) {
+	params := types.DefaultParams()
+	params.ChainId = 0
+
+	err := params.Validate()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid chain id")
+}
+
+func TestValidateParamsInvalidMaxFeePerGas(t *testing.T) {
+	params := types.DefaultParams()
+	params.MaximumFeePerGas = sdk.NewDec(0)
+	require.ErrorContains(t, params.Validate(), "nonpositive max fee per gas")
+
+	params.MaximumFeePerGas = params.MinimumFeePerGas.Sub(sdk.OneDec())
+	require.ErrorContains(t, params.Validate(), "maximum fee cannot be lower than minimum fee")
+}
+
+func TestValidateParamsInvalidBaseFeeHistoryRetention(t *testing.T) {
+	params := types.DefaultParams()
+	params.BaseFeeHistoryRetention = 0
+	require.ErrorContains(t, params.Validate(), "invalid base fee history retention")
+}
+
+func TestValidateParamsInvalidBaseFeeBurnRatio(t *testing.T) {
+	params := types.DefaultParams()
+	params.BaseFeeBurnRatio = sdk.NewDec(-1)
+	require.ErrorContains(t, params.Validate(), "negative base fee burn ratio")
+
+	params.BaseFeeBurnRatio = sdk.NewDecWithPrec(11, 1)
+	require.ErrorContains(t, params.Validate(), "base fee burn ratio must be less than or equal to 1")
+}
@@ -6,6 +6,9 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -636,6 +639,357 @@ func (m *QueryPointeeResponse) GetExists() bool {
 	return false
 }
 
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11c0d37eed5339f7, []int{12}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11c0d37eed5339f7, []int{13}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+type QueryBaseFeeRequest struct {
+	// height of the block, or 0 for the base fee of the next block
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryBaseFeeRequest) Reset()         { *m = QueryBaseFeeRequest{} }
+func (m *QueryBaseFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeRequest) ProtoMessage()    {}
+func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11c0d37eed5339f7, []int{14}
+}
+func (m *QueryBaseFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeRequest.Merge(m, src)
+}
+func (m *QueryBaseFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseFeeRequest proto.InternalMessageInfo
+
+func (m *QueryBaseFeeRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type QueryBaseFeeResponse struct {
+	BaseFeePerGas github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=base_fee_per_gas,json=baseFeePerGas,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_fee_per_gas"`
+	Height        int64                                  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryBaseFeeResponse) Reset()         { *m = QueryBaseFeeResponse{} }
+func (m *QueryBaseFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeResponse) ProtoMessage()    {}
+func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11c0d37eed5339f7, []int{15}
+}
+func (m *QueryBaseFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeResponse.Merge(m, src)
+}
+func (m *QueryBaseFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseFeeResponse proto.InternalMessageInfo
+
+func (m *QueryBaseFeeResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type QueryFeeHistoryRequest struct {
+	BlockCount uint64 `protobuf:"varint,1,opt,name=block_count,json=blockCount,proto3" json:"block_count,omitempty"`
+	// last block of the history, or 0 for the latest block
+	LastBlock int64 `protobuf:"varint,2,opt,name=last_block,json=lastBlock,proto3" json:"last_block,omitempty"`
+}
+
+func (m *QueryFeeHistoryRequest) Reset()         { *m = QueryFeeHistoryRequest{} }
+func (m *QueryFeeHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeHistoryRequest) ProtoMessage()    {}
+func (*QueryFeeHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11c0d37eed5339f7, []int{16}
+}
+func (m *QueryFeeHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeHistoryRequest.Merge(m, src)
+}
+func (m *QueryFeeHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryFeeHistoryRequest) GetBlockCount() uint64 {
+	if m != nil {
+		return m.BlockCount
+	}
+	return 0
+}
+
+func (m *QueryFeeHistoryRequest) GetLastBlock() int64 {
+	if m != nil {
+		return m.LastBlock
+	}
+	return 0
+}
+
+type QueryFeeHistoryResponse struct {
+	OldestBlock int64 `protobuf:"varint,1,opt,name=oldest_block,json=oldestBlock,proto3" json:"oldest_block,omitempty"`
+	// base fees of the blocks from the oldest block, followed by the base fee
+	// of the block after the last one
+	BaseFeePerGas []github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,rep,name=base_fee_per_gas,json=baseFeePerGas,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_fee_per_gas"`
+}
+
+func (m *QueryFeeHistoryResponse) Reset()         { *m = QueryFeeHistoryResponse{} }
+func (m *QueryFeeHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeHistoryResponse) ProtoMessage()    {}
+func (*QueryFeeHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11c0d37eed5339f7, []int{17}
+}
+func (m *QueryFeeHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeHistoryResponse.Merge(m, src)
+}
+func (m *QueryFeeHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryFeeHistoryResponse) GetOldestBlock() int64 {
+	if m != nil {
+		return m.OldestBlock
+	}
+	return 0
+}
+
+type QueryBurnedBaseFeeRequest struct {
+}
+
+func (m *QueryBurnedBaseFeeRequest) Reset()         { *m = QueryBurnedBaseFeeRequest{} }
+func (m *QueryBurnedBaseFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnedBaseFeeRequest) ProtoMessage()    {}
+func (*QueryBurnedBaseFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11c0d37eed5339f7, []int{18}
+}
+func (m *QueryBurnedBaseFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnedBaseFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnedBaseFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnedBaseFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnedBaseFeeRequest.Merge(m, src)
+}
+func (m *QueryBurnedBaseFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnedBaseFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnedBaseFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnedBaseFeeRequest proto.InternalMessageInfo
+
+type QueryBurnedBaseFeeResponse struct {
+	// total base fee of EVM txs burned so far
+	Burned types.Coin `protobuf:"bytes,1,opt,name=burned,proto3" json:"burned"`
+}
+
+func (m *QueryBurnedBaseFeeResponse) Reset()         { *m = QueryBurnedBaseFeeResponse{} }
+func (m *QueryBurnedBaseFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnedBaseFeeResponse) ProtoMessage()    {}
+func (*QueryBurnedBaseFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11c0d37eed5339f7, []int{19}
+}
+func (m *QueryBurnedBaseFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnedBaseFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnedBaseFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnedBaseFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnedBaseFeeResponse.Merge(m, src)
+}
+func (m *QueryBurnedBaseFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnedBaseFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnedBaseFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnedBaseFeeResponse proto.InternalMessageInfo
+
+func (m *QueryBurnedBaseFeeResponse) GetBurned() types.Coin {
+	if m != nil {
+		return m.Burned
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*QueryKiiAddressByEVMAddressRequest)(nil), "kiichain.kiichain3.evm.QueryKiiAddressByEVMAddressRequest")
 	proto.RegisterType((*QueryKiiAddressByEVMAddressResponse)(nil), "kiichain.kiichain3.evm.QueryKiiAddressByEVMAddressResponse")
@@ -649,53 +1003,86 @@ func init() {
 	proto.RegisterType((*QueryPointerVersionResponse)(nil), "kiichain.kiichain3.evm.QueryPointerVersionResponse")
 	proto.RegisterType((*QueryPointeeRequest)(nil), "kiichain.kiichain3.evm.QueryPointeeRequest")
 	proto.RegisterType((*QueryPointeeResponse)(nil), "kiichain.kiichain3.evm.QueryPointeeResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "kiichain.kiichain3.evm.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "kiichain.kiichain3.evm.QueryParamsResponse")
+	proto.RegisterType((*QueryBaseFeeRequest)(nil), "kiichain.kiichain3.evm.QueryBaseFeeRequest")
+	proto.RegisterType((*QueryBaseFeeResponse)(nil), "kiichain.kiichain3.evm.QueryBaseFeeResponse")
+	proto.RegisterType((*QueryFeeHistoryRequest)(nil), "kiichain.kiichain3.evm.QueryFeeHistoryRequest")
+	proto.RegisterType((*QueryFeeHistoryResponse)(nil), "kiichain.kiichain3.evm.QueryFeeHistoryResponse")
+	proto.RegisterType((*QueryBurnedBaseFeeRequest)(nil), "kiichain.kiichain3.evm.QueryBurnedBaseFeeRequest")
+	proto.RegisterType((*QueryBurnedBaseFeeResponse)(nil), "kiichain.kiichain3.evm.QueryBurnedBaseFeeResponse")
 }
 
 func init() { proto.RegisterFile("evm/query.proto", fileDescriptor_11c0d37eed5339f7) }
 
 var fileDescriptor_11c0d37eed5339f7 = []byte{
-	// 655 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0xcf, 0x4e, 0xd4, 0x50,
-	0x14, 0xc6, 0xe9, 0x88, 0xfc, 0x39, 0x20, 0x26, 0x57, 0x1d, 0xc6, 0x02, 0x05, 0x4b, 0x4c, 0x30,
-	0x6a, 0x9b, 0xc0, 0x4e, 0xdd, 0x08, 0xc1, 0xc4, 0x18, 0x13, 0xad, 0xca, 0xc2, 0xcd, 0xa4, 0xb4,
-	0x47, 0xb8, 0x81, 0xe9, 0x2d, 0xbd, 0x77, 0x06, 0x66, 0xab, 0x2f, 0x40, 0xe2, 0x0b, 0xf8, 0x10,
-	0x26, 0xbe, 0x82, 0x4b, 0x12, 0x37, 0x2e, 0x0d, 0xf8, 0x20, 0xa6, 0xb7, 0xb7, 0xd3, 0x16, 0x4a,
-	0x67, 0xc6, 0xe8, 0xae, 0xe7, 0xce, 0x39, 0xdf, 0xf9, 0xdd, 0x73, 0xda, 0x2f, 0x03, 0xd7, 0xb1,
-	0xd3, 0xb2, 0x0f, 0xda, 0x18, 0x75, 0xad, 0x30, 0x62, 0x82, 0x91, 0xfa, 0x1e, 0xa5, 0xde, 0xae,
-	0x4b, 0x03, 0x2b, 0x7d, 0x58, 0xb3, 0xb0, 0xd3, 0xd2, 0xe7, 0x77, 0x18, 0xdb, 0xd9, 0x47, 0xdb,
-	0x0d, 0xa9, 0xed, 0x06, 0x01, 0x13, 0xae, 0xa0, 0x2c, 0xe0, 0x49, 0x95, 0x2e, 0x65, 0x30, 0x68,
-	0xb7, 0xd4, 0x81, 0xb9, 0x09, 0xe6, 0xeb, 0x58, 0xf5, 0x05, 0xa5, 0x4f, 0x7d, 0x3f, 0x42, 0xce,
-	0xd7, 0xbb, 0x9b, 0x5b, 0x2f, 0xd5, 0xb3, 0x83, 0x07, 0x6d, 0xe4, 0x82, 0x2c, 0xc2, 0x14, 0x76,
-	0x5a, 0x4d, 0x37, 0x39, 0x6d, 0x68, 0x4b, 0xda, 0xca, 0xa4, 0x03, 0xd8, 0x69, 0xa9, 0x3c, 0xf3,
-	0x03, 0x2c, 0x57, 0xca, 0xf0, 0x90, 0x05, 0x1c, 0x63, 0x9d, 0x3d, 0x4a, 0xcf, 0xeb, 0xec, 0xf5,
-	0x8a, 0x88, 0x01, 0xe0, 0x72, 0xce, 0x3c, 0xea, 0x0a, 0xf4, 0x1b, 0xb5, 0x25, 0x6d, 0x65, 0xc2,
-	0xc9, 0x9d, 0xf4, 0x70, 0x33, 0xed, 0xf5, 0x5c, 0xcf, 0x1c, 0x6e, 0x65, 0x9b, 0x1e, 0xee, 0x65,
-	0x32, 0x19, 0x6e, 0xe5, 0xb5, 0xfb, 0xe2, 0x3e, 0x81, 0xba, 0xec, 0xf3, 0x26, 0xde, 0x82, 0xb7,
-	0xe1, 0xee, 0xef, 0xa7, 0x88, 0x04, 0x46, 0x7d, 0x57, 0xb8, 0x52, 0x73, 0xda, 0x91, 0xcf, 0x64,
-	0x06, 0x6a, 0x82, 0x49, 0x95, 0x49, 0xa7, 0x26, 0x98, 0xf9, 0x10, 0x66, 0x2f, 0x54, 0x2b, 0xb2,
-	0x92, 0x72, 0xf3, 0x10, 0x6e, 0xc8, 0xf4, 0x57, 0x8c, 0x06, 0x02, 0xa3, 0xb4, 0xd3, 0x33, 0x98,
-	0x0e, 0x93, 0x93, 0xa6, 0xe8, 0x86, 0x28, 0x4b, 0x66, 0x56, 0x97, 0xad, 0xf2, 0xf7, 0xc7, 0x52,
-	0xd5, 0x6f, 0xbb, 0x21, 0x3a, 0x53, 0x61, 0x16, 0x90, 0x06, 0x8c, 0x27, 0x21, 0x2a, 0xc4, 0x34,
-	0x34, 0xb7, 0xe1, 0x66, 0xb1, 0xb1, 0x82, 0xec, 0x55, 0x44, 0x6a, 0x74, 0x69, 0x18, 0xff, 0xd2,
-	0xc1, 0x88, 0x53, 0x16, 0x48, 0xad, 0x6b, 0x4e, 0x1a, 0x92, 0x3a, 0x8c, 0xe1, 0x11, 0xe5, 0x82,
-	0x37, 0xae, 0xc8, 0x69, 0xaa, 0xc8, 0xf4, 0x41, 0xcf, 0xf7, 0xd8, 0x4a, 0xd2, 0xff, 0xf1, 0x1d,
-	0xcd, 0x77, 0x30, 0x57, 0xda, 0x25, 0xbb, 0x50, 0x8a, 0xad, 0x15, 0xb1, 0xe7, 0x01, 0xbc, 0xc3,
-	0xa6, 0xc7, 0x7c, 0x6c, 0xd2, 0xe4, 0x45, 0x18, 0x75, 0x26, 0xbc, 0xc3, 0x0d, 0xe6, 0xe3, 0x73,
-	0xff, 0xdc, 0x66, 0xf0, 0xbf, 0x6d, 0x26, 0x2a, 0x6e, 0x26, 0x3a, 0xb7, 0x19, 0xbc, 0xb8, 0x19,
-	0x2c, 0x6e, 0x06, 0x87, 0xdf, 0xcc, 0xea, 0xb7, 0x71, 0xb8, 0x2a, 0x9b, 0x90, 0xaf, 0x1a, 0xd4,
-	0xcb, 0x0d, 0x80, 0x3c, 0xba, 0xec, 0x52, 0xfd, 0xcd, 0x47, 0x7f, 0xfc, 0x57, 0xb5, 0xc9, 0x4d,
-	0xcd, 0x3b, 0x1f, 0x7f, 0xfc, 0xfe, 0x5c, 0x9b, 0x23, 0xb7, 0xed, 0xb4, 0xd6, 0x8e, 0x2d, 0x30,
-	0x67, 0x0f, 0x12, 0xbb, 0xdc, 0x08, 0xfa, 0x60, 0x57, 0x9a, 0x50, 0x1f, 0xec, 0x6a, 0xe7, 0xb9,
-	0x0c, 0x3b, 0xe7, 0x46, 0xe4, 0x58, 0x03, 0xc8, 0x9c, 0x81, 0x58, 0x95, 0xed, 0x2e, 0x18, 0x90,
-	0x6e, 0x0f, 0x9c, 0x5f, 0x8d, 0xc4, 0x65, 0x66, 0xd3, 0x8b, 0x19, 0x3e, 0x69, 0x30, 0xae, 0xde,
-	0x52, 0x72, 0xbf, 0x52, 0xbf, 0xe8, 0x51, 0xfa, 0x83, 0xc1, 0x92, 0x15, 0xc9, 0x82, 0x24, 0x99,
-	0x25, 0xb7, 0x8a, 0x24, 0xa9, 0xb9, 0x7c, 0xd1, 0x60, 0xa6, 0xf8, 0x01, 0x93, 0xd5, 0x41, 0xf4,
-	0x8b, 0x9e, 0xa2, 0xaf, 0x0d, 0x55, 0xa3, 0xd0, 0xee, 0x4a, 0xb4, 0x45, 0xb2, 0x50, 0x8a, 0xd6,
-	0x4c, 0xbf, 0xa5, 0x6c, 0x50, 0x38, 0xd0, 0xa0, 0x70, 0x98, 0x41, 0xe1, 0x60, 0x83, 0xc2, 0xf5,
-	0x8d, 0xef, 0xa7, 0x86, 0x76, 0x72, 0x6a, 0x68, 0xbf, 0x4e, 0x0d, 0xed, 0xf8, 0xcc, 0x18, 0x39,
-	0x39, 0x33, 0x46, 0x7e, 0x9e, 0x19, 0x23, 0xef, 0xef, 0xed, 0x50, 0xb1, 0xdb, 0xde, 0xb6, 0x3c,
-	0xd6, 0xca, 0x4a, 0x7b, 0x0d, 0xed, 0x23, 0x29, 0x13, 0x7b, 0x16, 0xdf, 0x1e, 0x93, 0xff, 0x23,
-	0xd6, 0xfe, 0x04, 0x00, 0x00, 0xff, 0xff, 0x76, 0x66, 0x26, 0x11, 0xa1, 0x08, 0x00, 0x00,
+	// 1045 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xdf, 0x6f, 0xdb, 0xd4,
+	0x17, 0xaf, 0xd3, 0x7e, 0xd3, 0xf5, 0xf4, 0xc7, 0x77, 0xba, 0x0b, 0x69, 0xea, 0xb6, 0x6e, 0xe7,
+	0x09, 0x34, 0xd8, 0x6a, 0xab, 0xe9, 0x03, 0x12, 0xdb, 0x0b, 0x09, 0x2b, 0x20, 0x84, 0x34, 0x3c,
+	0x36, 0x10, 0x2f, 0x96, 0x63, 0x9f, 0x25, 0x56, 0x12, 0xdf, 0xcc, 0xd7, 0x49, 0x97, 0x27, 0x24,
+	0x40, 0xe2, 0x75, 0x12, 0xe2, 0x11, 0x89, 0x3f, 0x82, 0x3f, 0x62, 0x8f, 0x93, 0x78, 0x41, 0x3c,
+	0x4c, 0xa8, 0xe5, 0x2f, 0xe0, 0x2f, 0x40, 0xbe, 0xf7, 0x3a, 0xb1, 0x9b, 0xd4, 0x49, 0x0b, 0x3c,
+	0xc5, 0xf7, 0xf8, 0x9c, 0xcf, 0xf9, 0x9c, 0x73, 0xae, 0xcf, 0x47, 0x81, 0xff, 0xe3, 0xa0, 0x6b,
+	0x3e, 0xeb, 0x63, 0x38, 0x34, 0x7a, 0x21, 0x8d, 0x28, 0x29, 0xb7, 0x7d, 0xdf, 0x6d, 0x39, 0x7e,
+	0x60, 0x24, 0x0f, 0x47, 0x06, 0x0e, 0xba, 0xea, 0x4e, 0x93, 0xd2, 0x66, 0x07, 0x4d, 0xa7, 0xe7,
+	0x9b, 0x4e, 0x10, 0xd0, 0xc8, 0x89, 0x7c, 0x1a, 0x30, 0x11, 0xa5, 0x96, 0x9a, 0xb4, 0x49, 0xf9,
+	0xa3, 0x19, 0x3f, 0x49, 0xab, 0xe6, 0x52, 0xd6, 0xa5, 0xcc, 0x6c, 0x38, 0x0c, 0xcd, 0xc1, 0x61,
+	0x03, 0x23, 0xe7, 0xd0, 0x74, 0xa9, 0x1f, 0xc8, 0xf7, 0x3c, 0x39, 0x06, 0xfd, 0x6e, 0x02, 0x73,
+	0x3d, 0x36, 0xf4, 0x9c, 0xd0, 0x49, 0x2c, 0xfa, 0x03, 0xd0, 0x3f, 0x8b, 0xd9, 0x7d, 0xe2, 0xfb,
+	0xef, 0x7b, 0x5e, 0x88, 0x8c, 0xd5, 0x86, 0x0f, 0x9e, 0x7c, 0x2a, 0x9f, 0x2d, 0x7c, 0xd6, 0x47,
+	0x16, 0x91, 0x3d, 0x58, 0xc5, 0x41, 0xd7, 0x76, 0x84, 0xb5, 0xa2, 0xec, 0x2b, 0xb7, 0x57, 0x2c,
+	0xc0, 0x41, 0x57, 0xfa, 0xe9, 0x4f, 0xe1, 0x56, 0x2e, 0x0c, 0xeb, 0xd1, 0x80, 0x61, 0x8c, 0xd3,
+	0xf6, 0xfd, 0xf3, 0x38, 0xed, 0x51, 0x10, 0xd1, 0x00, 0x1c, 0xc6, 0xa8, 0xeb, 0x3b, 0x11, 0x7a,
+	0x95, 0xc2, 0xbe, 0x72, 0xfb, 0x9a, 0x95, 0xb2, 0x8c, 0xe8, 0x8e, 0xb1, 0x6b, 0xa9, 0x9c, 0x29,
+	0xba, 0xb9, 0x69, 0x46, 0x74, 0x2f, 0x82, 0x19, 0xd3, 0xcd, 0x2d, 0x7b, 0x26, 0xdd, 0xfb, 0x50,
+	0xe6, 0x79, 0x1e, 0xc5, 0xd3, 0x74, 0xeb, 0x4e, 0xa7, 0x93, 0x50, 0x24, 0xb0, 0xe4, 0x39, 0x91,
+	0xc3, 0x31, 0xd7, 0x2c, 0xfe, 0x4c, 0x36, 0xa0, 0x10, 0x51, 0x8e, 0xb2, 0x62, 0x15, 0x22, 0xaa,
+	0x1f, 0xc0, 0xe6, 0x44, 0xb4, 0x64, 0x36, 0x25, 0x5c, 0x3f, 0x81, 0x1b, 0xdc, 0xfd, 0x21, 0xf5,
+	0x83, 0x08, 0xc3, 0x24, 0xd3, 0x31, 0xac, 0xf5, 0x84, 0xc5, 0x8e, 0x86, 0x3d, 0xe4, 0x21, 0x1b,
+	0xd5, 0x5b, 0xc6, 0xf4, 0x7b, 0x68, 0xc8, 0xe8, 0xcf, 0x87, 0x3d, 0xb4, 0x56, 0x7b, 0xe3, 0x03,
+	0xa9, 0xc0, 0xb2, 0x38, 0xa2, 0xa4, 0x98, 0x1c, 0xf5, 0x06, 0x94, 0xb2, 0x89, 0x25, 0xc9, 0x51,
+	0x44, 0x28, 0x5b, 0x97, 0x1c, 0xe3, 0x37, 0x03, 0x0c, 0x99, 0x4f, 0x03, 0x8e, 0xb5, 0x6e, 0x25,
+	0x47, 0x52, 0x86, 0x22, 0x3e, 0xf7, 0x59, 0xc4, 0x2a, 0x8b, 0xbc, 0x9b, 0xf2, 0xa4, 0x7b, 0xa0,
+	0xa6, 0x73, 0x3c, 0x11, 0xee, 0xff, 0x72, 0x8d, 0xfa, 0x63, 0xd8, 0x9e, 0x9a, 0x65, 0x5c, 0x50,
+	0x42, 0x5b, 0xc9, 0xd2, 0xde, 0x01, 0x70, 0x4f, 0x6c, 0x97, 0x7a, 0x68, 0xfb, 0xe2, 0x22, 0x2c,
+	0x59, 0xd7, 0xdc, 0x93, 0x3a, 0xf5, 0xf0, 0x63, 0xef, 0xdc, 0x64, 0xf0, 0x3f, 0x9b, 0x4c, 0x98,
+	0x9d, 0x4c, 0x78, 0x6e, 0x32, 0x38, 0x39, 0x19, 0xcc, 0x4e, 0x06, 0xaf, 0x30, 0x99, 0x12, 0x10,
+	0x91, 0x83, 0xaf, 0x15, 0x59, 0x9b, 0xfe, 0x08, 0x6e, 0x64, 0xac, 0x32, 0xf1, 0x7d, 0x28, 0x8a,
+	0xf5, 0xc3, 0xf3, 0xae, 0x56, 0xb5, 0x0b, 0x8b, 0xe5, 0x5e, 0xb5, 0xa5, 0x97, 0xaf, 0xf7, 0x16,
+	0x2c, 0x19, 0xa3, 0x1f, 0x48, 0xd0, 0x9a, 0xc3, 0xf0, 0x78, 0xdc, 0xc7, 0x32, 0x14, 0x5b, 0xe8,
+	0x37, 0x5b, 0x11, 0x07, 0x5d, 0xb4, 0xe4, 0x49, 0xff, 0x5e, 0x81, 0x52, 0xd6, 0x5f, 0xb2, 0xf8,
+	0x02, 0xae, 0xc7, 0x2b, 0xd3, 0x7e, 0x8a, 0x68, 0xf7, 0x30, 0xb4, 0x9b, 0x8e, 0xfc, 0xb8, 0x6b,
+	0x46, 0x9c, 0xef, 0xf7, 0xd7, 0x7b, 0x6f, 0x35, 0xfd, 0xa8, 0xd5, 0x6f, 0x18, 0x2e, 0xed, 0x9a,
+	0x72, 0xc9, 0x8a, 0x9f, 0x03, 0xe6, 0xb5, 0xcd, 0x78, 0x5a, 0xcc, 0xf8, 0x00, 0x5d, 0x6b, 0xbd,
+	0x21, 0xa0, 0x1f, 0x62, 0xf8, 0xa1, 0xc3, 0x52, 0x4c, 0x0a, 0x19, 0x26, 0x5f, 0xca, 0x3d, 0x70,
+	0x8c, 0xf8, 0x91, 0xcf, 0x22, 0x1a, 0x0e, 0x53, 0xab, 0xaa, 0xd1, 0xa1, 0x6e, 0xdb, 0x76, 0x69,
+	0x3f, 0x10, 0x05, 0x2c, 0x59, 0xc0, 0x4d, 0xf5, 0xd8, 0x42, 0x76, 0x01, 0x3a, 0x0e, 0x8b, 0x6c,
+	0x6e, 0x92, 0xb0, 0x2b, 0xb1, 0xa5, 0x16, 0x1b, 0xf4, 0x1f, 0x15, 0xd8, 0x9c, 0x80, 0x96, 0x65,
+	0xde, 0x84, 0x35, 0xda, 0xf1, 0x70, 0x14, 0x2c, 0xba, 0xb3, 0x2a, 0x6c, 0x3c, 0x7c, 0x6a, 0x27,
+	0x0a, 0xfb, 0x8b, 0xff, 0xb8, 0x13, 0xfa, 0x36, 0x6c, 0x89, 0xd6, 0xf7, 0xc3, 0x00, 0xbd, 0xec,
+	0xc0, 0xf4, 0xc7, 0xa0, 0x4e, 0x7b, 0x29, 0x69, 0xbf, 0x0b, 0xc5, 0x06, 0x7f, 0x21, 0xef, 0xc8,
+	0x96, 0x21, 0x12, 0x1a, 0x71, 0x06, 0x43, 0xca, 0x9c, 0x51, 0xa7, 0x7e, 0x90, 0x5c, 0x0f, 0xe1,
+	0x5e, 0xfd, 0x6b, 0x15, 0xfe, 0xc7, 0x71, 0xc9, 0x2f, 0x0a, 0x94, 0xa7, 0x4b, 0x11, 0x79, 0xef,
+	0xa2, 0x1b, 0x37, 0x5b, 0x06, 0xd5, 0x7b, 0x57, 0x8a, 0x15, 0x65, 0xe9, 0x37, 0xbf, 0xf9, 0xf5,
+	0xcf, 0x1f, 0x0a, 0xdb, 0x64, 0xcb, 0x4c, 0x62, 0xcd, 0x58, 0x8d, 0x53, 0x42, 0xc5, 0x69, 0x4f,
+	0x97, 0xa4, 0x19, 0xb4, 0x73, 0xe5, 0x50, 0xbd, 0x77, 0xa5, 0xd8, 0x7c, 0xda, 0x29, 0x5d, 0x24,
+	0x2f, 0x14, 0x80, 0xb1, 0x46, 0x11, 0x23, 0x37, 0xdd, 0x84, 0x14, 0xaa, 0xe6, 0xdc, 0xfe, 0xf9,
+	0x94, 0x18, 0xf7, 0xb4, 0xdd, 0x98, 0xc3, 0xb7, 0x0a, 0x2c, 0xcb, 0x7d, 0x49, 0xee, 0xe4, 0xe2,
+	0x67, 0xd5, 0x52, 0xbd, 0x3b, 0x9f, 0xb3, 0x64, 0xb2, 0xcb, 0x99, 0x6c, 0x92, 0x37, 0xb2, 0x4c,
+	0x12, 0x99, 0xfb, 0x59, 0x81, 0x8d, 0xac, 0x94, 0x90, 0xea, 0x3c, 0xf8, 0x59, 0x75, 0x53, 0x8f,
+	0x2e, 0x15, 0x23, 0xa9, 0xbd, 0xc9, 0xa9, 0xed, 0x91, 0xdd, 0xa9, 0xd4, 0xec, 0x64, 0xab, 0x8f,
+	0x1b, 0x85, 0x73, 0x35, 0x0a, 0x2f, 0xd3, 0x28, 0x9c, 0xaf, 0x51, 0x48, 0xbe, 0x86, 0xa2, 0x58,
+	0xf8, 0xe4, 0x9d, 0x7c, 0xd8, 0xb4, 0xc6, 0xa8, 0x77, 0xe6, 0xf2, 0x95, 0x0c, 0x76, 0x38, 0x83,
+	0x32, 0x29, 0x9d, 0x63, 0x20, 0xd2, 0x7e, 0xa7, 0xc0, 0xb2, 0xdc, 0x43, 0x33, 0xda, 0x90, 0x5d,
+	0x65, 0xea, 0xdd, 0xf9, 0x9c, 0x25, 0x09, 0x8d, 0x93, 0xa8, 0x90, 0x72, 0x96, 0x44, 0xb2, 0x82,
+	0xf9, 0x97, 0x34, 0x5e, 0xe4, 0x33, 0xbe, 0xa4, 0x09, 0x31, 0x51, 0xcd, 0xb9, 0xfd, 0xf3, 0xbf,
+	0xa4, 0x58, 0x0d, 0x5a, 0x92, 0xc3, 0x4f, 0x0a, 0xac, 0x67, 0xf6, 0x34, 0x39, 0xcc, 0x2f, 0x79,
+	0xca, 0xc2, 0x57, 0xab, 0x97, 0x09, 0xc9, 0xbf, 0xc0, 0x62, 0xd7, 0xdb, 0x49, 0xcb, 0x6a, 0xf5,
+	0x97, 0xa7, 0x9a, 0xf2, 0xea, 0x54, 0x53, 0xfe, 0x38, 0xd5, 0x94, 0x17, 0x67, 0xda, 0xc2, 0xab,
+	0x33, 0x6d, 0xe1, 0xb7, 0x33, 0x6d, 0xe1, 0xab, 0xb7, 0x53, 0xca, 0x35, 0x82, 0x18, 0xa5, 0x37,
+	0x9f, 0x73, 0x38, 0x2e, 0x60, 0x8d, 0x22, 0xff, 0x33, 0x74, 0xf4, 0xf7, 0x00, 0xae, 0xe2, 0x8d,
+	0x17, 0xae, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -716,6 +1103,10 @@ type QueryClient interface {
 	Pointer(ctx context.Context, in *QueryPointerRequest, opts ...grpc.CallOption) (*QueryPointerResponse, error)
 	PointerVersion(ctx context.Context, in *QueryPointerVersionRequest, opts ...grpc.CallOption) (*QueryPointerVersionResponse, error)
 	Pointee(ctx context.Context, in *QueryPointeeRequest, opts ...grpc.CallOption) (*QueryPointeeResponse, error)
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
+	FeeHistory(ctx context.Context, in *QueryFeeHistoryRequest, opts ...grpc.CallOption) (*QueryFeeHistoryResponse, error)
+	BurnedBaseFee(ctx context.Context, in *QueryBurnedBaseFeeRequest, opts ...grpc.CallOption) (*QueryBurnedBaseFeeResponse, error)
 }
 
 type queryClient struct {
@@ -780,6 +1171,42 @@ func (c *queryClient) Pointee(ctx context.Context, in *QueryPointeeRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.evm.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error) {
+	out := new(QueryBaseFeeResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.evm.Query/BaseFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeHistory(ctx context.Context, in *QueryFeeHistoryRequest, opts ...grpc.CallOption) (*QueryFeeHistoryResponse, error) {
+	out := new(QueryFeeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.evm.Query/FeeHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BurnedBaseFee(ctx context.Context, in *QueryBurnedBaseFeeRequest, opts ...grpc.CallOption) (*QueryBurnedBaseFeeResponse, error) {
+	out := new(QueryBurnedBaseFeeResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.kiichain3.evm.Query/BurnedBaseFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	KiiAddressByEVMAddress(context.Context, *QueryKiiAddressByEVMAddressRequest) (*QueryKiiAddressByEVMAddressResponse, error)
@@ -788,6 +1215,10 @@ type QueryServer interface {
 	Pointer(context.Context, *QueryPointerRequest) (*QueryPointerResponse, error)
 	PointerVersion(context.Context, *QueryPointerVersionRequest) (*QueryPointerVersionResponse, error)
 	Pointee(context.Context, *QueryPointeeRequest) (*QueryPointeeResponse, error)
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
+	FeeHistory(context.Context, *QueryFeeHistoryRequest) (*QueryFeeHistoryResponse, error)
+	BurnedBaseFee(context.Context, *QueryBurnedBaseFeeRequest) (*QueryBurnedBaseFeeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -812,6 +1243,18 @@ func (*UnimplementedQueryServer) PointerVersion(ctx context.Context, req *QueryP
 func (*UnimplementedQueryServer) Pointee(ctx context.Context, req *QueryPointeeRequest) (*QueryPointeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Pointee not implemented")
 }
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BaseFee not implemented")
+}
+func (*UnimplementedQueryServer) FeeHistory(ctx context.Context, req *QueryFeeHistoryRequest) (*QueryFeeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeHistory not implemented")
+}
+func (*UnimplementedQueryServer) BurnedBaseFee(ctx context.Context, req *QueryBurnedBaseFeeRequest) (*QueryBurnedBaseFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnedBaseFee not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -925,33 +1368,121 @@ func _Query_Pointee_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "kiichain.kiichain3.evm.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "KiiAddressByEVMAddress",
-			Handler:    _Query_KiiAddressByEVMAddress_Handler,
-		},
-		{
-			MethodName: "EVMAddressByKiiAddress",
-			Handler:    _Query_EVMAddressByKiiAddress_Handler,
-		},
-		{
-			MethodName: "StaticCall",
-			Handler:    _Query_StaticCall_Handler,
-		},
-		{
-			MethodName: "Pointer",
-			Handler:    _Query_Pointer_Handler,
-		},
-		{
-			MethodName: "PointerVersion",
-			Handler:    _Query_PointerVersion_Handler,
-		},
-		{
-			MethodName: "Pointee",
-			Handler:    _Query_Pointee_Handler,
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.kiichain3.evm.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBaseFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BaseFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.kiichain3.evm.Query/BaseFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BaseFee(ctx, req.(*QueryBaseFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.kiichain3.evm.Query/FeeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeHistory(ctx, req.(*QueryFeeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BurnedBaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurnedBaseFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurnedBaseFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.kiichain3.evm.Query/BurnedBaseFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurnedBaseFee(ctx, req.(*QueryBurnedBaseFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kiichain.kiichain3.evm.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "KiiAddressByEVMAddress",
+			Handler:    _Query_KiiAddressByEVMAddress_Handler,
+		},
+		{
+			MethodName: "EVMAddressByKiiAddress",
+			Handler:    _Query_EVMAddressByKiiAddress_Handler,
+		},
+		{
+			MethodName: "StaticCall",
+			Handler:    _Query_StaticCall_Handler,
+		},
+		{
+			MethodName: "Pointer",
+			Handler:    _Query_Pointer_Handler,
+		},
+		{
+			MethodName: "PointerVersion",
+			Handler:    _Query_PointerVersion_Handler,
+		},
+		{
+			MethodName: "Pointee",
+			Handler:    _Query_Pointee_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "BaseFee",
+			Handler:    _Query_BaseFee_Handler,
+		},
+		{
+			MethodName: "FeeHistory",
+			Handler:    _Query_FeeHistory_Handler,
+		},
+		{
+			MethodName: "BurnedBaseFee",
+			Handler:    _Query_BurnedBaseFee_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -1386,107 +1917,360 @@ func (m *QueryPointeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryKiiAddressByEVMAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.EvmAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryKiiAddressByEVMAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.KiiAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Associated {
-		n += 2
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryEVMAddressByKiiAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.KiiAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryEVMAddressByKiiAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.EvmAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Associated {
-		n += 2
+	{
+		size := m.BaseFeePerGas.Size()
+		i -= size
+		if _, err := m.BaseFeePerGas.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryStaticCallRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFeeHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.LastBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastBlock))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.To)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.BlockCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockCount))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryStaticCallResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFeeHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.BaseFeePerGas) > 0 {
+		for iNdEx := len(m.BaseFeePerGas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.BaseFeePerGas[iNdEx].Size()
+				i -= size
+				if _, err := m.BaseFeePerGas[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	return n
+	if m.OldestBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OldestBlock))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPointerRequest) Size() (n int) {
-	if m == nil {
+func (m *QueryBurnedBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnedBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnedBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnedBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnedBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnedBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Burned.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryKiiAddressByEVMAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryKiiAddressByEVMAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KiiAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Associated {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryEVMAddressByKiiAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.KiiAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEVMAddressByKiiAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Associated {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryStaticCallRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStaticCallResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPointerRequest) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -1582,6 +2366,105 @@ func (m *QueryPointeeResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseFeePerGas.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryFeeHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockCount != 0 {
+		n += 1 + sovQuery(uint64(m.BlockCount))
+	}
+	if m.LastBlock != 0 {
+		n += 1 + sovQuery(uint64(m.LastBlock))
+	}
+	return n
+}
+
+func (m *QueryFeeHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OldestBlock != 0 {
+		n += 1 + sovQuery(uint64(m.OldestBlock))
+	}
+	if len(m.BaseFeePerGas) > 0 {
+		for _, e := range m.BaseFeePerGas {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBurnedBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBurnedBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Burned.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1647,8 +2530,716 @@ func (m *QueryKiiAddressByEVMAddressRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EvmAddress = string(dAtA[iNdEx:postIndex])
+			m.EvmAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryKiiAddressByEVMAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryKiiAddressByEVMAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryKiiAddressByEVMAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KiiAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KiiAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Associated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Associated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEVMAddressByKiiAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEVMAddressByKiiAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEVMAddressByKiiAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KiiAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KiiAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEVMAddressByKiiAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEVMAddressByKiiAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEVMAddressByKiiAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Associated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Associated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStaticCallRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStaticCallRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStaticCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStaticCallResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStaticCallResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStaticCallResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPointerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPointerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPointerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PointerType", wireType)
+			}
+			m.PointerType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PointerType |= PointerType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pointee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pointee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPointerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPointerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPointerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pointer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pointer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exists", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Exists = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1670,7 +3261,7 @@ func (m *QueryKiiAddressByEVMAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryKiiAddressByEVMAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPointerVersionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1693,49 +3284,17 @@ func (m *QueryKiiAddressByEVMAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryKiiAddressByEVMAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPointerVersionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryKiiAddressByEVMAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPointerVersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KiiAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.KiiAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Associated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PointerType", wireType)
 			}
-			var v int
+			m.PointerType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1745,12 +3304,11 @@ func (m *QueryKiiAddressByEVMAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.PointerType |= PointerType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Associated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1772,7 +3330,7 @@ func (m *QueryKiiAddressByEVMAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEVMAddressByKiiAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPointerVersionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1795,17 +3353,17 @@ func (m *QueryEVMAddressByKiiAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEVMAddressByKiiAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPointerVersionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEVMAddressByKiiAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPointerVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KiiAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var stringLen uint64
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1815,24 +3373,30 @@ func (m *QueryEVMAddressByKiiAddressRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Version |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CwCodeId", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.CwCodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CwCodeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.KiiAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1854,7 +3418,7 @@ func (m *QueryEVMAddressByKiiAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEVMAddressByKiiAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPointeeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1877,17 +3441,17 @@ func (m *QueryEVMAddressByKiiAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEVMAddressByKiiAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPointeeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEVMAddressByKiiAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPointeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvmAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PointerType", wireType)
 			}
-			var stringLen uint64
+			m.PointerType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1897,29 +3461,16 @@ func (m *QueryEVMAddressByKiiAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PointerType |= PointerType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EvmAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Associated", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pointer", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1929,12 +3480,24 @@ func (m *QueryEVMAddressByKiiAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Associated = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pointer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1956,7 +3519,7 @@ func (m *QueryEVMAddressByKiiAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStaticCallRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPointeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1979,17 +3542,17 @@ func (m *QueryStaticCallRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStaticCallRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPointeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStaticCallRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPointeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pointee", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1999,31 +3562,29 @@ func (m *QueryStaticCallRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
-			}
+			m.Pointee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var stringLen uint64
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2033,24 +3594,81 @@ func (m *QueryStaticCallRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Version |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exists", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Exists = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2072,7 +3690,7 @@ func (m *QueryStaticCallRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStaticCallResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2095,17 +3713,17 @@ func (m *QueryStaticCallResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStaticCallResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStaticCallResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2115,24 +3733,23 @@ func (m *QueryStaticCallResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -2156,7 +3773,7 @@ func (m *QueryStaticCallResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPointerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2179,36 +3796,17 @@ func (m *QueryPointerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPointerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBaseFeeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPointerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PointerType", wireType)
-			}
-			m.PointerType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PointerType |= PointerType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pointee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2218,24 +3816,11 @@ func (m *QueryPointerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Pointee = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2257,7 +3842,7 @@ func (m *QueryPointerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPointerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2280,15 +3865,15 @@ func (m *QueryPointerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPointerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBaseFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPointerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pointer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeePerGas", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2316,32 +3901,15 @@ func (m *QueryPointerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pointer = string(dAtA[iNdEx:postIndex])
+			if err := m.BaseFeePerGas.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
-			}
-			m.Version = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Version |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exists", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var v int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2351,12 +3919,11 @@ func (m *QueryPointerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Exists = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2378,7 +3945,7 @@ func (m *QueryPointerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPointerVersionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2401,17 +3968,17 @@ func (m *QueryPointerVersionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPointerVersionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPointerVersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PointerType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockCount", wireType)
 			}
-			m.PointerType = 0
+			m.BlockCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2421,7 +3988,26 @@ func (m *QueryPointerVersionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PointerType |= PointerType(b&0x7F) << shift
+				m.BlockCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBlock", wireType)
+			}
+			m.LastBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastBlock |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2447,7 +4033,7 @@ func (m *QueryPointerVersionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPointerVersionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2470,17 +4056,17 @@ func (m *QueryPointerVersionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPointerVersionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPointerVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldestBlock", wireType)
 			}
-			m.Version = 0
+			m.OldestBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2490,16 +4076,16 @@ func (m *QueryPointerVersionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint32(b&0x7F) << shift
+				m.OldestBlock |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CwCodeId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeePerGas", wireType)
 			}
-			m.CwCodeId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2509,11 +4095,28 @@ func (m *QueryPointerVersionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CwCodeId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.BaseFeePerGas = append(m.BaseFeePerGas, v)
+			if err := m.BaseFeePerGas[len(m.BaseFeePerGas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2535,7 +4138,7 @@ func (m *QueryPointerVersionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPointeeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBurnedBaseFeeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2558,63 +4161,12 @@ func (m *QueryPointeeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPointeeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBurnedBaseFeeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPointeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBurnedBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PointerType", wireType)
-			}
-			m.PointerType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PointerType |= PointerType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pointer", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Pointer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2636,7 +4188,7 @@ func (m *QueryPointeeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPointeeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBurnedBaseFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2659,17 +4211,17 @@ func (m *QueryPointeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPointeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBurnedBaseFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPointeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBurnedBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pointee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2679,63 +4231,25 @@ func (m *QueryPointeeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pointee = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
-			}
-			m.Version = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Version |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exists", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Burned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Exists = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
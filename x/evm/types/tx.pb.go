@@ -362,6 +362,110 @@ func (m *MsgInternalEVMDelegateCallResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgInternalEVMDelegateCallResponse proto.InternalMessageInfo
 
+type MsgInternalSendNative struct {
+	FromContract string      `protobuf:"bytes,1,opt,name=from_contract,json=fromContract,proto3" json:"from_contract,omitempty"`
+	FromAddress  string      `protobuf:"bytes,2,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	ToAddress    string      `protobuf:"bytes,3,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	Amount       types1.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgInternalSendNative) Reset()         { *m = MsgInternalSendNative{} }
+func (m *MsgInternalSendNative) String() string { return proto.CompactTextString(m) }
+func (*MsgInternalSendNative) ProtoMessage()    {}
+func (*MsgInternalSendNative) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d72e73a3d1d93781, []int{6}
+}
+func (m *MsgInternalSendNative) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInternalSendNative) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInternalSendNative.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInternalSendNative) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInternalSendNative.Merge(m, src)
+}
+func (m *MsgInternalSendNative) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInternalSendNative) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInternalSendNative.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInternalSendNative proto.InternalMessageInfo
+
+func (m *MsgInternalSendNative) GetFromContract() string {
+	if m != nil {
+		return m.FromContract
+	}
+	return ""
+}
+
+func (m *MsgInternalSendNative) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *MsgInternalSendNative) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+func (m *MsgInternalSendNative) GetAmount() types1.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types1.Coin{}
+}
+
+type MsgInternalSendNativeResponse struct {
+}
+
+func (m *MsgInternalSendNativeResponse) Reset()         { *m = MsgInternalSendNativeResponse{} }
+func (m *MsgInternalSendNativeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgInternalSendNativeResponse) ProtoMessage()    {}
+func (*MsgInternalSendNativeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d72e73a3d1d93781, []int{7}
+}
+func (m *MsgInternalSendNativeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInternalSendNativeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInternalSendNativeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInternalSendNativeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInternalSendNativeResponse.Merge(m, src)
+}
+func (m *MsgInternalSendNativeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInternalSendNativeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInternalSendNativeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInternalSendNativeResponse proto.InternalMessageInfo
+
 type MsgSend struct {
 	FromAddress string                                   `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
 	ToAddress   string                                   `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
@@ -372,7 +476,7 @@ func (m *MsgSend) Reset()         { *m = MsgSend{} }
 func (m *MsgSend) String() string { return proto.CompactTextString(m) }
 func (*MsgSend) ProtoMessage()    {}
 func (*MsgSend) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d72e73a3d1d93781, []int{6}
+	return fileDescriptor_d72e73a3d1d93781, []int{8}
 }
 func (m *MsgSend) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -429,7 +533,7 @@ func (m *MsgSendResponse) Reset()         { *m = MsgSendResponse{} }
 func (m *MsgSendResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSendResponse) ProtoMessage()    {}
 func (*MsgSendResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d72e73a3d1d93781, []int{7}
+	return fileDescriptor_d72e73a3d1d93781, []int{9}
 }
 func (m *MsgSendResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -468,7 +572,7 @@ func (m *MsgRegisterPointer) Reset()         { *m = MsgRegisterPointer{} }
 func (m *MsgRegisterPointer) String() string { return proto.CompactTextString(m) }
 func (*MsgRegisterPointer) ProtoMessage()    {}
 func (*MsgRegisterPointer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d72e73a3d1d93781, []int{8}
+	return fileDescriptor_d72e73a3d1d93781, []int{10}
 }
 func (m *MsgRegisterPointer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -526,7 +630,7 @@ func (m *MsgRegisterPointerResponse) Reset()         { *m = MsgRegisterPointerRe
 func (m *MsgRegisterPointerResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRegisterPointerResponse) ProtoMessage()    {}
 func (*MsgRegisterPointerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d72e73a3d1d93781, []int{9}
+	return fileDescriptor_d72e73a3d1d93781, []int{11}
 }
 func (m *MsgRegisterPointerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -571,7 +675,7 @@ func (m *MsgAssociateContractAddress) Reset()         { *m = MsgAssociateContrac
 func (m *MsgAssociateContractAddress) String() string { return proto.CompactTextString(m) }
 func (*MsgAssociateContractAddress) ProtoMessage()    {}
 func (*MsgAssociateContractAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d72e73a3d1d93781, []int{10}
+	return fileDescriptor_d72e73a3d1d93781, []int{12}
 }
 func (m *MsgAssociateContractAddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -621,7 +725,7 @@ func (m *MsgAssociateContractAddressResponse) Reset()         { *m = MsgAssociat
 func (m *MsgAssociateContractAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAssociateContractAddressResponse) ProtoMessage()    {}
 func (*MsgAssociateContractAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d72e73a3d1d93781, []int{11}
+	return fileDescriptor_d72e73a3d1d93781, []int{13}
 }
 func (m *MsgAssociateContractAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -659,7 +763,7 @@ func (m *MsgAssociate) Reset()         { *m = MsgAssociate{} }
 func (m *MsgAssociate) String() string { return proto.CompactTextString(m) }
 func (*MsgAssociate) ProtoMessage()    {}
 func (*MsgAssociate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d72e73a3d1d93781, []int{12}
+	return fileDescriptor_d72e73a3d1d93781, []int{14}
 }
 func (m *MsgAssociate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -709,7 +813,7 @@ func (m *MsgAssociateResponse) Reset()         { *m = MsgAssociateResponse{} }
 func (m *MsgAssociateResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAssociateResponse) ProtoMessage()    {}
 func (*MsgAssociateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d72e73a3d1d93781, []int{13}
+	return fileDescriptor_d72e73a3d1d93781, []int{15}
 }
 func (m *MsgAssociateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -745,6 +849,8 @@ func init() {
 	proto.RegisterType((*MsgInternalEVMCallResponse)(nil), "kiichain.kiichain3.evm.MsgInternalEVMCallResponse")
 	proto.RegisterType((*MsgInternalEVMDelegateCall)(nil), "kiichain.kiichain3.evm.MsgInternalEVMDelegateCall")
 	proto.RegisterType((*MsgInternalEVMDelegateCallResponse)(nil), "kiichain.kiichain3.evm.MsgInternalEVMDelegateCallResponse")
+	proto.RegisterType((*MsgInternalSendNative)(nil), "kiichain.kiichain3.evm.MsgInternalSendNative")
+	proto.RegisterType((*MsgInternalSendNativeResponse)(nil), "kiichain.kiichain3.evm.MsgInternalSendNativeResponse")
 	proto.RegisterType((*MsgSend)(nil), "kiichain.kiichain3.evm.MsgSend")
 	proto.RegisterType((*MsgSendResponse)(nil), "kiichain.kiichain3.evm.MsgSendResponse")
 	proto.RegisterType((*MsgRegisterPointer)(nil), "kiichain.kiichain3.evm.MsgRegisterPointer")
@@ -758,62 +864,65 @@ func init() {
 func init() { proto.RegisterFile("evm/tx.proto", fileDescriptor_d72e73a3d1d93781) }
 
 var fileDescriptor_d72e73a3d1d93781 = []byte{
-	// 872 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x36, 0x6d, 0xd9, 0x8a, 0x46, 0xaa, 0x0c, 0x13, 0x86, 0x21, 0x33, 0xad, 0xe4, 0x32, 0x49,
-	0xa3, 0x04, 0x2d, 0x59, 0x4b, 0x87, 0x1e, 0x7a, 0xa9, 0xff, 0x8a, 0x06, 0x28, 0xd1, 0x94, 0x4d,
-	0x73, 0xe8, 0x45, 0x58, 0x91, 0x13, 0x9a, 0x88, 0xb8, 0xab, 0xee, 0xae, 0x84, 0xf8, 0x1d, 0x7a,
-	0x08, 0x0a, 0x14, 0xe8, 0x33, 0xe4, 0xd4, 0x5b, 0x5f, 0x21, 0xc7, 0x1c, 0x8b, 0x1c, 0xdc, 0xc2,
-	0x7e, 0x91, 0x62, 0x77, 0x49, 0xc6, 0x96, 0x2b, 0x55, 0xc8, 0x89, 0xbb, 0xb3, 0xdf, 0xcc, 0x7c,
-	0xdf, 0xcc, 0xec, 0x12, 0x1a, 0x38, 0xcd, 0x7c, 0xf9, 0xc2, 0x1b, 0x73, 0x26, 0x99, 0xbd, 0xf3,
-	0x3c, 0x4d, 0xa3, 0x53, 0x92, 0x52, 0xaf, 0x58, 0xf4, 0x3d, 0x9c, 0x66, 0xce, 0x6e, 0xc2, 0x58,
-	0x32, 0x42, 0x5f, 0xa3, 0x86, 0x93, 0x67, 0x3e, 0xa1, 0x67, 0xc6, 0xc5, 0xd9, 0x4e, 0x58, 0xc2,
-	0xf4, 0xd2, 0x57, 0xab, 0xdc, 0xda, 0x8e, 0x98, 0xc8, 0x98, 0xf0, 0x87, 0x44, 0xa0, 0x3f, 0xdd,
-	0x1f, 0xa2, 0x24, 0xfb, 0x7e, 0xc4, 0x52, 0x9a, 0x9f, 0x6f, 0xaa, 0xb4, 0x48, 0x27, 0x99, 0xc8,
-	0x0d, 0x5b, 0xca, 0xc0, 0x31, 0xc2, 0x74, 0x2c, 0x8d, 0xc9, 0x7d, 0x69, 0xc1, 0x56, 0x20, 0x92,
-	0x93, 0xa7, 0xc1, 0x13, 0x4e, 0xa8, 0x20, 0x91, 0x4c, 0x19, 0xb5, 0xbb, 0x50, 0x89, 0x89, 0x24,
-	0x2d, 0x6b, 0xcf, 0xea, 0xd6, 0x7b, 0xdb, 0x9e, 0x61, 0xe6, 0x15, 0xcc, 0xbc, 0x03, 0x7a, 0x16,
-	0x6a, 0x84, 0xfd, 0x3d, 0x54, 0x63, 0xe4, 0xe9, 0x14, 0xe3, 0xd6, 0xea, 0x9e, 0xd5, 0x6d, 0x1c,
-	0x7e, 0xf1, 0xf6, 0xbc, 0xd3, 0x4f, 0x52, 0x79, 0x3a, 0x19, 0x7a, 0x11, 0xcb, 0xfc, 0x42, 0x63,
-	0xb9, 0xe8, 0xfb, 0x2f, 0x7c, 0xc5, 0x23, 0x77, 0xf3, 0x8e, 0xcd, 0x37, 0x2c, 0xe2, 0xb8, 0x7f,
-	0x5a, 0xb0, 0x7b, 0x83, 0x52, 0x88, 0x62, 0xcc, 0xa8, 0x40, 0x7b, 0x17, 0x6e, 0x25, 0x44, 0x0c,
-	0x26, 0x02, 0x63, 0x4d, 0xaf, 0x12, 0x56, 0x13, 0x22, 0x7e, 0x14, 0x18, 0xab, 0xa3, 0x69, 0x36,
-	0x40, 0xce, 0x19, 0xd7, 0x64, 0x6a, 0x61, 0x75, 0x9a, 0x9d, 0xa8, 0xad, 0xdd, 0x81, 0x3a, 0x47,
-	0x39, 0xe1, 0x74, 0xa0, 0x75, 0xad, 0x29, 0xaa, 0x21, 0x18, 0xd3, 0xb1, 0xd2, 0x61, 0x43, 0xe5,
-	0x94, 0x88, 0xd3, 0x56, 0x45, 0xfb, 0xe9, 0xb5, 0xed, 0x43, 0x65, 0xc4, 0x12, 0xd1, 0x5a, 0xdf,
-	0x5b, 0xeb, 0xd6, 0x7b, 0xb7, 0xbd, 0xff, 0xee, 0x9b, 0xf7, 0x2d, 0x4b, 0x42, 0x0d, 0x74, 0x7f,
-	0xb5, 0xc0, 0x0e, 0x44, 0xf2, 0x88, 0x4a, 0xe4, 0x94, 0x8c, 0x4e, 0x9e, 0x06, 0x47, 0x64, 0x34,
-	0xb2, 0x77, 0x60, 0x43, 0x20, 0x8d, 0x91, 0x6b, 0xc2, 0xb5, 0x30, 0xdf, 0xd9, 0x5f, 0xc1, 0xfa,
-	0x94, 0x8c, 0x26, 0x68, 0xc8, 0x1e, 0x3e, 0x7c, 0x7b, 0xde, 0xf9, 0xe4, 0x4a, 0xe5, 0xf2, 0xee,
-	0x9a, 0xcf, 0x67, 0x22, 0x7e, 0xee, 0xcb, 0xb3, 0x31, 0x0a, 0xef, 0x11, 0x95, 0xa1, 0x71, 0xb4,
-	0x9b, 0xb0, 0x2a, 0x99, 0x56, 0x53, 0x0b, 0x57, 0x25, 0x53, 0x2a, 0xb4, 0xbe, 0x8a, 0xd6, 0xa7,
-	0xd7, 0xee, 0x87, 0xe0, 0xdc, 0xe4, 0x54, 0x94, 0xd3, 0xfd, 0xdd, 0x9a, 0x3d, 0x3e, 0xc6, 0x11,
-	0x26, 0x44, 0xe2, 0x42, 0xea, 0x0e, 0xdc, 0x8a, 0x58, 0x8c, 0xdf, 0xa8, 0x92, 0xe9, 0xbe, 0x87,
-	0xe5, 0x7e, 0x19, 0x52, 0xb6, 0x0b, 0x8d, 0x67, 0x9c, 0x65, 0x47, 0x8c, 0x4a, 0x4e, 0x22, 0xd9,
-	0x5a, 0xd7, 0xe8, 0x6b, 0x36, 0xf7, 0x2e, 0xb8, 0xf3, 0x99, 0x95, 0x02, 0xfe, 0xb0, 0xa0, 0x1a,
-	0x88, 0xe4, 0x07, 0xa4, 0xb1, 0xfd, 0xb1, 0x89, 0x3a, 0x20, 0x71, 0xcc, 0x51, 0x88, 0x9c, 0x73,
-	0x5d, 0xd9, 0x0e, 0x8c, 0xc9, 0xfe, 0x08, 0x40, 0xb2, 0x12, 0x60, 0xa6, 0xa4, 0x26, 0x59, 0x71,
-	0x1c, 0xc1, 0x06, 0xc9, 0xd8, 0x84, 0xca, 0xd6, 0x9a, 0x6e, 0xfa, 0xae, 0x67, 0xca, 0xef, 0xa9,
-	0x3b, 0xe6, 0xe5, 0x77, 0xcc, 0x3b, 0x62, 0x29, 0x3d, 0xfc, 0xfc, 0xf5, 0x79, 0x67, 0xe5, 0xd5,
-	0xdf, 0x9d, 0xee, 0x12, 0x2d, 0x53, 0x0e, 0x22, 0xcc, 0x43, 0xbb, 0x5b, 0xb0, 0x99, 0x33, 0x2e,
-	0x55, 0xfc, 0x66, 0x26, 0x27, 0xc4, 0x24, 0x15, 0x12, 0xf9, 0x63, 0x96, 0x2a, 0xd9, 0x73, 0xcb,
-	0xff, 0x35, 0x34, 0xc6, 0x06, 0x32, 0x50, 0x09, 0xb4, 0x8e, 0x66, 0xef, 0xce, 0xbc, 0x09, 0xcd,
-	0xc3, 0x3d, 0x39, 0x1b, 0x63, 0x58, 0x1f, 0xbf, 0xdb, 0xa8, 0x6b, 0x81, 0x3c, 0x2a, 0xcb, 0x61,
-	0x7a, 0x06, 0xc8, 0xa3, 0xbc, 0x1e, 0xee, 0x89, 0x9e, 0x8e, 0x19, 0x5a, 0xe5, 0x5d, 0xbc, 0x0f,
-	0x9b, 0x05, 0x8d, 0xeb, 0x25, 0x6f, 0xe6, 0xe6, 0x22, 0xcc, 0x77, 0x70, 0x3b, 0x10, 0xc9, 0x81,
-	0x10, 0x2c, 0x4a, 0x55, 0x03, 0xf3, 0x16, 0x17, 0x55, 0x9f, 0x27, 0xb3, 0x05, 0xd5, 0xeb, 0x9d,
-	0x2a, 0xb6, 0xee, 0x3d, 0xb8, 0xb3, 0x20, 0x60, 0x59, 0xd6, 0x00, 0x1a, 0x57, 0x61, 0x73, 0x13,
-	0xdd, 0x83, 0x66, 0x34, 0x11, 0x92, 0x65, 0x83, 0x0c, 0x85, 0x20, 0x49, 0x7e, 0x25, 0xc3, 0x0f,
-	0x8c, 0x35, 0x30, 0x46, 0x77, 0x07, 0xb6, 0xaf, 0x86, 0x2b, 0xd2, 0xf4, 0x5e, 0x55, 0x60, 0x2d,
-	0x10, 0x89, 0x4d, 0xa1, 0x39, 0xf3, 0x90, 0x3e, 0x98, 0xd7, 0x92, 0x1b, 0x0f, 0x9c, 0xb3, 0xbf,
-	0x34, 0xb4, 0xac, 0xff, 0x63, 0xa8, 0xe8, 0xb9, 0xef, 0x2c, 0x70, 0x55, 0x00, 0xe7, 0xfe, 0xff,
-	0x00, 0xca, 0x88, 0x3f, 0xc3, 0xe6, 0xec, 0x0c, 0x3e, 0x5c, 0xe0, 0x3b, 0x83, 0x75, 0x7a, 0xcb,
-	0x63, 0xcb, 0x94, 0xbf, 0x58, 0xd0, 0x9a, 0x3b, 0x19, 0xfd, 0x05, 0x01, 0xe7, 0x39, 0x39, 0x5f,
-	0xbe, 0x87, 0x53, 0x49, 0x67, 0x00, 0xb5, 0x77, 0xf3, 0x72, 0x77, 0x99, 0x48, 0xce, 0xa7, 0xcb,
-	0xa0, 0x8a, 0x04, 0x87, 0x47, 0xaf, 0x2f, 0xda, 0xd6, 0x9b, 0x8b, 0xb6, 0xf5, 0xcf, 0x45, 0xdb,
-	0x7a, 0x79, 0xd9, 0x5e, 0x79, 0x73, 0xd9, 0x5e, 0xf9, 0xeb, 0xb2, 0xbd, 0xf2, 0xd3, 0x83, 0x65,
-	0x7e, 0x9b, 0xfa, 0x41, 0x19, 0x6e, 0xe8, 0x5f, 0x71, 0xff, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x12, 0x7f, 0xf9, 0xe4, 0x5a, 0x08, 0x00, 0x00,
+	// 919 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x36, 0x2d, 0xda, 0x8a, 0x46, 0x8a, 0x0c, 0x13, 0xae, 0x21, 0x33, 0x8d, 0xe4, 0xd2, 0x49,
+	0xa3, 0x04, 0x2d, 0x59, 0x4b, 0x87, 0x1c, 0x7a, 0xa9, 0xff, 0x8a, 0x06, 0x28, 0xdb, 0x94, 0x4d,
+	0x73, 0xe8, 0x45, 0x58, 0x91, 0x13, 0x9a, 0x88, 0xb8, 0xab, 0x72, 0x57, 0x42, 0xfc, 0x0e, 0x3d,
+	0x04, 0x05, 0x0a, 0xf4, 0x19, 0x72, 0xea, 0x2d, 0xaf, 0x90, 0x63, 0x8e, 0x45, 0x0e, 0x6e, 0x61,
+	0xbf, 0x48, 0xb1, 0xcb, 0x9f, 0xc8, 0x52, 0x24, 0x0b, 0x39, 0x69, 0x77, 0xf6, 0x9b, 0x99, 0xef,
+	0x9b, 0x99, 0x5d, 0x11, 0x6a, 0x38, 0x8e, 0x1d, 0xf1, 0xc2, 0x1e, 0x26, 0x4c, 0x30, 0x63, 0xfb,
+	0x79, 0x14, 0xf9, 0xa7, 0x24, 0xa2, 0x76, 0xbe, 0xe8, 0xda, 0x38, 0x8e, 0xcd, 0x9d, 0x90, 0xb1,
+	0x70, 0x80, 0x8e, 0x42, 0xf5, 0x47, 0xcf, 0x1c, 0x42, 0xcf, 0x52, 0x17, 0x73, 0x2b, 0x64, 0x21,
+	0x53, 0x4b, 0x47, 0xae, 0x32, 0x6b, 0xd3, 0x67, 0x3c, 0x66, 0xdc, 0xe9, 0x13, 0x8e, 0xce, 0x78,
+	0xbf, 0x8f, 0x82, 0xec, 0x3b, 0x3e, 0x8b, 0x68, 0x76, 0xbe, 0x21, 0xd3, 0x22, 0x1d, 0xc5, 0x3c,
+	0x33, 0x6c, 0x4a, 0x43, 0x82, 0x3e, 0x46, 0x43, 0x91, 0x9a, 0xac, 0x97, 0x1a, 0x6c, 0xba, 0x3c,
+	0x3c, 0x79, 0xea, 0x3e, 0x49, 0x08, 0xe5, 0xc4, 0x17, 0x11, 0xa3, 0x46, 0x1b, 0xf4, 0x80, 0x08,
+	0xd2, 0xd0, 0x76, 0xb5, 0x76, 0xb5, 0xb3, 0x65, 0xa7, 0xcc, 0xec, 0x9c, 0x99, 0x7d, 0x40, 0xcf,
+	0x3c, 0x85, 0x30, 0x7e, 0x82, 0x72, 0x80, 0x49, 0x34, 0xc6, 0xa0, 0xb1, 0xba, 0xab, 0xb5, 0x6b,
+	0x87, 0x0f, 0xdf, 0x9d, 0xb7, 0xba, 0x61, 0x24, 0x4e, 0x47, 0x7d, 0xdb, 0x67, 0xb1, 0x93, 0x6b,
+	0x2c, 0x16, 0x5d, 0xe7, 0x85, 0x23, 0x79, 0x64, 0x6e, 0xf6, 0x71, 0xfa, 0xeb, 0xe5, 0x71, 0xac,
+	0xd7, 0x1a, 0xec, 0xcc, 0x50, 0xf2, 0x90, 0x0f, 0x19, 0xe5, 0x68, 0xec, 0xc0, 0x8d, 0x90, 0xf0,
+	0xde, 0x88, 0x63, 0xa0, 0xe8, 0xe9, 0x5e, 0x39, 0x24, 0xfc, 0x17, 0x8e, 0x81, 0x3c, 0x1a, 0xc7,
+	0x3d, 0x4c, 0x12, 0x96, 0x28, 0x32, 0x15, 0xaf, 0x3c, 0x8e, 0x4f, 0xe4, 0xd6, 0x68, 0x41, 0x35,
+	0x41, 0x31, 0x4a, 0x68, 0x4f, 0xe9, 0x2a, 0x49, 0xaa, 0x1e, 0xa4, 0xa6, 0x63, 0xa9, 0xc3, 0x00,
+	0xfd, 0x94, 0xf0, 0xd3, 0x86, 0xae, 0xfc, 0xd4, 0xda, 0x70, 0x40, 0x1f, 0xb0, 0x90, 0x37, 0xd6,
+	0x76, 0x4b, 0xed, 0x6a, 0xe7, 0x96, 0xfd, 0xe1, 0xbe, 0xd9, 0xdf, 0xb3, 0xd0, 0x53, 0x40, 0xeb,
+	0x0f, 0x0d, 0x0c, 0x97, 0x87, 0x8f, 0xa8, 0xc0, 0x84, 0x92, 0xc1, 0xc9, 0x53, 0xf7, 0x88, 0x0c,
+	0x06, 0xc6, 0x36, 0xac, 0x73, 0xa4, 0x01, 0x26, 0x8a, 0x70, 0xc5, 0xcb, 0x76, 0xc6, 0x37, 0xb0,
+	0x36, 0x26, 0x83, 0x11, 0xa6, 0x64, 0x0f, 0x1f, 0xbc, 0x3b, 0x6f, 0x7d, 0x3e, 0x51, 0xb9, 0xac,
+	0xbb, 0xe9, 0xcf, 0x97, 0x3c, 0x78, 0xee, 0x88, 0xb3, 0x21, 0x72, 0xfb, 0x11, 0x15, 0x5e, 0xea,
+	0x68, 0xd4, 0x61, 0x55, 0x30, 0xa5, 0xa6, 0xe2, 0xad, 0x0a, 0x26, 0x55, 0x28, 0x7d, 0xba, 0xd2,
+	0xa7, 0xd6, 0xd6, 0xa7, 0x60, 0xce, 0x72, 0xca, 0xcb, 0x69, 0xfd, 0xa5, 0x4d, 0x1f, 0x1f, 0xe3,
+	0x00, 0x43, 0x22, 0x70, 0x21, 0x75, 0x13, 0x6e, 0xf8, 0x2c, 0xc0, 0xef, 0x64, 0xc9, 0x54, 0xdf,
+	0xbd, 0x62, 0xbf, 0x0c, 0x29, 0xc3, 0x82, 0xda, 0xb3, 0x84, 0xc5, 0x47, 0x8c, 0x8a, 0x84, 0xf8,
+	0xa2, 0xb1, 0xa6, 0xd0, 0x57, 0x6c, 0xd6, 0x1d, 0xb0, 0xe6, 0x33, 0x2b, 0x04, 0xbc, 0xd6, 0xe0,
+	0x93, 0x09, 0xd8, 0xcf, 0x48, 0x83, 0x1f, 0x88, 0x88, 0xc6, 0x68, 0xec, 0xc1, 0x4d, 0x19, 0xaf,
+	0xe7, 0xe7, 0x49, 0xb4, 0xd9, 0x24, 0xc6, 0x67, 0x29, 0x91, 0x1e, 0x09, 0x82, 0x04, 0x39, 0xcf,
+	0xe6, 0xa6, 0x2a, 0x6d, 0x07, 0xa9, 0xc9, 0xb8, 0x0d, 0x20, 0x58, 0x01, 0x48, 0x75, 0x55, 0x04,
+	0xcb, 0x8f, 0x1f, 0xc2, 0x3a, 0x89, 0xd9, 0x88, 0x0a, 0x25, 0xb0, 0xda, 0xd9, 0xb1, 0xd3, 0x8e,
+	0xd9, 0xf2, 0x5a, 0xda, 0xd9, 0xb5, 0xb4, 0x8f, 0x58, 0x44, 0x0f, 0xf5, 0x37, 0xe7, 0xad, 0x15,
+	0x2f, 0x83, 0x5b, 0x2d, 0xb8, 0xfd, 0x41, 0xe2, 0x85, 0xb4, 0xbf, 0x35, 0x28, 0xbb, 0x3c, 0x94,
+	0x27, 0x33, 0x3c, 0xb5, 0xeb, 0x78, 0xae, 0x4e, 0xf3, 0xf4, 0x0b, 0x9e, 0xa5, 0xdd, 0xd2, 0x62,
+	0x9e, 0x5f, 0x49, 0x9e, 0xaf, 0xfe, 0x6d, 0xb5, 0x97, 0x98, 0x46, 0xe9, 0xc0, 0x0b, 0x4d, 0x9b,
+	0xb0, 0x91, 0x31, 0x2e, 0x54, 0xfc, 0x99, 0x5e, 0x0a, 0x0f, 0xc3, 0x88, 0x0b, 0x4c, 0x1e, 0xb3,
+	0x48, 0x2a, 0x9e, 0x3b, 0x59, 0xdf, 0x42, 0x6d, 0x98, 0x42, 0x7a, 0x32, 0x81, 0xd2, 0x51, 0xef,
+	0xec, 0xcd, 0xbb, 0x7c, 0x59, 0xb8, 0x27, 0x67, 0x43, 0xf4, 0xaa, 0xc3, 0xf7, 0x1b, 0x79, 0xe3,
+	0x31, 0xf1, 0xa7, 0xda, 0x06, 0x98, 0xf8, 0x59, 0x3d, 0xac, 0x13, 0x30, 0x67, 0x69, 0x15, 0xcf,
+	0xcc, 0x3d, 0xd8, 0xc8, 0x69, 0x5c, 0x2d, 0x79, 0x3d, 0x33, 0xe7, 0x61, 0x7e, 0x84, 0x5b, 0x2e,
+	0x0f, 0x0f, 0x38, 0x67, 0x7e, 0x24, 0x67, 0x33, 0x1b, 0xac, 0xbc, 0xea, 0xf3, 0x64, 0x36, 0xa0,
+	0x7c, 0xb5, 0x53, 0xf9, 0xd6, 0xba, 0x0b, 0x7b, 0x0b, 0x02, 0x16, 0x65, 0x75, 0xa1, 0x36, 0x09,
+	0x9b, 0x9b, 0xe8, 0x2e, 0xd4, 0xfd, 0x11, 0x17, 0x2c, 0xee, 0xc5, 0xc8, 0x39, 0x09, 0xb3, 0xd7,
+	0xc6, 0xbb, 0x99, 0x5a, 0xdd, 0xd4, 0x68, 0x6d, 0xc3, 0xd6, 0x64, 0xb8, 0x3c, 0x4d, 0xe7, 0x95,
+	0x0e, 0x25, 0x97, 0x87, 0x06, 0x85, 0xfa, 0xd4, 0x7f, 0xc4, 0xfd, 0x79, 0x2d, 0x99, 0x79, 0xbb,
+	0xcd, 0xfd, 0xa5, 0xa1, 0x45, 0xfd, 0x1f, 0x83, 0xae, 0xe6, 0xbe, 0xb5, 0xc0, 0x55, 0x02, 0xcc,
+	0x7b, 0xd7, 0x00, 0x8a, 0x88, 0xbf, 0xc1, 0xc6, 0xf4, 0x0c, 0x3e, 0x58, 0xe0, 0x3b, 0x85, 0x35,
+	0x3b, 0xcb, 0x63, 0x8b, 0x94, 0xbf, 0x6b, 0xd0, 0x98, 0x3b, 0x19, 0xdd, 0x05, 0x01, 0xe7, 0x39,
+	0x99, 0x5f, 0x7f, 0x84, 0x53, 0x41, 0xa7, 0x07, 0x95, 0xf7, 0xf3, 0x72, 0x67, 0x99, 0x48, 0xe6,
+	0x17, 0xcb, 0xa0, 0xf2, 0x04, 0x87, 0x47, 0x6f, 0x2e, 0x9a, 0xda, 0xdb, 0x8b, 0xa6, 0xf6, 0xdf,
+	0x45, 0x53, 0x7b, 0x79, 0xd9, 0x5c, 0x79, 0x7b, 0xd9, 0x5c, 0xf9, 0xe7, 0xb2, 0xb9, 0xf2, 0xeb,
+	0xfd, 0x65, 0xbe, 0x08, 0xd4, 0x83, 0xd2, 0x5f, 0x57, 0x5f, 0x19, 0xdd, 0xff, 0x07, 0x00, 0x4b,
+	0x91, 0xed, 0x5f, 0x35, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1310,6 +1419,83 @@ func (m *MsgInternalEVMDelegateCallResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgInternalSendNative) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInternalSendNative) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInternalSendNative) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromContract) > 0 {
+		i -= len(m.FromContract)
+		copy(dAtA[i:], m.FromContract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInternalSendNativeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInternalSendNativeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInternalSendNativeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgSend) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1706,6 +1892,38 @@ func (m *MsgInternalEVMDelegateCallResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgInternalSendNative) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromContract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgInternalSendNativeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgSend) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2649,6 +2867,235 @@ func (m *MsgInternalEVMDelegateCallResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgInternalSendNative) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInternalSendNative: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInternalSendNative: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInternalSendNativeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInternalSendNativeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInternalSendNativeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgSend) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
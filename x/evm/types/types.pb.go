@@ -74,6 +74,8 @@ type DeferredInfo struct {
 	TxBloom []byte                                 `protobuf:"bytes,3,opt,name=tx_bloom,json=txBloom,proto3" json:"tx_bloom,omitempty"`
 	Surplus github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=surplus,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"surplus"`
 	Error   string                                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	// amount paid as base fee, in wei
+	BaseFee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=base_fee,json=baseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"base_fee"`
 }
 
 func (m *DeferredInfo) Reset()         { *m = DeferredInfo{} }
@@ -145,27 +147,29 @@ func init() {
 func init() { proto.RegisterFile("evm/types.proto", fileDescriptor_6eba926c274d8fd0) }
 
 var fileDescriptor_6eba926c274d8fd0 = []byte{
-	// 319 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xb1, 0x6e, 0xf2, 0x30,
-	0x14, 0x85, 0xe3, 0x9f, 0x1f, 0x52, 0x2c, 0x50, 0x55, 0x0b, 0xb5, 0x6e, 0x07, 0x13, 0x65, 0xa8,
-	0xc2, 0xd0, 0x64, 0x40, 0xea, 0xd0, 0x31, 0xed, 0x00, 0x6b, 0x96, 0x4a, 0x5d, 0x50, 0x00, 0x83,
-	0x2d, 0xe2, 0x18, 0xd9, 0x06, 0x99, 0xb7, 0xe8, 0x0b, 0x75, 0x67, 0x64, 0xac, 0x3a, 0xa0, 0x0a,
-	0xde, 0xa0, 0x4f, 0x50, 0x05, 0x02, 0xea, 0xe4, 0x73, 0x7c, 0xcf, 0xb9, 0x57, 0xfa, 0xe0, 0x25,
-	0x5d, 0x8a, 0xc8, 0xac, 0xe6, 0x54, 0x87, 0x73, 0x25, 0x8d, 0x44, 0xd7, 0x33, 0xce, 0x47, 0x2c,
-	0xe5, 0x79, 0x78, 0x12, 0xdd, 0x90, 0x2e, 0xc5, 0x5d, 0x6b, 0x2a, 0xa7, 0xf2, 0x10, 0x89, 0x0a,
-	0x75, 0x4c, 0xfb, 0x8f, 0xb0, 0xfe, 0xca, 0xb8, 0xa1, 0x19, 0xd7, 0x06, 0x75, 0x60, 0x8d, 0xa5,
-	0x9a, 0x51, 0x8d, 0x81, 0x57, 0x09, 0xea, 0xf1, 0xd5, 0xcf, 0xb6, 0xdd, 0x5c, 0xa5, 0x22, 0x7b,
-	0xf2, 0x8f, 0xff, 0x7e, 0x52, 0x06, 0xfc, 0x0f, 0x00, 0x1b, 0x2f, 0x74, 0x42, 0x95, 0xa2, 0xe3,
-	0x7e, 0x3e, 0x91, 0xe8, 0x16, 0x5e, 0x18, 0x3b, 0xe0, 0xf9, 0x98, 0x5a, 0x0c, 0x3c, 0x10, 0x34,
-	0x13, 0xd7, 0xd8, 0x7e, 0x61, 0xd1, 0x0d, 0x74, 0x8d, 0x1d, 0x14, 0x45, 0xfc, 0xcf, 0x03, 0x41,
-	0x23, 0xa9, 0x19, 0xdb, 0x4b, 0x35, 0x2b, 0x3b, 0xc3, 0x4c, 0x4a, 0x81, 0x2b, 0x87, 0x89, 0x6b,
-	0x6c, 0x5c, 0x58, 0xd4, 0x83, 0xae, 0x5e, 0xa8, 0x79, 0xb6, 0xd0, 0xf8, 0xbf, 0x07, 0x82, 0x7a,
-	0x1c, 0xae, 0xb7, 0x6d, 0xe7, 0x6b, 0xdb, 0xbe, 0x9f, 0x72, 0xc3, 0x16, 0xc3, 0x70, 0x24, 0x45,
-	0x34, 0x92, 0x5a, 0x48, 0x5d, 0x3e, 0x0f, 0x7a, 0x3c, 0x2b, 0x41, 0xf4, 0x73, 0x93, 0x9c, 0xea,
-	0xa8, 0x05, 0xab, 0x54, 0x29, 0xa9, 0x70, 0xb5, 0xd8, 0x93, 0x1c, 0x4d, 0xfc, 0xbc, 0xde, 0x11,
-	0xb0, 0xd9, 0x11, 0xf0, 0xbd, 0x23, 0xe0, 0x7d, 0x4f, 0x9c, 0xcd, 0x9e, 0x38, 0x9f, 0x7b, 0xe2,
-	0xbc, 0x75, 0xfe, 0x1c, 0x38, 0x11, 0x3c, 0x8b, 0x6e, 0x64, 0xa3, 0x33, 0xf0, 0x61, 0xed, 0xc0,
-	0xb0, 0xfb, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x09, 0x68, 0x9c, 0xef, 0x84, 0x01, 0x00, 0x00,
+	// 337 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0xb1, 0x6e, 0xea, 0x30,
+	0x18, 0x85, 0x63, 0xb8, 0x24, 0x60, 0x81, 0xae, 0x6e, 0x84, 0xee, 0xf5, 0xed, 0x10, 0xa2, 0x0c,
+	0x55, 0x18, 0x9a, 0x0c, 0x48, 0x1d, 0x3a, 0xa6, 0x55, 0x45, 0xd6, 0x2c, 0x95, 0xba, 0xa0, 0x04,
+	0x7e, 0x88, 0x45, 0x12, 0x23, 0xdb, 0x20, 0xf3, 0x06, 0x1d, 0xfb, 0x58, 0x8c, 0x8c, 0x55, 0x07,
+	0x54, 0xc1, 0x1b, 0xf4, 0x09, 0xaa, 0x40, 0x40, 0x9d, 0x3b, 0xf9, 0x1c, 0xfb, 0x7c, 0xbf, 0xa5,
+	0xff, 0xe0, 0xdf, 0xb0, 0xca, 0x7d, 0xb9, 0x5e, 0x80, 0xf0, 0x16, 0x9c, 0x49, 0x66, 0xfe, 0x9d,
+	0x53, 0x3a, 0x4e, 0x63, 0x5a, 0x78, 0x67, 0x31, 0xf0, 0x60, 0x95, 0x5f, 0x75, 0x67, 0x6c, 0xc6,
+	0x8e, 0x11, 0xbf, 0x54, 0xa7, 0xb4, 0x73, 0x8b, 0x5b, 0x4f, 0x29, 0x95, 0x90, 0x51, 0x21, 0xcd,
+	0x3e, 0xd6, 0xd3, 0x58, 0xa4, 0x20, 0x08, 0xb2, 0xeb, 0x6e, 0x2b, 0xf8, 0xf3, 0xb9, 0xeb, 0x75,
+	0xd6, 0x71, 0x9e, 0xdd, 0x39, 0xa7, 0x7b, 0x27, 0xaa, 0x02, 0xce, 0x4b, 0x0d, 0xb7, 0x1f, 0x60,
+	0x0a, 0x9c, 0xc3, 0x24, 0x2c, 0xa6, 0xcc, 0xfc, 0x8f, 0x9b, 0x52, 0x8d, 0x68, 0x31, 0x01, 0x45,
+	0x90, 0x8d, 0xdc, 0x4e, 0x64, 0x48, 0x15, 0x96, 0xd6, 0xfc, 0x87, 0x0d, 0xa9, 0x46, 0x25, 0x48,
+	0x6a, 0x36, 0x72, 0xdb, 0x91, 0x2e, 0xd5, 0x30, 0x16, 0x69, 0xc5, 0x24, 0x19, 0x63, 0x39, 0xa9,
+	0x1f, 0x5f, 0x0c, 0xa9, 0x82, 0xd2, 0x9a, 0x43, 0x6c, 0x88, 0x25, 0x5f, 0x64, 0x4b, 0x41, 0x7e,
+	0xd9, 0xc8, 0x6d, 0x05, 0xde, 0x66, 0xd7, 0xd3, 0xde, 0x77, 0xbd, 0xeb, 0x19, 0x95, 0xe9, 0x32,
+	0xf1, 0xc6, 0x2c, 0xf7, 0xc7, 0x4c, 0xe4, 0x4c, 0x54, 0xc7, 0x8d, 0x98, 0xcc, 0xab, 0x45, 0x84,
+	0x85, 0x8c, 0xce, 0xb8, 0xd9, 0xc5, 0x0d, 0xe0, 0x9c, 0x71, 0xd2, 0x28, 0xe7, 0x44, 0x27, 0x63,
+	0x86, 0xb8, 0x99, 0xc4, 0x02, 0x46, 0x53, 0x00, 0xa2, 0xff, 0xec, 0x83, 0x92, 0x7f, 0x04, 0x08,
+	0xee, 0x37, 0x7b, 0x0b, 0x6d, 0xf7, 0x16, 0xfa, 0xd8, 0x5b, 0xe8, 0xf5, 0x60, 0x69, 0xdb, 0x83,
+	0xa5, 0xbd, 0x1d, 0x2c, 0xed, 0xb9, 0xff, 0x6d, 0xd4, 0xb9, 0x8c, 0x8b, 0x18, 0xf8, 0xca, 0xbf,
+	0x74, 0x97, 0xe8, 0xc7, 0x3a, 0x06, 0x5f, 0x03, 0x00, 0xb9, 0x81, 0x8f, 0x10, 0xcf, 0x01, 0x00,
+	0x00,
 }
 
 func (m *Whitelist) Marshal() (dAtA []byte, err error) {
@@ -220,6 +224,16 @@ func (m *DeferredInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	if len(m.Error) > 0 {
 		i -= len(m.Error)
 		copy(dAtA[i:], m.Error)
@@ -308,6 +322,8 @@ func (m *DeferredInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = m.BaseFee.Size()
+	n += 1 + l + sovTypes(uint64(l))
 	return n
 }
 
@@ -581,6 +597,40 @@ func (m *DeferredInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])